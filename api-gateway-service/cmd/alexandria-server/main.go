@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/dep"
+	"github.com/oklog/run"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	// Root context, enable complete context shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	// Inject root context with cancel inside DI container
+	dep.Ctx = ctx
+
+	gateway, cleanup, err := dep.InjectTransportService()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		log.Print("stopping services")
+		cleanup()
+	}()
+
+	// Manage goroutines
+	var g run.Group
+	{
+		l, err := net.Listen("tcp", gateway.HTTPProxy.Addr)
+		if err != nil {
+			log.Fatalf("failed to start http server\nerror: %v", err)
+		}
+
+		g.Add(func() error {
+			log.Print("starting http gateway")
+			return gateway.HTTPProxy.Serve(l)
+		}, func(err error) {
+			_ = l.Close()
+		})
+	}
+	{
+		grpcListener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", gateway.Config.Transport.RPCHost,
+			gateway.Config.Transport.RPCPort))
+		if err != nil {
+			log.Fatalf("failed to start grpc server\nerror: %v", err)
+		}
+		g.Add(func() error {
+			log.Print("starting grpc gateway")
+			return gateway.RPCProxy.Serve(grpcListener)
+		}, func(error) {
+			_ = grpcListener.Close()
+		})
+	}
+	{
+		// Set up signal bind
+		var (
+			cancelInterrupt = make(chan struct{})
+			c               = make(chan os.Signal, 2)
+		)
+		defer close(c)
+
+		g.Add(func() error {
+			signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+			select {
+			case sig := <-c:
+				return fmt.Errorf("received signal %s", sig)
+			case <-cancelInterrupt:
+				return nil
+			}
+		}, func(error) {
+			// Cancel root context, propagate cancellation
+			cancel()
+			close(cancelInterrupt)
+		})
+	}
+
+	_ = g.Run()
+}
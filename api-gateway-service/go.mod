@@ -0,0 +1,24 @@
+module github.com/maestre3d/alexandria/api-gateway-service
+
+go 1.13
+
+require (
+	contrib.go.opencensus.io/exporter/zipkin v0.1.1
+	github.com/alexandria-oss/core v0.5.4-beta
+	github.com/go-kit/kit v0.10.0
+	github.com/google/wire v0.4.0
+	github.com/gorilla/mux v1.7.3
+	github.com/maestre3d/alexandria/shared v0.0.0
+	github.com/oklog/run v1.1.0
+	github.com/opentracing/opentracing-go v1.1.0
+	github.com/openzipkin/zipkin-go v0.2.2
+	github.com/prometheus/client_golang v1.5.1
+	github.com/rs/cors v1.7.0
+	github.com/spf13/viper v1.6.3
+	github.com/stretchr/testify v1.5.1
+	go.opencensus.io v0.22.3
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
+	google.golang.org/grpc v1.27.1
+)
+
+replace github.com/maestre3d/alexandria/shared => ../shared
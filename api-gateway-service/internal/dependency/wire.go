@@ -0,0 +1,32 @@
+// +build wireinject
+
+package dependency
+
+import (
+	"github.com/alexandria-oss/core/logger"
+	"github.com/google/wire"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/interactor"
+)
+
+var dataSet = wire.NewSet(
+	logger.NewZapLogger,
+	infrastructure.NewBackendRegistry,
+	wire.Bind(new(domain.ResourceFetcher), new(*infrastructure.ResourceHTTPFetcher)),
+	infrastructure.NewResourceHTTPFetcher,
+)
+
+func InjectMediaAggregator() (*interactor.MediaAggregator, func(), error) {
+	wire.Build(
+		dataSet,
+		interactor.NewMediaAggregator,
+	)
+	return &interactor.MediaAggregator{}, nil, nil
+}
+
+func InjectBackendRegistry() (domain.BackendRegistry, error) {
+	wire.Build(infrastructure.NewBackendRegistry)
+
+	return domain.BackendRegistry{}, nil
+}
@@ -0,0 +1,40 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate wire
+//+build !wireinject
+
+package dependency
+
+import (
+	"github.com/alexandria-oss/core/logger"
+	"github.com/google/wire"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/interactor"
+)
+
+// Injectors from wire.go:
+
+func InjectMediaAggregator() (*interactor.MediaAggregator, func(), error) {
+	logLogger := logger.NewZapLogger()
+	backendRegistry, err := infrastructure.NewBackendRegistry()
+	if err != nil {
+		return nil, nil, err
+	}
+	resourceHTTPFetcher := infrastructure.NewResourceHTTPFetcher()
+	mediaAggregator := interactor.NewMediaAggregator(logLogger, backendRegistry, resourceHTTPFetcher)
+	return mediaAggregator, func() {
+	}, nil
+}
+
+func InjectBackendRegistry() (domain.BackendRegistry, error) {
+	backendRegistry, err := infrastructure.NewBackendRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return backendRegistry, nil
+}
+
+// wire.go:
+
+var dataSet = wire.NewSet(logger.NewZapLogger, infrastructure.NewBackendRegistry, wire.Bind(new(domain.ResourceFetcher), new(*infrastructure.ResourceHTTPFetcher)), infrastructure.NewResourceHTTPFetcher)
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+// ErrBackendUnavailable the backend could not be reached or failed to serve the request
+var ErrBackendUnavailable = errors.New("backend unavailable")
+
+// Backend service behind the gateway
+type Backend struct {
+	// Name backend's unique name (e.g. media)
+	Name string
+	// HTTP base URL of the backend's HTTP API (e.g. http://media:8080)
+	HTTP string
+	// RPC address of the backend's gRPC API (e.g. media:31337)
+	RPC string
+	// Resources HTTP resources served by the backend (e.g. /v1/media)
+	Resources []string
+	// Services gRPC services served by the backend (e.g. pb.Media)
+	Services []string
+	// PublicMethods gRPC methods anyone may call (e.g. /pb.Media/Get)
+	PublicMethods []string
+	// AdminMethods gRPC methods only administrators may call (e.g. /pb.Media/HardDelete)
+	AdminMethods []string
+	// Timeout maximum time to wait for the backend's response
+	Timeout time.Duration
+}
+
+// BackendRegistry backends behind the gateway indexed by name
+type BackendRegistry map[string]*Backend
+
+// Get returns the backend with the given name
+func (r BackendRegistry) Get(name string) (*Backend, error) {
+	backend, ok := r[name]
+	if !ok || backend.HTTP == "" {
+		return nil, exception.NewErrorDescription(ErrBackendUnavailable,
+			fmt.Sprintf("backend %s is not configured", name))
+	}
+
+	return backend, nil
+}
+
+// ByService returns the backend serving the given gRPC service
+func (r BackendRegistry) ByService(service string) (*Backend, bool) {
+	for _, backend := range r {
+		for _, svc := range backend.Services {
+			if svc == service {
+				return backend, true
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+const (
+	// ExpandAuthor embeds the media's author
+	ExpandAuthor = "author"
+	// ExpandCategories embeds the media's categories
+	ExpandCategories = "categories"
+)
+
+// MediaAggregate media read model composed from the media, author and category services,
+// failed expansions are left empty and reported in Errors
+type MediaAggregate struct {
+	Media      json.RawMessage   `json:"media"`
+	Author     json.RawMessage   `json:"author,omitempty"`
+	Categories json.RawMessage   `json:"categories,omitempty"`
+	Errors     map[string]string `json:"errors,omitempty"`
+}
+
+// ResourceFetcher fetches a resource from a backend, the body's root field is returned
+// (e.g. {"media":{...}} -> {...})
+type ResourceFetcher interface {
+	Fetch(ctx context.Context, backend *Backend, path, field string) (json.RawMessage, error)
+}
+
+// ParseExpand returns the expansions of the given comma-separated list (e.g. author,categories)
+func ParseExpand(expand string) ([]string, error) {
+	expansions := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, e := range strings.Split(expand, ",") {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if e == "" || seen[e] {
+			continue
+		}
+		if e != ExpandAuthor && e != ExpandCategories {
+			return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf(exception.InvalidFieldFormatString, "expand", ExpandAuthor+","+ExpandCategories))
+		}
+
+		seen[e] = true
+		expansions = append(expansions, e)
+	}
+
+	return expansions, nil
+}
@@ -0,0 +1,66 @@
+package infrastructure
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+const backendsKey = "alexandria.gateway.backends"
+
+func init() {
+	viper.SetDefault("alexandria.gateway.timeout", "5s")
+
+	defaults := []struct {
+		name, http, rpc, resource, service string
+	}{
+		{"identity", "http://identity:8080", "identity:31337", "user", "pb.User"},
+		{"author", "http://author:8080", "author:31337", "author", "pb.Author"},
+		{"media", "http://media:8080", "media:31337", "media", "pb.Media"},
+		{"category", "http://category:8080", "", "category", ""},
+		{"blob", "http://blob:8080", "", "blob", ""},
+		{"trending", "http://trending:8080", "trending:31337", "trending", "pb.Trending"},
+	}
+	for _, d := range defaults {
+		key := backendsKey + "." + d.name
+		viper.SetDefault(key+".http", d.http)
+		viper.SetDefault(key+".rpc", d.rpc)
+		viper.SetDefault(key+".resources", []string{d.resource})
+		if d.service != "" {
+			viper.SetDefault(key+".services", []string{d.service})
+		}
+	}
+}
+
+// NewBackendRegistry returns the backends set in alexandria.gateway.backends, backends without a
+// timeout use alexandria.gateway.timeout
+func NewBackendRegistry() (domain.BackendRegistry, error) {
+	names := viper.GetStringMap(backendsKey)
+	if len(names) == 0 {
+		return nil, errors.New("missing " + backendsKey)
+	}
+
+	registry := domain.BackendRegistry{}
+	for name := range names {
+		key := backendsKey + "." + name
+		backend := &domain.Backend{
+			Name:          strings.ToLower(name),
+			HTTP:          strings.TrimSuffix(viper.GetString(key+".http"), "/"),
+			RPC:           viper.GetString(key + ".rpc"),
+			Resources:     viper.GetStringSlice(key + ".resources"),
+			Services:      viper.GetStringSlice(key + ".services"),
+			PublicMethods: viper.GetStringSlice(key + ".rpc_public"),
+			AdminMethods:  viper.GetStringSlice(key + ".rpc_admin"),
+			Timeout:       viper.GetDuration(key + ".timeout"),
+		}
+		if backend.Timeout <= 0 {
+			backend.Timeout = viper.GetDuration("alexandria.gateway.timeout")
+		}
+
+		registry[backend.Name] = backend
+	}
+
+	return registry, nil
+}
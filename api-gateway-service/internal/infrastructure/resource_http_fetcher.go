@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+)
+
+// maxResourceSize maximum body size read from a backend
+const maxResourceSize = 1 << 20
+
+// ResourceHTTPFetcher fetches resources from the backends' public HTTP APIs
+type ResourceHTTPFetcher struct {
+	client *http.Client
+}
+
+func NewResourceHTTPFetcher() *ResourceHTTPFetcher {
+	return &ResourceHTTPFetcher{
+		// Timeouts are set per backend through the request context
+		client: &http.Client{},
+	}
+}
+
+func (f *ResourceHTTPFetcher) Fetch(ctx context.Context, backend *domain.Backend, path, field string) (json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, backend.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, backend.HTTP+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, exception.NewErrorDescription(domain.ErrBackendUnavailable,
+				fmt.Sprintf("backend %s timed out", backend.Name))
+		}
+		return nil, exception.NewErrorDescription(domain.ErrBackendUnavailable,
+			fmt.Sprintf("backend %s is not reachable", backend.Name))
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxResourceSize))
+	if err != nil {
+		return nil, exception.NewErrorDescription(domain.ErrBackendUnavailable,
+			fmt.Sprintf("could not read the response of backend %s", backend.Name))
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, responseError(backend, res.StatusCode, body)
+	}
+
+	resource := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(body, &resource); err != nil {
+		return nil, exception.NewErrorDescription(domain.ErrBackendUnavailable,
+			fmt.Sprintf("backend %s returned an invalid response", backend.Name))
+	}
+	raw, ok := resource[field]
+	if !ok || string(raw) == "null" {
+		return nil, exception.EntityNotFound
+	}
+
+	return raw, nil
+}
+
+// responseError maps a backend error response to the gateway's errors, backend client errors are
+// forwarded as-is
+func responseError(backend *domain.Backend, code int, body []byte) error {
+	res := new(httputil.GenericResponse)
+	_ = json.Unmarshal(body, res)
+
+	switch code {
+	case http.StatusNotFound:
+		if res.Message != "" {
+			return exception.NewErrorDescription(exception.EntityNotFound, res.Message)
+		}
+		return exception.EntityNotFound
+	case http.StatusBadRequest:
+		if res.Message != "" {
+			return exception.NewErrorDescription(exception.InvalidFieldFormat, res.Message)
+		}
+		return exception.InvalidFieldFormat
+	default:
+		return exception.NewErrorDescription(domain.ErrBackendUnavailable,
+			fmt.Sprintf("backend %s responded with status %d", backend.Name, code))
+	}
+}
@@ -0,0 +1,139 @@
+package interactor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+)
+
+// MediaAggregator composes media read models, expansions are fetched concurrently and a failed
+// expansion never fails the whole read
+type MediaAggregator struct {
+	logger   log.Logger
+	backends domain.BackendRegistry
+	fetcher  domain.ResourceFetcher
+}
+
+func NewMediaAggregator(logger log.Logger, backends domain.BackendRegistry, fetcher domain.ResourceFetcher) *MediaAggregator {
+	return &MediaAggregator{
+		logger:   logger,
+		backends: backends,
+		fetcher:  fetcher,
+	}
+}
+
+// Get returns the given media with the given expansions (e.g. author,categories)
+func (u *MediaAggregator) Get(ctx context.Context, id, expand string) (*domain.MediaAggregate, error) {
+	if id == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField,
+			fmt.Sprintf(exception.RequiredFieldString, "id"))
+	}
+	expansions, err := domain.ParseExpand(expand)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaBackend, err := u.backends.Get("media")
+	if err != nil {
+		return nil, err
+	}
+	media, err := u.fetcher.Fetch(ctx, mediaBackend, core.PublicAPI+"/media/"+url.PathEscape(id), "media")
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate := &domain.MediaAggregate{Media: media}
+	mu := new(sync.Mutex)
+	wg := new(sync.WaitGroup)
+	for _, expansion := range expansions {
+		var fetch func(context.Context) (json.RawMessage, error)
+		switch expansion {
+		case domain.ExpandAuthor:
+			fetch = func(ctx context.Context) (json.RawMessage, error) {
+				return u.fetchAuthor(ctx, media)
+			}
+		case domain.ExpandCategories:
+			fetch = func(ctx context.Context) (json.RawMessage, error) {
+				return u.fetchCategories(ctx, id)
+			}
+		}
+
+		wg.Add(1)
+		go func(expansion string) {
+			defer wg.Done()
+
+			raw, err := fetch(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				_ = u.logger.Log("method", "gateway.interactor.media.get", "expand", expansion, "err", err.Error())
+				if aggregate.Errors == nil {
+					aggregate.Errors = make(map[string]string)
+				}
+				aggregate.Errors[expansion] = exception.GetErrorDescription(err)
+				return
+			}
+
+			switch expansion {
+			case domain.ExpandAuthor:
+				aggregate.Author = raw
+			case domain.ExpandCategories:
+				aggregate.Categories = raw
+			}
+		}(expansion)
+	}
+	wg.Wait()
+
+	return aggregate, nil
+}
+
+// fetchAuthor returns the first credited author of the given media
+func (u *MediaAggregator) fetchAuthor(ctx context.Context, media json.RawMessage) (json.RawMessage, error) {
+	ref := new(struct {
+		Authors []struct {
+			AuthorID string `json:"author_id"`
+		} `json:"authors"`
+	})
+	if err := json.Unmarshal(media, ref); err != nil || len(ref.Authors) == 0 || ref.Authors[0].AuthorID == "" {
+		return nil, exception.NewErrorDescription(exception.EntityNotFound, "media has no author")
+	}
+
+	backend, err := u.backends.Get("author")
+	if err != nil {
+		return nil, err
+	}
+
+	return u.fetcher.Fetch(ctx, backend, core.PublicAPI+"/author/"+url.PathEscape(ref.Authors[0].AuthorID), "author")
+}
+
+func (u *MediaAggregator) fetchCategories(ctx context.Context, id string) (json.RawMessage, error) {
+	backend, err := u.backends.Get("category")
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := u.fetcher.Fetch(ctx, backend, core.PublicAPI+"/category/root/"+url.PathEscape(id), "category_root")
+	if errors.Is(err, exception.EntityNotFound) {
+		// Uncategorized media
+		return json.RawMessage("{}"), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	root := new(struct {
+		CategoryList json.RawMessage `json:"category_list"`
+	})
+	if err = json.Unmarshal(raw, root); err != nil || len(root.CategoryList) == 0 || string(root.CategoryList) == "null" {
+		return json.RawMessage("{}"), nil
+	}
+
+	return root.CategoryList, nil
+}
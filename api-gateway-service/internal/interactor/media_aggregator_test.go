@@ -0,0 +1,69 @@
+package interactor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// fetcherFunc fake fetcher, resources are indexed by backend
+type fetcherFunc func(ctx context.Context, backend *domain.Backend, path string) (json.RawMessage, error)
+
+func (f fetcherFunc) Fetch(ctx context.Context, backend *domain.Backend, path, _ string) (json.RawMessage, error) {
+	return f(ctx, backend, path)
+}
+
+func TestMediaAggregator_Get(t *testing.T) {
+	backends := domain.BackendRegistry{}
+	for _, name := range []string{"media", "author", "category"} {
+		backends[name] = &domain.Backend{Name: name, HTTP: "http://" + name, Timeout: 50 * time.Millisecond}
+	}
+
+	authorDown := false
+	aggregator := NewMediaAggregator(log.NewNopLogger(), backends,
+		fetcherFunc(func(ctx context.Context, backend *domain.Backend, path string) (json.RawMessage, error) {
+			switch backend.Name {
+			case "media":
+				if path != "/v1/media/123" {
+					return nil, exception.EntityNotFound
+				}
+				return json.RawMessage(`{"id":"123","authors":[{"author_id":"abc","role":"author"},{"author_id":"def","role":"editor"}]}`), nil
+			case "author":
+				if authorDown {
+					<-ctx.Done()
+					return nil, exception.NewErrorDescription(domain.ErrBackendUnavailable, "backend author timed out")
+				}
+				return json.RawMessage(`{"id":"abc"}`), nil
+			default:
+				return json.RawMessage(`{"category_list":{"1":"Science"}}`), nil
+			}
+		}))
+
+	aggregate, err := aggregator.Get(context.Background(), "123", "author,categories")
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"id":"abc"}`, string(aggregate.Author))
+	assert.JSONEq(t, `{"1":"Science"}`, string(aggregate.Categories))
+	assert.Empty(t, aggregate.Errors)
+
+	// Failed expansions do not fail the read
+	authorDown = true
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	aggregate, err = aggregator.Get(ctx, "123", "author,categories")
+	assert.Nil(t, err)
+	assert.Nil(t, aggregate.Author)
+	assert.NotNil(t, aggregate.Categories)
+	assert.Equal(t, "backend author timed out", aggregate.Errors[domain.ExpandAuthor])
+
+	_, err = aggregator.Get(context.Background(), "404", "author")
+	assert.True(t, errors.Is(err, exception.EntityNotFound))
+	_, err = aggregator.Get(context.Background(), "123", "owners")
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+}
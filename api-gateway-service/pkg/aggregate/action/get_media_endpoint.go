@@ -0,0 +1,59 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type GetMediaRequest struct {
+	ID     string `json:"id"`
+	Expand string `json:"expand"`
+}
+
+type GetMediaResponse struct {
+	Aggregate *domain.MediaAggregate `json:"aggregate"`
+	Err       error                  `json:"-"`
+}
+
+func MakeGetMediaEndpoint(svc usecase.MediaAggregateInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(GetMediaRequest)
+		aggregate, err := svc.Get(ctx, req.ID, req.Expand)
+		if err != nil {
+			return GetMediaResponse{
+				Aggregate: nil,
+				Err:       err,
+			}, err
+		}
+
+		return GetMediaResponse{
+			Aggregate: aggregate,
+			Err:       nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "get_media"
+	ep = middleware.WrapResiliency(ep, "aggregate", action)
+	return middleware.WrapInstrumentation(ep, "aggregate", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetMediaResponse{}
+)
+
+func (r GetMediaResponse) Failed() error { return r.Err }
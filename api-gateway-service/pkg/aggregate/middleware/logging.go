@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/usecase"
+	"time"
+)
+
+type LoggingMediaAggregateMiddleware struct {
+	Logger log.Logger
+	Next   usecase.MediaAggregateInteractor
+}
+
+func (mw LoggingMediaAggregateMiddleware) Get(ctx context.Context, id, expand string) (output *domain.MediaAggregate, err error) {
+	defer func(begin time.Time) {
+		errs := 0
+		if output != nil {
+			errs = len(output.Errors)
+		}
+		_ = mw.Logger.Log(
+			"method", "aggregate.media.get",
+			"input", fmt.Sprintf("id: %s, expand: %s", id, expand),
+			"output", fmt.Sprintf("partial errors: %d", errs),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Get(ctx, id, expand)
+	return
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/usecase"
+	"time"
+)
+
+type MetricMediaAggregateMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.MediaAggregateInteractor
+}
+
+func (mw MetricMediaAggregateMiddleware) Get(ctx context.Context, id, expand string) (output *domain.MediaAggregate, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "aggregate.media.get", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Get(ctx, id, expand)
+	return
+}
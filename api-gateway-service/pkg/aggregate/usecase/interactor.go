@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"context"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+)
+
+type MediaAggregateInteractor interface {
+	Get(ctx context.Context, id, expand string) (*domain.MediaAggregate, error)
+}
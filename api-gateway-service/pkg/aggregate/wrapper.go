@@ -0,0 +1,42 @@
+package aggregate
+
+import (
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/middleware"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/usecase"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// WrapMediaAggregateInstrumentation Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapMediaAggregateInstrumentation(aggregator usecase.MediaAggregateInteractor, logger log.Logger) usecase.MediaAggregateInteractor {
+	// Inject Prometheus metrics
+	// Inject logger
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "gateway_service",
+		Name:        "request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "gateway_service",
+		Name:        "request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.MediaAggregateInteractor
+	svc = aggregator
+	svc = middleware.LoggingMediaAggregateMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricMediaAggregateMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
@@ -0,0 +1,177 @@
+// +build wireinject
+
+package dep
+
+import (
+	"context"
+	oczipkin "contrib.go.opencensus.io/exporter/zipkin"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/tracer"
+	"github.com/alexandria-oss/core/transport/proxy"
+	"github.com/go-kit/kit/log"
+	"github.com/google/wire"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/dependency"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/usecase"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/transport"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/transport/geo"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/transport/limit"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/openzipkin/zipkin-go"
+	"github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/reporter"
+	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+)
+
+var Ctx = context.Background()
+
+var interactorSet = wire.NewSet(
+	provideContext,
+	logger.NewZapLogger,
+	provideMediaAggregator,
+)
+
+var zipkinSet = wire.NewSet(
+	provideZipkinReporter,
+	provideZipkinEndpoint,
+	provideZipkinTracer,
+)
+
+var httpProxySet = wire.NewSet(
+	interactorSet,
+	config.NewKernel,
+	zipkinSet,
+	tracer.WrapZipkinOpenTracing,
+	dependency.InjectBackendRegistry,
+	auth.NewAuthenticator,
+	limit.NewRateLimiter,
+	geo.NewCountry,
+	bind.NewAggregateHTTP,
+	bind.NewReverseProxy,
+	provideHTTPHandlers,
+	transport.NewHTTPServer,
+)
+
+var rpcProxySet = wire.NewSet(
+	bind.NewRPCProxy,
+	provideRPCPolicy,
+	provideRPCProxy,
+)
+
+func provideContext() context.Context {
+	return Ctx
+}
+
+func provideMediaAggregator(logger log.Logger) (usecase.MediaAggregateInteractor, func(), error) {
+	aggregator, cleanup, err := dependency.InjectMediaAggregator()
+	return aggregate.WrapMediaAggregateInstrumentation(aggregator, logger), cleanup, err
+}
+
+// Bind/Map used http handlers, aggregated reads are mapped before the reverse proxy to take precedence
+func provideHTTPHandlers(aggregateHandler *bind.AggregateHandler, reverseProxy *bind.ReverseProxy,
+	authenticator *auth.Authenticator) []proxy.Handler {
+	handlers := make([]proxy.Handler, 0)
+	handlers = append(handlers, authenticator, aggregateHandler, reverseProxy)
+	return handlers
+}
+
+// Bind/Map access level of every proxied rpc method, unknown methods are private
+func provideRPCPolicy(backends domain.BackendRegistry) auth.Policy {
+	policy := auth.Policy{}
+	for _, backend := range backends {
+		for _, method := range backend.PublicMethods {
+			policy[method] = auth.Public
+		}
+		for _, method := range backend.AdminMethods {
+			policy[method] = auth.Admin
+		}
+	}
+	return policy
+}
+
+// provideRPCProxy replaces proxy.NewRPC, every call is forwarded by the rpc proxy, grpc-go v1.27 accepts a
+// single stream interceptor so callers are throttled and get their country replaced before they are authenticated
+func provideRPCProxy(rpcProxy *bind.RPCProxy, limiter *limit.RateLimiter, country *geo.Country,
+	authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	throttle := limiter.StreamServerInterceptor()
+	locate := country.StreamServerInterceptor()
+	authorize := authenticator.StreamServerInterceptor(policy)
+	rpcServer := grpc.NewServer(
+		grpc.CustomCodec(bind.RawCodec{}),
+		grpc.UnknownServiceHandler(rpcProxy.Handler),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+			return throttle(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+				return locate(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+					return authorize(srv, ss, info, handler)
+				})
+			})
+		}),
+	)
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+/* ZIPKIN PROVIDERS */
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinReporter(cfg *config.Kernel) reporter.Reporter {
+	if cfg.Tracing.ZipkinHost != "" {
+		zipkinReporter := zipkinhttp.NewReporter(cfg.Tracing.ZipkinHost)
+
+		return zipkinReporter
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinEndpoint(cfg *config.Kernel) *model.Endpoint {
+	if cfg.Tracing.ZipkinEndpoint != "" {
+		zipkinEndpoint, err := zipkin.NewEndpoint(cfg.Service, cfg.Tracing.ZipkinEndpoint)
+		if err != nil {
+			return nil
+		}
+
+		return zipkinEndpoint
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinTracer(r reporter.Reporter, ep *model.Endpoint) (*zipkin.Tracer, func()) {
+	if r != nil && ep != nil {
+		// Start OpenCensus
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+		// Add Zipkin exporter
+		trace.RegisterExporter(oczipkin.NewExporter(r, ep))
+
+		zipkinTrace, err := zipkin.NewTracer(r, zipkin.WithLocalEndpoint(ep))
+		if err != nil {
+			return nil, nil
+		}
+		cleanup := func() {
+			_ = r.Close()
+		}
+
+		return zipkinTrace, cleanup
+	}
+
+	return nil, nil
+}
+
+func InjectTransportService() (*transport.Gateway, func(), error) {
+	wire.Build(httpProxySet, rpcProxySet, transport.NewGateway)
+
+	return &transport.Gateway{}, nil, nil
+}
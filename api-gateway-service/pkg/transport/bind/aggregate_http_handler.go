@@ -0,0 +1,95 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/action"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/aggregate/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+)
+
+type AggregateHandler struct {
+	service      usecase.MediaAggregateInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewAggregateHTTP(svc usecase.MediaAggregateInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *AggregateHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "gateway_service",
+		Name:        "request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("gateway_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &AggregateHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy, reads without expansions are forwarded as-is
+// to the media service, hence this handler must be mapped before the reverse proxy
+func (h *AggregateHandler) SetRoutes(public, _, _ *mux.Router) {
+	// Public routing
+	public.Path("/media/{id}").Methods(http.MethodGet).Queries("expand", "{expand}").Handler(h.GetMedia())
+}
+
+func (h *AggregateHandler) GetMedia() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeGetMediaEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeGetMediaRequest,
+		encodeGetMediaResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "GetMedia", h.logger)))...,
+	)
+}
+
+/* Decoders */
+
+func decodeGetMediaRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.GetMediaRequest{
+		ID:     mux.Vars(r)["id"],
+		Expand: r.URL.Query().Get("expand"),
+	}, nil
+}
+
+/* Encoders */
+
+func encodeGetMediaResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.GetMediaResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	}
+
+	// Partial failures are reported in the aggregate's errors field, the media itself was found
+	return json.NewEncoder(w).Encode(r.Aggregate)
+}
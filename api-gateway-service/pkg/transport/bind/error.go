@@ -0,0 +1,31 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+)
+
+// responseErrJSON extends httputil.ResponseErrJSON, unavailable backends are written as 502
+func responseErrJSON(ctx context.Context, err error, w http.ResponseWriter) {
+	if !errors.Is(err, domain.ErrBackendUnavailable) {
+		httputil.ResponseErrJSON(ctx, err, w)
+		return
+	}
+
+	writeGenericResponse(w, http.StatusBadGateway, exception.GetErrorDescription(err))
+}
+
+func writeGenericResponse(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(&httputil.GenericResponse{
+		Message: message,
+		Code:    code,
+	})
+}
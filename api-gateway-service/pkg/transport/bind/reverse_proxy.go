@@ -0,0 +1,95 @@
+package bind
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	stdhttputil "net/http/httputil"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+)
+
+// ReverseProxy forwards every resource request to its backend, the path is kept as-is
+// (e.g. /v1/private/media/123 -> http://media:8080/v1/private/media/123)
+type ReverseProxy struct {
+	backends domain.BackendRegistry
+	proxies  map[string]*stdhttputil.ReverseProxy
+	logger   log.Logger
+}
+
+func NewReverseProxy(backends domain.BackendRegistry, logger log.Logger) (*ReverseProxy, error) {
+	proxies := make(map[string]*stdhttputil.ReverseProxy)
+	for name, backend := range backends {
+		if backend.HTTP == "" {
+			continue
+		}
+
+		target, err := url.Parse(backend.HTTP)
+		if err != nil || target.Host == "" {
+			return nil, fmt.Errorf("invalid http url of backend %s", name)
+		}
+
+		proxies[name] = newBackendProxy(target, backend, logger)
+	}
+
+	return &ReverseProxy{
+		backends: backends,
+		proxies:  proxies,
+		logger:   logger,
+	}, nil
+}
+
+func newBackendProxy(target *url.URL, backend *domain.Backend, logger log.Logger) *stdhttputil.ReverseProxy {
+	p := stdhttputil.NewSingleHostReverseProxy(target)
+	p.Transport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   backend.Timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConnsPerHost:   32,
+		IdleConnTimeout:       90 * time.Second,
+		ResponseHeaderTimeout: backend.Timeout,
+	}
+	p.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		_ = logger.Log("method", "gateway.transport.reverse_proxy", "backend", backend.Name, "err", err.Error())
+
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() || errors.Is(err, context.DeadlineExceeded) {
+			writeGenericResponse(w, http.StatusGatewayTimeout, fmt.Sprintf("backend %s timed out", backend.Name))
+			return
+		}
+
+		writeGenericResponse(w, http.StatusBadGateway, fmt.Sprintf("backend %s is not reachable", backend.Name))
+	}
+
+	return p
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (p *ReverseProxy) SetRoutes(public, private, admin *mux.Router) {
+	// Sorted to keep routing deterministic
+	names := make([]string, 0, len(p.proxies))
+	for name := range p.proxies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, resource := range p.backends[name].Resources {
+			resource = "/" + strings.Trim(resource, "/")
+			for _, r := range []*mux.Router{public, private, admin} {
+				r.Path(resource).Handler(p.proxies[name])
+				r.PathPrefix(resource + "/").Handler(p.proxies[name])
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package bind
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/maestre3d/alexandria/api-gateway-service/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RPCProxy transparent gRPC proxy, calls are routed to their backend by service name
+// (e.g. /pb.Media/Get -> media:31337) and messages are forwarded without decoding them
+type RPCProxy struct {
+	backends domain.BackendRegistry
+	conns    map[string]*grpc.ClientConn
+}
+
+func NewRPCProxy(backends domain.BackendRegistry) (*RPCProxy, func(), error) {
+	conns := make(map[string]*grpc.ClientConn)
+	cleanup := func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}
+
+	for name, backend := range backends {
+		if backend.RPC == "" || len(backend.Services) == 0 {
+			continue
+		}
+
+		// Connections are established lazily, unavailable backends do not block the gateway
+		conn, err := grpc.Dial(backend.RPC, grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.ForceCodec(RawCodec{})))
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("could not dial rpc backend %s: %w", name, err)
+		}
+		conns[name] = conn
+	}
+
+	return &RPCProxy{
+		backends: backends,
+		conns:    conns,
+	}, cleanup, nil
+}
+
+// Handler forwards the incoming stream to its backend, unary calls are handled as streams too,
+// hence it must be set as the gRPC server's grpc.UnknownServiceHandler
+func (p *RPCProxy) Handler(_ interface{}, ss grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(ss)
+	if !ok {
+		return status.Error(codes.Internal, "could not get the called method")
+	}
+
+	service := strings.SplitN(strings.TrimPrefix(method, "/"), "/", 2)[0]
+	backend, ok := p.backends.ByService(service)
+	if !ok || p.conns[backend.Name] == nil {
+		return status.Errorf(codes.Unimplemented, "unknown service %s", service)
+	}
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md.Copy())
+	}
+
+	cs, err := grpc.NewClientStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true},
+		p.conns[backend.Name], method)
+	if err != nil {
+		return err
+	}
+
+	// Client to backend
+	sendErr := make(chan error, 1)
+	go func() {
+		for {
+			frame := new(RawFrame)
+			if err := ss.RecvMsg(frame); err != nil {
+				if err == io.EOF {
+					err = cs.CloseSend()
+				}
+				sendErr <- err
+				return
+			}
+			if err := cs.SendMsg(frame); err != nil {
+				sendErr <- err
+				return
+			}
+		}
+	}()
+
+	// Backend to client
+	header, err := cs.Header()
+	if err != nil {
+		return err
+	}
+	if err = ss.SendHeader(header); err != nil {
+		return err
+	}
+	for {
+		frame := new(RawFrame)
+		if err = cs.RecvMsg(frame); err != nil {
+			ss.SetTrailer(cs.Trailer())
+			if err == io.EOF {
+				return nil
+			}
+			// Backend status errors are returned as-is
+			return err
+		}
+		if err = ss.SendMsg(frame); err != nil {
+			return err
+		}
+
+		select {
+		case err = <-sendErr:
+			if err != nil && err != io.EOF {
+				return status.Error(codes.Internal, err.Error())
+			}
+			sendErr = nil
+		default:
+		}
+	}
+}
+
+// RawFrame undecoded gRPC message
+type RawFrame struct {
+	payload []byte
+}
+
+// RawCodec passes gRPC messages through without decoding them, implements both grpc.Codec
+// and encoding.Codec
+type RawCodec struct{}
+
+func (RawCodec) Marshal(v interface{}) ([]byte, error) {
+	frame, ok := v.(*RawFrame)
+	if !ok {
+		return nil, fmt.Errorf("raw codec cannot marshal %T", v)
+	}
+
+	return frame.payload, nil
+}
+
+func (RawCodec) Unmarshal(data []byte, v interface{}) error {
+	frame, ok := v.(*RawFrame)
+	if !ok {
+		return fmt.Errorf("raw codec cannot unmarshal %T", v)
+	}
+
+	frame.payload = append(frame.payload[:0], data...)
+	return nil
+}
+
+func (RawCodec) Name() string {
+	return "proto"
+}
+
+func (c RawCodec) String() string {
+	return c.Name()
+}
@@ -0,0 +1,19 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/alexandria-oss/core/config"
+	"google.golang.org/grpc"
+)
+
+// Gateway edge proxies of the platform, the gateway does not consume events
+type Gateway struct {
+	HTTPProxy *http.Server
+	RPCProxy  *grpc.Server
+	Config    *config.Kernel
+}
+
+func NewGateway(httpProxy *http.Server, rpcProxy *grpc.Server, cfg *config.Kernel) *Gateway {
+	return &Gateway{httpProxy, rpcProxy, cfg}
+}
@@ -0,0 +1,80 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func init() {
+	viper.SetDefault("alexandria.gateway.country_header", "")
+}
+
+// CountryHeader header holding the client's country code (ISO 3166 Alpha-2) forwarded to the backing services,
+// x-country-code through gRPC
+const CountryHeader = "X-Country-Code"
+
+// Country propagates the client's country to the backing services, country codes sent by clients are always
+// stripped and replaced by the header of the trusted load balancer (e.g. CloudFront-Viewer-Country)
+type Country struct {
+	source string
+}
+
+// NewCountry returns a country propagator using alexandria.gateway.country_header, an empty header forwards
+// no country at all
+func NewCountry() *Country {
+	return newCountry(viper.GetString("alexandria.gateway.country_header"))
+}
+
+func newCountry(source string) *Country {
+	return &Country{source: source}
+}
+
+// Middleware returns an HTTP middleware replacing the client's X-Country-Code header
+func (c *Country) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Del(CountryHeader)
+		if c.source != "" {
+			if country := r.Header.Get(c.source); country != "" {
+				r.Header.Set(CountryHeader, country)
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// StreamServerInterceptor returns a gRPC interceptor replacing the client's x-country-code metadata
+func (c *Country) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, ok := metadata.FromIncomingContext(ss.Context())
+		if !ok {
+			md = metadata.MD{}
+		}
+		md = md.Copy()
+
+		key := strings.ToLower(CountryHeader)
+		delete(md, key)
+		if c.source != "" {
+			if v := md.Get(c.source); len(v) > 0 && v[0] != "" {
+				md.Set(key, v[0])
+			}
+		}
+
+		return handler(srv, countryServerStream{ss, metadata.NewIncomingContext(ss.Context(), md)})
+	}
+}
+
+// countryServerStream server stream holding the replaced metadata
+type countryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s countryServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,63 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCountry_Middleware(t *testing.T) {
+	call := func(c *Country, header http.Header) string {
+		country := ""
+		handler := c.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			country = r.Header.Get(CountryHeader)
+		}))
+		r := httptest.NewRequest(http.MethodGet, "/v1/media/1", nil)
+		for k, v := range header {
+			r.Header[k] = v
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		return country
+	}
+
+	// Client values are never forwarded
+	spoofed := http.Header{CountryHeader: {"MX"}}
+	assert.Equal(t, "", call(newCountry(""), spoofed))
+	assert.Equal(t, "", call(newCountry("CloudFront-Viewer-Country"), spoofed))
+
+	assert.Equal(t, "US", call(newCountry("CloudFront-Viewer-Country"), http.Header{
+		CountryHeader:               {"MX"},
+		"Cloudfront-Viewer-Country": {"US"},
+	}))
+}
+
+type countryTestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s countryTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestCountry_StreamServerInterceptor(t *testing.T) {
+	interceptor := newCountry("cloudfront-viewer-country").StreamServerInterceptor()
+	call := func(md metadata.MD) []string {
+		var country []string
+		_ = interceptor(nil, countryTestStream{ctx: metadata.NewIncomingContext(context.Background(), md)},
+			&grpc.StreamServerInfo{FullMethod: "/pb.Media/Get"}, func(_ interface{}, ss grpc.ServerStream) error {
+				md, _ := metadata.FromIncomingContext(ss.Context())
+				country = md.Get("x-country-code")
+				return nil
+			})
+		return country
+	}
+
+	assert.Empty(t, call(metadata.Pairs("x-country-code", "MX")))
+	assert.Equal(t, []string{"US"}, call(metadata.Pairs("x-country-code", "MX", "cloudfront-viewer-country", "US")))
+}
@@ -0,0 +1,64 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/alexandria-oss/core/transport/proxy"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/transport/geo"
+	"github.com/maestre3d/alexandria/api-gateway-service/pkg/transport/limit"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/cors"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.gateway.cors.origins", []string{"*"})
+	viper.SetDefault("alexandria.gateway.cors.methods", []string{http.MethodGet, http.MethodPost,
+		http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodHead})
+	viper.SetDefault("alexandria.gateway.cors.headers", []string{"Authorization", "Content-Type"})
+	viper.SetDefault("alexandria.gateway.cors.credentials", false)
+	viper.SetDefault("alexandria.gateway.cors.max_age", 600)
+}
+
+// NewHTTPServer replaces proxy.NewHTTP, CORS policy is taken from alexandria.gateway.cors and every
+// request goes through the rate limiter and gets its country replaced, handlers are mapped in the given order
+func NewHTTPServer(cfg *config.Kernel, limiter *limit.RateLimiter, country *geo.Country,
+	handlers ...proxy.Handler) (*http.Server, func()) {
+	r := mux.NewRouter()
+	public := r.PathPrefix(core.PublicAPI).Subrouter()
+	private := r.PathPrefix(core.PrivateAPI).Subrouter()
+	admin := r.PathPrefix(core.AdminAPI).Subrouter()
+
+	public.Path("/health").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, `{"alive":true}`)
+	})
+	admin.Path("/metrics").Methods(http.MethodGet).Handler(promhttp.Handler())
+
+	for _, handler := range handlers {
+		handler.SetRoutes(public, private, admin)
+	}
+
+	c := cors.New(cors.Options{
+		AllowedOrigins:   viper.GetStringSlice("alexandria.gateway.cors.origins"),
+		AllowedMethods:   viper.GetStringSlice("alexandria.gateway.cors.methods"),
+		AllowedHeaders:   viper.GetStringSlice("alexandria.gateway.cors.headers"),
+		AllowCredentials: viper.GetBool("alexandria.gateway.cors.credentials"),
+		MaxAge:           viper.GetInt("alexandria.gateway.cors.max_age"),
+	})
+
+	// Preflight requests are answered by the CORS handler before they are throttled
+	server := httputil.DefaultServer(cfg, c.Handler(limiter.Middleware(country.Middleware(r))))
+	cleanup := func() {
+		_ = server.Shutdown(context.Background())
+	}
+
+	return server, cleanup
+}
@@ -0,0 +1,164 @@
+package limit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	viper.SetDefault("alexandria.gateway.rate_limit.rps", 20)
+	viper.SetDefault("alexandria.gateway.rate_limit.burst", 40)
+	viper.SetDefault("alexandria.gateway.rate_limit.ttl", "10m")
+	viper.SetDefault("alexandria.gateway.rate_limit.trust_proxy", false)
+}
+
+// errTooManyRequests message returned to throttled clients
+const errTooManyRequests = "too many requests"
+
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter token bucket rate limiter per client address, idle clients are evicted after the ttl
+type RateLimiter struct {
+	rps        rate.Limit
+	burst      int
+	ttl        time.Duration
+	trustProxy bool
+	clients    map[string]*client
+	mu         *sync.Mutex
+	now        func() time.Time
+}
+
+// NewRateLimiter returns a rate limiter using alexandria.gateway.rate_limit, a non-positive rps
+// disables the limiter
+func NewRateLimiter() *RateLimiter {
+	return newRateLimiter(viper.GetFloat64("alexandria.gateway.rate_limit.rps"),
+		viper.GetInt("alexandria.gateway.rate_limit.burst"), viper.GetDuration("alexandria.gateway.rate_limit.ttl"),
+		viper.GetBool("alexandria.gateway.rate_limit.trust_proxy"))
+}
+
+func newRateLimiter(rps float64, burst int, ttl time.Duration, trustProxy bool) *RateLimiter {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rps)))
+	}
+
+	return &RateLimiter{
+		rps:        rate.Limit(rps),
+		burst:      burst,
+		ttl:        ttl,
+		trustProxy: trustProxy,
+		clients:    make(map[string]*client),
+		mu:         new(sync.Mutex),
+		now:        time.Now,
+	}
+}
+
+// Allow reports whether the given client may perform a request now, if not the time to wait
+// before the next request is returned
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	if l.rps <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.evict(now)
+	c, ok := l.clients[key]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(l.rps, l.burst)}
+		l.clients[key] = c
+	}
+	c.lastSeen = now
+
+	r := c.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return false, delay
+	}
+
+	return true, 0
+}
+
+// evict removes clients idle for longer than the ttl
+func (l *RateLimiter) evict(now time.Time) {
+	if l.ttl <= 0 {
+		return
+	}
+
+	for key, c := range l.clients {
+		if now.Sub(c.lastSeen) > l.ttl {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// Middleware returns an HTTP middleware rejecting throttled clients with 429 Too Many Requests
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ok, delay := l.Allow(l.clientAddr(r)); !ok {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.Header().Set("Retry-After", fmt.Sprintf("%d", int(math.Ceil(delay.Seconds()))))
+			w.WriteHeader(http.StatusTooManyRequests)
+			_ = json.NewEncoder(w).Encode(&httputil.GenericResponse{
+				Message: errTooManyRequests,
+				Code:    http.StatusTooManyRequests,
+			})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// StreamServerInterceptor returns a gRPC interceptor rejecting throttled clients with ResourceExhausted
+func (l *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		key := ""
+		if p, ok := peer.FromContext(ss.Context()); ok && p.Addr != nil {
+			key = host(p.Addr.String())
+		}
+
+		if ok, _ := l.Allow(key); !ok {
+			return status.Error(codes.ResourceExhausted, errTooManyRequests)
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// clientAddr returns the client's address, X-Forwarded-For is only used behind a trusted proxy
+func (l *RateLimiter) clientAddr(r *http.Request) string {
+	if l.trustProxy {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	return host(r.RemoteAddr)
+}
+
+func host(addr string) string {
+	if h, _, err := net.SplitHostPort(addr); err == nil {
+		return h
+	}
+
+	return addr
+}
@@ -0,0 +1,42 @@
+package limit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(1, 2, time.Minute, false)
+	l.now = func() time.Time { return now }
+
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	call := func(addr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/v1/media", nil)
+		r.RemoteAddr = addr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	// Burst is consumed, clients are limited independently
+	assert.Equal(t, http.StatusOK, call("10.0.0.1:1000").Code)
+	assert.Equal(t, http.StatusOK, call("10.0.0.1:1001").Code)
+	w := call("10.0.0.1:1002")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, call("10.0.0.2:1000").Code)
+
+	// Tokens are refilled at the given rate
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, call("10.0.0.1:1003").Code)
+
+	// Idle clients are evicted
+	now = now.Add(2 * time.Minute)
+	l.Allow("10.0.0.3")
+	assert.Len(t, l.clients, 1)
+}
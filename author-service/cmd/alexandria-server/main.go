@@ -28,6 +28,12 @@ func main() {
 		cleanup()
 	}()
 
+	watchdog, cleanupWatchdog, err := dep.InjectSAGAWatchdog()
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupWatchdog()
+
 	// Manage goroutines
 	var g run.Group
 	{
@@ -67,6 +73,15 @@ func main() {
 			transport.EventProxy.Server.Close()
 		})
 	}
+	{
+		ctxW, cancelW := context.WithCancel(ctx)
+		g.Add(func() error {
+			log.Print("starting saga watchdog")
+			return watchdog.Serve(ctxW)
+		}, func(error) {
+			cancelW()
+		})
+	}
 	{
 		// Set up signal bind
 		var (
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Shopify/sarama"
+	"github.com/alexandria-oss/core/config"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/kafkapubsub"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// dlq-replay reads a <TOPIC>_DLQ topic from the beginning using an ephemeral consumer group
+//
+// Usage:
+//	dlq-replay -topic MEDIA_OWNER_VERIFIED list
+//	dlq-replay -topic MEDIA_OWNER_VERIFIED inspect <event_id>
+//	dlq-replay -topic MEDIA_OWNER_VERIFIED -root <root_id> -from 2020-06-01T00:00:00Z replay
+//
+// Replayed messages keep their original metadata, event consumers are idempotent so a message
+// replayed twice is applied once
+
+type filter struct {
+	eventType string
+	rootID    string
+	eventID   string
+	from      time.Time
+	to        time.Time
+}
+
+func (f filter) matches(m *pubsub.Message) bool {
+	if f.eventType != "" && !strings.EqualFold(m.Metadata["event_type"], f.eventType) {
+		return false
+	} else if f.rootID != "" && m.Metadata["root_id"] != f.rootID {
+		return false
+	} else if f.eventID != "" && m.Metadata["event_id"] != f.eventID {
+		return false
+	}
+
+	if !f.from.IsZero() || !f.to.IsZero() {
+		failedTime := failedAt(m)
+		if !f.from.IsZero() && failedTime.Before(f.from) {
+			return false
+		} else if !f.to.IsZero() && failedTime.After(f.to) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func failedAt(m *pubsub.Message) time.Time {
+	unix, err := strconv.ParseInt(m.Metadata[messaging.DeadLetterFailedTime], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(unix, 0)
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func main() {
+	topic := flag.String("topic", "", "original topic, its dead-letter topic (<TOPIC>"+messaging.DeadLetterSuffix+") will be read")
+	eventType := flag.String("event-type", "", "filter by event type")
+	rootID := flag.String("root", "", "filter by root_id")
+	from := flag.String("from", "", "filter messages failed after the given time (RFC3339)")
+	to := flag.String("to", "", "filter messages failed before the given time (RFC3339)")
+	wait := flag.Duration("wait", 10*time.Second, "stop reading once no message was received within the given duration")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "usage: dlq-replay -topic TOPIC [flags] list|inspect EVENT_ID|replay\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	cmd := flag.Arg(0)
+	if *topic == "" || (cmd != "list" && cmd != "inspect" && cmd != "replay") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f := filter{
+		eventType: *eventType,
+		rootID:    *rootID,
+	}
+	var err error
+	if f.from, err = parseTime(*from); err != nil {
+		log.Fatalf("invalid from time: %v", err)
+	}
+	if f.to, err = parseTime(*to); err != nil {
+		log.Fatalf("invalid to time: %v", err)
+	}
+	if cmd == "inspect" {
+		if f.eventID = flag.Arg(1); f.eventID == "" {
+			log.Fatal("inspect requires an event_id")
+		}
+	}
+
+	ctx := context.Background()
+	cfg, err := config.NewKernel(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = run(ctx, cfg.EventBus.KafkaBrokers, strings.ToUpper(*topic), cmd, f, *wait); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, brokers []string, topic, cmd string, f filter, wait time.Duration) error {
+	saramaCfg := kafkapubsub.MinimalConfig()
+	saramaCfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	group := fmt.Sprintf("DLQ_REPLAY_%d", time.Now().UnixNano())
+	sub, err := kafkapubsub.OpenSubscription(brokers, saramaCfg, group, []string{topic + messaging.DeadLetterSuffix},
+		&kafkapubsub.SubscriptionOptions{WaitForJoin: wait})
+	if err != nil {
+		return err
+	}
+	defer sub.Shutdown(ctx)
+
+	producers := make(map[string]*pubsub.Topic)
+	defer func() {
+		for _, p := range producers {
+			_ = p.Shutdown(ctx)
+		}
+	}()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+	if cmd == "list" {
+		_, _ = fmt.Fprintln(w, "EVENT_ID\tROOT_ID\tEVENT_TYPE\tOPERATION\tATTEMPTS\tFAILED_TIME\tERROR")
+	}
+
+	total := 0
+	for {
+		ctxR, cancel := context.WithTimeout(ctx, wait)
+		m, err := sub.Receive(ctxR)
+		cancel()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				break
+			}
+
+			return err
+		}
+		// Read-only consumer group, message is never removed from the dead-letter topic
+		m.Ack()
+
+		if !f.matches(m) {
+			continue
+		}
+		total++
+
+		switch cmd {
+		case "list":
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", m.Metadata["event_id"], m.Metadata["root_id"],
+				m.Metadata["event_type"], m.Metadata["operation"], m.Metadata[messaging.DeadLetterAttempts],
+				failedAt(m).Format(time.RFC3339), m.Metadata[messaging.DeadLetterError])
+		case "inspect":
+			messageJSON, err := json.MarshalIndent(struct {
+				Metadata map[string]string `json:"metadata"`
+				Body     string            `json:"body"`
+			}{
+				Metadata: m.Metadata,
+				Body:     string(m.Body),
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintln(w, string(messageJSON))
+		case "replay":
+			if err = replay(ctx, brokers, producers, m); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(w, "replayed %s to %s\n", m.Metadata["event_id"], m.Metadata[messaging.DeadLetterTopic])
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, "%d message(s) found\n", total)
+	return nil
+}
+
+// replay publishes the given dead-letter message to its original topic
+func replay(ctx context.Context, brokers []string, producers map[string]*pubsub.Topic, m *pubsub.Message) error {
+	topic := m.Metadata[messaging.DeadLetterTopic]
+	if topic == "" {
+		return fmt.Errorf("message %s has no original topic", m.Metadata["event_id"])
+	}
+
+	p, ok := producers[topic]
+	if !ok {
+		var err error
+		p, err = kafkapubsub.OpenTopic(brokers, kafkapubsub.MinimalConfig(), topic, nil)
+		if err != nil {
+			return err
+		}
+		producers[topic] = p
+	}
+
+	return p.Send(ctx, &pubsub.Message{
+		Body:       m.Body,
+		Metadata:   replayMetadata(m),
+		BeforeSend: nil,
+	})
+}
+
+// replayMetadata removes dead-letter headers but attempts, replayed messages failing again accumulate them
+func replayMetadata(m *pubsub.Message) map[string]string {
+	metadata := make(map[string]string, len(m.Metadata))
+	for k, v := range m.Metadata {
+		if !strings.HasPrefix(k, "dlq_") || k == messaging.DeadLetterAttempts {
+			metadata[k] = v
+		}
+	}
+
+	return metadata
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/stretchr/testify/assert"
+	"gocloud.dev/pubsub"
+)
+
+func newDeadLetterMessage(failedTime time.Time) *pubsub.Message {
+	return &pubsub.Message{
+		Body: []byte("alexandria"),
+		Metadata: map[string]string{
+			"event_id":                     "event",
+			"root_id":                      "root",
+			"event_type":                   "integration",
+			messaging.DeadLetterTopic:      "AUTHOR_VERIFY",
+			messaging.DeadLetterError:      "service unavailable",
+			messaging.DeadLetterAttempts:   "3",
+			messaging.DeadLetterService:    "AUTHOR",
+			messaging.DeadLetterFailedTime: strconv.FormatInt(failedTime.Unix(), 10),
+		},
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	failedTime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	m := newDeadLetterMessage(failedTime)
+
+	assert.True(t, filter{}.matches(m))
+	assert.True(t, filter{eventType: "INTEGRATION", rootID: "root", eventID: "event"}.matches(m))
+	assert.False(t, filter{eventType: "domain"}.matches(m))
+	assert.False(t, filter{rootID: "foo"}.matches(m))
+	assert.False(t, filter{eventID: "foo"}.matches(m))
+
+	assert.True(t, filter{from: failedTime.Add(-time.Hour), to: failedTime.Add(time.Hour)}.matches(m))
+	assert.False(t, filter{from: failedTime.Add(time.Minute)}.matches(m))
+	assert.False(t, filter{to: failedTime.Add(-time.Minute)}.matches(m))
+
+	// Messages without failure time are filtered out by time ranges only
+	delete(m.Metadata, messaging.DeadLetterFailedTime)
+	assert.True(t, filter{rootID: "root"}.matches(m))
+	assert.False(t, filter{from: failedTime}.matches(m))
+}
+
+func TestReplayMetadata(t *testing.T) {
+	metadata := replayMetadata(newDeadLetterMessage(time.Now()))
+
+	assert.Equal(t, map[string]string{
+		"event_id":                   "event",
+		"root_id":                    "root",
+		"event_type":                 "integration",
+		messaging.DeadLetterAttempts: "3",
+	}, metadata)
+}
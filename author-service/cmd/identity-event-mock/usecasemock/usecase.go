@@ -19,7 +19,13 @@ const (
 /* Use case */
 
 func ValidateOwners(ctx context.Context, author *domain.Author, transactionID, operation string) error {
-	err := getIdentity(author.OwnerID)
+	var ownerID string
+	var err error
+	for _, ownerID = range author.OwnerPool() {
+		if err = getIdentity(ownerID); err != nil {
+			break
+		}
+	}
 	if err != nil {
 		// Identity not found, publish AUTHOR_OWNER_FAILED
 		// This is supposed to be inside identity's use case event bus implementation
@@ -35,7 +41,7 @@ func ValidateOwners(ctx context.Context, author *domain.Author, transactionID, o
 			RootID:    author.ExternalID,
 			Operation: operation,
 			Code:      404,
-			Message:   fmt.Sprintf("%s: identity %s not found", exception.EntityNotFound.Error(), author.OwnerID),
+			Message:   fmt.Sprintf("%s: identity %s not found", exception.EntityNotFound.Error(), ownerID),
 		})
 
 		e := eventbus.NewEvent(ServiceName, eventbus.EventIntegration, eventbus.PriorityHigh, eventbus.ProviderAWS, mJSON)
@@ -4,6 +4,7 @@ go 1.13
 
 require (
 	contrib.go.opencensus.io/exporter/zipkin v0.1.1
+	github.com/Shopify/sarama v1.26.1
 	github.com/alexandria-oss/core v0.5.4-beta
 	github.com/go-kit/kit v0.10.0
 	github.com/go-playground/validator/v10 v10.3.0
@@ -13,6 +14,7 @@ require (
 	github.com/google/wire v0.4.0
 	github.com/gorilla/mux v1.7.3
 	github.com/lib/pq v1.1.1
+	github.com/maestre3d/alexandria/shared v0.0.0
 	github.com/matoous/go-nanoid v1.4.1
 	github.com/oklog/run v1.1.0
 	github.com/opentracing/opentracing-go v1.1.0
@@ -20,6 +22,7 @@ require (
 	github.com/prometheus/client_golang v1.5.1
 	github.com/rs/cors v1.7.0 // indirect
 	github.com/sony/gobreaker v0.4.1
+	github.com/spf13/viper v1.6.3
 	github.com/stretchr/testify v1.5.1
 	go.opencensus.io v0.22.3
 	go.uber.org/zap v1.14.1
@@ -28,3 +31,5 @@ require (
 	google.golang.org/grpc v1.27.1
 	google.golang.org/protobuf v1.24.0
 )
+
+replace github.com/maestre3d/alexandria/shared => ../shared
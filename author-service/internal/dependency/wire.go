@@ -12,6 +12,8 @@ import (
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/author-service/internal/domain"
 	"github.com/maestre3d/alexandria/author-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
 var Ctx context.Context = context.Background()
@@ -24,6 +26,10 @@ var dataSet = wire.NewSet(
 	logger.NewZapLogger,
 	wire.Bind(new(domain.AuthorRepository), new(*infrastructure.AuthorPQRepository)),
 	infrastructure.NewAuthorPQRepository,
+	wire.Bind(new(domain.PendingTransactionRepository), new(*infrastructure.AuthorPendingPQRepository)),
+	infrastructure.NewAuthorPendingPQRepository,
+	wire.Bind(new(domain.UnitOfWork), new(*pqutil.UnitOfWork)),
+	pqutil.NewUnitOfWork,
 )
 
 var eventSet = wire.NewSet(
@@ -39,12 +45,26 @@ func InjectAuthorUseCase() (*interactor.Author, func(), error) {
 	wire.Build(
 		dataSet,
 		eventSet,
+		wire.Bind(new(domain.CursorCodec), new(*infrastructure.CursorHMACCodec)),
+		infrastructure.NewCursorHMACCodec,
+		wire.Bind(new(domain.AuthorViewEvent), new(*infrastructure.AuthorViewKafkaEvent)),
+		infrastructure.NewAuthorViewKafkaEvent,
 		interactor.NewAuthor,
 	)
 
 	return &interactor.Author{}, nil, nil
 }
 
+func InjectAuthorViewAggregator() (*interactor.AuthorViewAggregator, func(), error) {
+	wire.Build(
+		dataSet,
+		infrastructure.NewViewPolicy,
+		interactor.NewAuthorViewAggregator,
+	)
+
+	return &interactor.AuthorViewAggregator{}, nil, nil
+}
+
 func InjectAuthorSAGAUseCase() (*interactor.AuthorSAGA, func(), error) {
 	wire.Build(
 		dataSet,
@@ -56,3 +76,42 @@ func InjectAuthorSAGAUseCase() (*interactor.AuthorSAGA, func(), error) {
 
 	return &interactor.AuthorSAGA{}, nil, nil
 }
+
+func InjectAuthorSAGAWatchdog() (*interactor.AuthorSAGAWatchdog, func(), error) {
+	wire.Build(
+		dataSet,
+		eventSet,
+		wire.Bind(new(domain.AuthorSAGAEventBus), new(*infrastructure.AuthorSAGAKafkaEventBus)),
+		infrastructure.NewAuthorSAGAKafkaEventBus,
+		interactor.NewAuthorSAGA,
+		infrastructure.NewSAGAPolicy,
+		interactor.NewAuthorSAGAWatchdog,
+	)
+
+	return &interactor.AuthorSAGAWatchdog{}, nil, nil
+}
+
+func InjectAuthorCascadeUseCase() (*interactor.AuthorCascade, func(), error) {
+	wire.Build(
+		dataSet,
+		eventSet,
+		wire.Bind(new(domain.CascadeReportRepository), new(*infrastructure.CascadeRedisRepository)),
+		infrastructure.NewCascadeRedisRepository,
+		infrastructure.NewCascadePolicy,
+		interactor.NewAuthorCascade,
+	)
+
+	return &interactor.AuthorCascade{}, nil, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		persistence.NewRedisPool,
+		wire.Bind(new(messaging.Store), new(*messaging.RedisStore)),
+		messaging.NewRedisStore,
+	)
+
+	return &messaging.RedisStore{}, nil, nil
+}
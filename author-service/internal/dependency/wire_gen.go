@@ -14,6 +14,8 @@ import (
 	"github.com/maestre3d/alexandria/author-service/internal/domain"
 	"github.com/maestre3d/alexandria/author-service/internal/infrastructure"
 	"github.com/maestre3d/alexandria/author-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
 // Injectors from wire.go:
@@ -35,9 +37,43 @@ func InjectAuthorUseCase() (*interactor.Author, func(), error) {
 		return nil, nil, err
 	}
 	authorPQRepository := infrastructure.NewAuthorPQRepository(db, client, logLogger)
+	cursorHMACCodec, err := infrastructure.NewCursorHMACCodec()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	authorKafkaEventBus := infrastructure.NewAuthorKafkaEventBus(kernel)
-	author := interactor.NewAuthor(logLogger, authorPQRepository, authorKafkaEventBus)
+	authorViewKafkaEvent, cleanup3 := infrastructure.NewAuthorViewKafkaEvent(kernel)
+	authorPendingPQRepository := infrastructure.NewAuthorPendingPQRepository(db, logLogger)
+	author := interactor.NewAuthor(logLogger, authorPQRepository, cursorHMACCodec, authorKafkaEventBus, authorViewKafkaEvent, authorPendingPQRepository)
 	return author, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectAuthorViewAggregator() (*interactor.AuthorViewAggregator, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	logLogger := logger.NewZapLogger()
+	authorPQRepository := infrastructure.NewAuthorPQRepository(db, client, logLogger)
+	viewPolicy := infrastructure.NewViewPolicy()
+	authorViewAggregator := interactor.NewAuthorViewAggregator(authorPQRepository, viewPolicy, logLogger)
+	return authorViewAggregator, func() {
 		cleanup2()
 		cleanup()
 	}, nil
@@ -62,19 +98,99 @@ func InjectAuthorSAGAUseCase() (*interactor.AuthorSAGA, func(), error) {
 	authorPQRepository := infrastructure.NewAuthorPQRepository(db, client, logLogger)
 	authorSAGAKafkaEventBus := infrastructure.NewAuthorSAGAKafkaEventBus(kernel)
 	authorKafkaEventBus := infrastructure.NewAuthorKafkaEventBus(kernel)
-	authorSAGA := interactor.NewAuthorSAGA(logLogger, authorPQRepository, authorSAGAKafkaEventBus, authorKafkaEventBus)
+	authorPendingPQRepository := infrastructure.NewAuthorPendingPQRepository(db, logLogger)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	authorSAGA := interactor.NewAuthorSAGA(logLogger, authorPQRepository, authorSAGAKafkaEventBus, authorKafkaEventBus, authorPendingPQRepository, unitOfWork)
 	return authorSAGA, func() {
 		cleanup2()
 		cleanup()
 	}, nil
 }
 
+func InjectAuthorSAGAWatchdog() (*interactor.AuthorSAGAWatchdog, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	authorPQRepository := infrastructure.NewAuthorPQRepository(db, client, logLogger)
+	authorSAGAKafkaEventBus := infrastructure.NewAuthorSAGAKafkaEventBus(kernel)
+	authorKafkaEventBus := infrastructure.NewAuthorKafkaEventBus(kernel)
+	authorPendingPQRepository := infrastructure.NewAuthorPendingPQRepository(db, logLogger)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	authorSAGA := interactor.NewAuthorSAGA(logLogger, authorPQRepository, authorSAGAKafkaEventBus, authorKafkaEventBus, authorPendingPQRepository, unitOfWork)
+	sagaPolicy := infrastructure.NewSAGAPolicy()
+	authorSAGAWatchdog := interactor.NewAuthorSAGAWatchdog(authorSAGA, authorPendingPQRepository, sagaPolicy, logLogger)
+	return authorSAGAWatchdog, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectAuthorCascadeUseCase() (*interactor.AuthorCascade, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	authorPQRepository := infrastructure.NewAuthorPQRepository(db, client, logLogger)
+	authorKafkaEventBus := infrastructure.NewAuthorKafkaEventBus(kernel)
+	cascadeRedisRepository := infrastructure.NewCascadeRedisRepository(client)
+	cascadePolicy, err := infrastructure.NewCascadePolicy()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authorCascade := interactor.NewAuthorCascade(logLogger, authorPQRepository, authorKafkaEventBus, cascadeRedisRepository, cascadePolicy)
+	return authorCascade, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisStore := messaging.NewRedisStore(client)
+	return redisStore, func() {
+		cleanup()
+	}, nil
+}
+
 // wire.go:
 
 var Ctx context.Context = context.Background()
 
 var dataSet = wire.NewSet(
-	provideContext, config.NewKernel, persistence.NewPostgresPool, persistence.NewRedisPool, logger.NewZapLogger, wire.Bind(new(domain.AuthorRepository), new(*infrastructure.AuthorPQRepository)), infrastructure.NewAuthorPQRepository,
+	provideContext, config.NewKernel, persistence.NewPostgresPool, persistence.NewRedisPool, logger.NewZapLogger, wire.Bind(new(domain.AuthorRepository), new(*infrastructure.AuthorPQRepository)), infrastructure.NewAuthorPQRepository, wire.Bind(new(domain.PendingTransactionRepository), new(*infrastructure.AuthorPendingPQRepository)), infrastructure.NewAuthorPendingPQRepository, wire.Bind(new(domain.UnitOfWork), new(*pqutil.UnitOfWork)), pqutil.NewUnitOfWork,
 )
 
 var eventSet = wire.NewSet(wire.Bind(new(domain.AuthorEventBus), new(*infrastructure.AuthorKafkaEventBus)), infrastructure.NewAuthorKafkaEventBus)
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/alexandria-oss/core"
+)
+
+// Supported listing sort keys
+const (
+	SortCreateTime  = "create_time"
+	SortUpdateTime  = "update_time"
+	SortTotalViews  = "total_views"
+	SortDisplayName = "display_name"
+	// SortRank full-text search relevance, only available with search_mode=fulltext
+	SortRank = "rank"
+)
+
+// Sorting directions
+const (
+	SortAscending  = "ASC"
+	SortDescending = "DESC"
+)
+
+// Cursor keyset pagination position, points to the boundary row of a page using its sort key value and external id
+// as tie-breaker. A cursor without ID points to the first page
+type Cursor struct {
+	Sort      string `json:"s"`
+	Direction string `json:"d"`
+	Value     string `json:"v,omitempty"`
+	ID        string `json:"i,omitempty"`
+	// Backward fetches the page before the boundary row
+	Backward bool `json:"b,omitempty"`
+}
+
+// CursorCodec turns cursors into opaque page tokens
+type CursorCodec interface {
+	Encode(cursor Cursor) (string, error)
+	Decode(token string) (*Cursor, error)
+}
+
+// NewCursor returns a first page cursor using the given filter params sorting,
+// filter_by sets the sort key and sort its direction
+func NewCursor(filter core.FilterParams) Cursor {
+	c := Cursor{}
+	switch strings.ToLower(filter["filter_by"]) {
+	case "id", SortCreateTime:
+		c.Sort, c.Direction = SortCreateTime, SortAscending
+	case "timestamp", SortUpdateTime:
+		c.Sort, c.Direction = SortUpdateTime, SortDescending
+	case SortDisplayName:
+		c.Sort, c.Direction = SortDisplayName, SortAscending
+	default:
+		c.Sort, c.Direction = SortTotalViews, SortDescending
+	}
+
+	if strings.ToLower(filter["search_mode"]) == "fulltext" && strings.IndexFunc(filter["query"], isWordRune) >= 0 {
+		c.Sort, c.Direction = SortRank, SortDescending
+	}
+
+	switch strings.ToUpper(filter["sort"]) {
+	case SortAscending:
+		c.Direction = SortAscending
+	case SortDescending:
+		c.Direction = SortDescending
+	}
+
+	return c
+}
+
+// NewAuthorCursor returns a cursor pointing to the given author using the sorting of c
+func NewAuthorCursor(c Cursor, author *Author, backward bool) Cursor {
+	c.ID = author.ExternalID
+	c.Backward = backward
+
+	switch c.Sort {
+	case SortCreateTime:
+		c.Value = author.CreateTime.UTC().Format(time.RFC3339Nano)
+	case SortUpdateTime:
+		c.Value = author.UpdateTime.UTC().Format(time.RFC3339Nano)
+	case SortDisplayName:
+		c.Value = author.DisplayName
+	case SortRank:
+		c.Value = strconv.FormatFloat(float64(author.Rank), 'g', -1, 32)
+	default:
+		c.Value = strconv.FormatInt(author.TotalViews, 10)
+	}
+
+	return c
+}
+
+// IsValid returns true if the cursor sort key and direction are supported
+func (c Cursor) IsValid() bool {
+	switch c.Sort {
+	case SortCreateTime, SortUpdateTime, SortTotalViews, SortDisplayName, SortRank:
+	default:
+		return false
+	}
+
+	return c.Direction == SortAscending || c.Direction == SortDescending
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
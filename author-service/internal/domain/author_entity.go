@@ -17,6 +17,8 @@ const (
 	PrivateOwner   ownershipType = "private"
 	StatusPending                = "STATUS_PENDING"
 	StatusDone                   = "STATUS_DONE"
+	// DefaultCountry ISO 3166-1 Alpha-2 country code of authors created without country
+	DefaultCountry = "us"
 )
 
 // Author entity
@@ -26,7 +28,7 @@ type Author struct {
 	FirstName     string     `json:"first_name" validate:"required,min=1,max=255,alphanumunicode"`
 	LastName      string     `json:"last_name" validate:"required,min=1,max=255,alphanumunicode"`
 	DisplayName   string     `json:"display_name" validate:"required,min=1,max=255"`
-	OwnerID       string     `json:"owner_id" validate:"required"`
+	Owners        []*Owner   `json:"owners" validate:"min=1,dive,required"`
 	OwnershipType string     `json:"ownership_type" validate:"required,oneof=public private"`
 	CreateTime    time.Time  `json:"create_time"`
 	UpdateTime    time.Time  `json:"update_time"`
@@ -37,10 +39,14 @@ type Author struct {
 	TotalViews    int64      `json:"total_views"`
 	Country       string     `json:"country" validate:"required,min=1,max=5,alphaunicode"`
 	Status        string     `json:"status,omitempty" validate:"required,oneof=STATUS_PENDING STATUS_DONE"`
+	// Highlighted full-text search matches, only set by search queries
+	Snippet *string `json:"snippet,omitempty"`
+	// Full-text search relevance, only set by search queries
+	Rank float32 `json:"-"`
 }
 
-// NewAuthor Create a new author
-func NewAuthor(firstName, lastName, displayName, ownershipType, ownerID, countryCode string) *Author {
+// NewAuthor Create a new author owned by the given owner
+func NewAuthor(firstName, lastName, displayName, ownershipType string, owner *Owner) *Author {
 	if displayName == "" {
 		if firstName == "" {
 			displayName = lastName
@@ -63,6 +69,10 @@ func NewAuthor(firstName, lastName, displayName, ownershipType, ownerID, country
 		return nil
 	}
 	var picture string
+	owners := make([]*Owner, 0)
+	if owner != nil {
+		owners = append(owners, owner)
+	}
 
 	return &Author{
 		ID:            0,
@@ -70,7 +80,7 @@ func NewAuthor(firstName, lastName, displayName, ownershipType, ownerID, country
 		FirstName:     firstName,
 		LastName:      lastName,
 		DisplayName:   displayName,
-		OwnerID:       ownerID,
+		Owners:        owners,
 		OwnershipType: ownershipType,
 		CreateTime:    time.Now(),
 		UpdateTime:    time.Now(),
@@ -79,7 +89,7 @@ func NewAuthor(firstName, lastName, displayName, ownershipType, ownerID, country
 		Verified:      false,
 		Picture:       &picture,
 		TotalViews:    0,
-		Country:       countryCode,
+		Country:       DefaultCountry,
 		Status:        StatusPending,
 	}
 }
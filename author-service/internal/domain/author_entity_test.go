@@ -32,5 +32,5 @@ func TestNewAuthor(t *testing.T) {
 		"69817804-4af4-4de1-83af-4a5f660d0018",
 		"owner"))
 
-	assert.Equal(t, nil, a2.IsValid())
+	assert.True(t, errors.Is(a2.IsValid(), exception.InvalidFieldFormat))
 }
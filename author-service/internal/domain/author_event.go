@@ -10,8 +10,9 @@ const (
 )
 
 type AuthorEventBus interface {
-	StartCreate(ctx context.Context, author Author) error
-	StartUpdate(ctx context.Context, author Author, backup Author) error
+	// StartCreate and StartUpdate start the SAGA transaction with the given ID, see PendingTransaction
+	StartCreate(ctx context.Context, transactionID string, author Author) error
+	StartUpdate(ctx context.Context, transactionID string, author Author, backup Author) error
 	Updated(ctx context.Context, author Author) error
 	Removed(ctx context.Context, id string) error
 	Restored(ctx context.Context, id string) error
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+const (
+	// RoleAdmin owners may edit, delete, transfer the author and manage its owner pool
+	RoleAdmin = "admin"
+	// RoleContrib owners may only edit the author
+	RoleContrib = "contrib"
+)
+
+// Owner user owning an author with the given role
+type Owner struct {
+	ID         string    `json:"owner_id" validate:"required"`
+	Role       string    `json:"role" validate:"required,oneof=admin contrib"`
+	CreateTime time.Time `json:"create_time"`
+}
+
+// NewOwner Create a new owner with the given role, unknown roles are rejected by Author.IsValid
+func NewOwner(id, role string) *Owner {
+	return &Owner{
+		ID:         id,
+		Role:       strings.ToLower(role),
+		CreateTime: time.Now(),
+	}
+}
+
+// ParseRole returns a valid owner role, contrib if no role was given, only admin and contrib are accepted
+func ParseRole(role string) (string, error) {
+	switch role = strings.ToLower(strings.TrimSpace(role)); role {
+	case RoleAdmin, RoleContrib:
+		return role, nil
+	case "":
+		return RoleContrib, nil
+	default:
+		return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "role", "["+RoleAdmin+" "+RoleContrib+"]"))
+	}
+}
+
+// Owner returns the owner with the given id, nil if the user does not own the author
+func (e Author) Owner(id string) *Owner {
+	for _, owner := range e.Owners {
+		if owner != nil && owner.ID == id {
+			return owner
+		}
+	}
+
+	return nil
+}
+
+// OwnerPool returns the id of every owner with any of the given roles, every owner if no role was given
+func (e Author) OwnerPool(roles ...string) []string {
+	pool := make([]string, 0, len(e.Owners))
+	for _, owner := range e.Owners {
+		if owner == nil {
+			continue
+		}
+		if len(roles) == 0 {
+			pool = append(pool, owner.ID)
+			continue
+		}
+
+		for _, role := range roles {
+			if owner.Role == role {
+				pool = append(pool, owner.ID)
+				break
+			}
+		}
+	}
+
+	return pool
+}
+
+// AddOwner appends the given owner to the owner pool
+func (e *Author) AddOwner(owner *Owner) error {
+	if owner == nil || owner.ID == "" {
+		return exception.NewErrorDescription(exception.RequiredField,
+			fmt.Sprintf(exception.RequiredFieldString, "owner_id"))
+	}
+	if e.Owner(owner.ID) != nil {
+		return exception.NewErrorDescription(exception.EntityExists,
+			fmt.Sprintf("user %s already owns the author", owner.ID))
+	}
+
+	e.Owners = append(e.Owners, owner)
+	return nil
+}
+
+// RemoveOwner removes the given owner from the owner pool, the last admin cannot be removed
+func (e *Author) RemoveOwner(id string) error {
+	owner := e.Owner(id)
+	if owner == nil {
+		return exception.NewErrorDescription(exception.EntityNotFound,
+			fmt.Sprintf("user %s does not own the author", id))
+	}
+	if owner.Role == RoleAdmin && len(e.OwnerPool(RoleAdmin)) == 1 {
+		return errLastAdmin
+	}
+
+	owners := make([]*Owner, 0, len(e.Owners)-1)
+	for _, o := range e.Owners {
+		if o != owner {
+			owners = append(owners, o)
+		}
+	}
+	e.Owners = owners
+	return nil
+}
+
+// ChangeRole sets the role of the given owner, the last admin cannot be demoted
+func (e *Author) ChangeRole(id, role string) error {
+	owner := e.Owner(id)
+	if owner == nil {
+		return exception.NewErrorDescription(exception.EntityNotFound,
+			fmt.Sprintf("user %s does not own the author", id))
+	}
+
+	role, err := ParseRole(role)
+	if err != nil {
+		return err
+	}
+	if owner.Role == RoleAdmin && role != RoleAdmin && len(e.OwnerPool(RoleAdmin)) == 1 {
+		return errLastAdmin
+	}
+
+	// Owners are copied on write, previous snapshots of the author are kept untouched
+	owners := make([]*Owner, 0, len(e.Owners))
+	for _, o := range e.Owners {
+		if o == owner {
+			o = &Owner{ID: owner.ID, Role: role, CreateTime: owner.CreateTime}
+		}
+		owners = append(owners, o)
+	}
+	e.Owners = owners
+	return nil
+}
+
+var errLastAdmin = exception.NewErrorDescription(exception.InvalidFieldRange,
+	fmt.Sprintf(exception.InvalidFieldRangeString, "admin owners", "1", "n"))
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthor_OwnerPool(t *testing.T) {
+	author := NewAuthor("Isaac", "Newton", "", "private", NewOwner("user-1", RoleAdmin))
+	assert.True(t, errors.Is(author.AddOwner(NewOwner("user-1", RoleContrib)), exception.EntityExists))
+	assert.Nil(t, author.AddOwner(NewOwner("user-2", "CONTRIB")))
+	assert.Equal(t, []string{"user-1", "user-2"}, author.OwnerPool())
+	assert.Equal(t, []string{"user-2"}, author.OwnerPool(RoleContrib))
+
+	// Authors always keep an admin
+	assert.True(t, errors.Is(author.RemoveOwner("user-1"), exception.InvalidFieldRange))
+	assert.True(t, errors.Is(author.ChangeRole("user-1", RoleContrib), exception.InvalidFieldRange))
+	assert.True(t, errors.Is(author.RemoveOwner("user-3"), exception.EntityNotFound))
+
+	assert.True(t, errors.Is(author.ChangeRole("user-2", "owner"), exception.InvalidFieldFormat))
+
+	assert.Nil(t, author.ChangeRole("user-2", RoleAdmin))
+	assert.Nil(t, author.RemoveOwner("user-1"))
+	assert.Equal(t, []string{"user-2"}, author.OwnerPool(RoleAdmin))
+	assert.Nil(t, author.IsValid())
+}
+
+func TestParseRole(t *testing.T) {
+	role, err := ParseRole(" Admin ")
+	assert.Nil(t, err)
+	assert.Equal(t, RoleAdmin, role)
+
+	role, err = ParseRole("")
+	assert.Nil(t, err)
+	assert.Equal(t, RoleContrib, role)
+
+	_, err = ParseRole("owner")
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+}
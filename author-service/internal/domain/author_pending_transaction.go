@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthorSAGATimedOut SAGA transaction could not be verified within the deadline, entity was rolled back
+const AuthorSAGATimedOut = "AUTHOR_SAGA_TIMED_OUT" // Produced
+
+// PendingTransaction running SAGA transaction of an entity in STATUS_PENDING, it keeps the
+// rollback snapshot (update operations only) and the total of re-emitted verification requests.
+// ID is sent within the verification request, responses carrying another ID belong to a replaced transaction
+type PendingTransaction struct {
+	ID         string    `json:"transaction_id"`
+	RootID     string    `json:"root_id"`
+	Operation  string    `json:"operation"`
+	Snapshot   string    `json:"snapshot"`
+	Attempts   int       `json:"attempts"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
+}
+
+// NewPendingTransaction returns a pending transaction for the given entity
+func NewPendingTransaction(rootID, operation, snapshot string) *PendingTransaction {
+	return &PendingTransaction{
+		ID:         uuid.New().String(),
+		RootID:     rootID,
+		Operation:  operation,
+		Snapshot:   snapshot,
+		Attempts:   0,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}
+}
+
+// PendingTransactionRepository keeps running SAGA transactions, only one transaction per entity.
+// Every operation must use the running unit of work (if any) to be committed along with the entity change
+type PendingTransactionRepository interface {
+	// Save stores the given transaction, replaces the entity's running transaction if any
+	Save(ctx context.Context, tx PendingTransaction) error
+	// FetchExpired leases transactions which were not updated since the given time, leased transactions
+	// are skipped by concurrent lookups until they expire again
+	FetchExpired(ctx context.Context, since time.Time, limit int) ([]*PendingTransaction, error)
+	// Attempt renews the given running transaction with renewedID and increments the re-emitted verification
+	// requests counter, returns exception.EntityNotFound if the transaction is no longer running
+	Attempt(ctx context.Context, rootID, transactionID, renewedID string) error
+	// Claim removes and returns the given running transaction, returns exception.EntityNotFound if the
+	// transaction was already resolved or replaced by another one
+	Claim(ctx context.Context, rootID, transactionID string) (*PendingTransaction, error)
+}
+
+// UnitOfWork executes the given function inside a single atomic transaction,
+// every repository called with the given context shares the transaction
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// SAGAPolicy SAGA transaction timeout policy
+type SAGAPolicy struct {
+	// Deadline time to wait for a verification response before re-emitting the request
+	Deadline time.Duration
+	// Retries total of verification requests to re-emit before rolling back the transaction
+	Retries int
+	// Interval time between expired transaction lookups
+	Interval time.Duration
+	// BatchSize maximum of expired transactions resolved per lookup
+	BatchSize int
+}
@@ -9,11 +9,16 @@ import (
 type AuthorRepository interface {
 	Save(ctx context.Context, author Author) error
 	SaveRaw(ctx context.Context, author Author) error
-	Fetch(ctx context.Context, params core.PaginationParams, filterParams core.FilterParams) ([]*Author, error)
+	// Fetch returns up to size authors after the cursor boundary, rows are returned in reverse order on backward cursors
+	Fetch(ctx context.Context, cursor Cursor, size int, filterParams core.FilterParams) ([]*Author, error)
 	FetchByID(ctx context.Context, id string, showDisabled bool) (*Author, error)
+	// FetchByOwner returns every author owned by the given user, disabled authors included
+	FetchByOwner(ctx context.Context, ownerID string) ([]*Author, error)
 	Replace(ctx context.Context, author Author) error
 	Remove(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	HardRemove(ctx context.Context, id string) error
 	ChangeState(ctx context.Context, id, state string) error
+	// IncrementViews atomically adds the given view totals (external_id -> views) to total_views
+	IncrementViews(ctx context.Context, views map[string]int64) error
 }
@@ -26,4 +26,5 @@ type AuthorSAGAEventBus interface {
 	Failed(ctx context.Context, service, msg string) error
 	Created(ctx context.Context, author Author) error
 	BlobFailed(ctx context.Context, msg string) error
+	TimedOut(ctx context.Context, tx PendingTransaction) error
 }
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// AuthorViewed domain event, lightweight view notification consumed by total_views aggregation and trending services
+const AuthorViewed = "AUTHOR_VIEWED"
+
+// Viewer user reading an aggregate, transports propagate it through the request context
+type Viewer struct {
+	UserID  string
+	Country string
+}
+
+type viewerContextKey struct{}
+
+// WithViewer returns a copy of ctx holding the given viewer
+func WithViewer(ctx context.Context, viewer Viewer) context.Context {
+	return context.WithValue(ctx, viewerContextKey{}, viewer)
+}
+
+// ViewerFromContext returns the viewer of the given context, anonymous if not set
+func ViewerFromContext(ctx context.Context) Viewer {
+	viewer, _ := ctx.Value(viewerContextKey{}).(Viewer)
+	return viewer
+}
+
+// AuthorView AUTHOR_VIEWED event body
+type AuthorView struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id,omitempty"`
+	Country   string    `json:"country,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewAuthorView returns a view of the given author done by viewer
+func NewAuthorView(id string, viewer Viewer) *AuthorView {
+	return &AuthorView{
+		ID:        id,
+		UserID:    viewer.UserID,
+		Country:   strings.ToUpper(viewer.Country),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// AuthorViewEvent publishes author views, reads must not be blocked by it
+type AuthorViewEvent interface {
+	Viewed(ctx context.Context, view AuthorView) error
+}
+
+// ViewPolicy total_views aggregation policy
+type ViewPolicy struct {
+	// Interval maximum time a view waits before its batch gets stored
+	Interval time.Duration
+	// BatchSize total of views stored at once, a full batch is stored right away
+	BatchSize int
+}
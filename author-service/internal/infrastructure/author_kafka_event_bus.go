@@ -7,7 +7,6 @@ import (
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/eventbus"
 	"github.com/alexandria-oss/core/exception"
-	"github.com/google/uuid"
 	"github.com/maestre3d/alexandria/author-service/internal/domain"
 	"github.com/sony/gobreaker"
 	"go.opencensus.io/trace"
@@ -41,13 +40,12 @@ func (b AuthorKafkaEventBus) defaultCircuitBreaker(action string) *gobreaker.Cir
 	return gobreaker.NewCircuitBreaker(st)
 }
 
-func (b *AuthorKafkaEventBus) StartCreate(ctx context.Context, author domain.Author) error {
+func (b *AuthorKafkaEventBus) StartCreate(ctx context.Context, transactionID string, author domain.Author) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	ownerPool := make([]string, 0)
-	ownerPool = append(ownerPool, author.OwnerID)
-	ownerJSON, err := json.Marshal(ownerPool)
+	// Identity service verifies the whole owner pool
+	ownerJSON, err := json.Marshal(author.OwnerPool())
 	if err != nil {
 		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
 			"owner_pool", "[]string"))
@@ -72,7 +70,7 @@ func (b *AuthorKafkaEventBus) StartCreate(ctx context.Context, author domain.Aut
 	e := eventbus.NewEvent(b.cfg.Service, eventbus.EventIntegration, eventbus.PriorityHigh, eventbus.ProviderKafka, ownerJSON)
 	e.TracingContext = string(spanJSON)
 	t := eventbus.Transaction{
-		ID:        uuid.New().String(),
+		ID:        transactionID,
 		RootID:    author.ExternalID,
 		SpanID:    span.SpanContext().SpanID.String(),
 		TraceID:   span.SpanContext().TraceID.String(),
@@ -112,13 +110,13 @@ func (b *AuthorKafkaEventBus) StartCreate(ctx context.Context, author domain.Aut
 	return err
 }
 
-func (b *AuthorKafkaEventBus) StartUpdate(ctx context.Context, author domain.Author, snapshot domain.Author) error {
+func (b *AuthorKafkaEventBus) StartUpdate(ctx context.Context, transactionID string, author domain.Author,
+	snapshot domain.Author) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	ownerPool := make([]string, 0)
-	ownerPool = append(ownerPool, author.OwnerID)
-	ownerJSON, err := json.Marshal(ownerPool)
+	// Identity service verifies the whole owner pool
+	ownerJSON, err := json.Marshal(author.OwnerPool())
 	if err != nil {
 		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
 			"owner_pool", "[]string"))
@@ -147,7 +145,7 @@ func (b *AuthorKafkaEventBus) StartUpdate(ctx context.Context, author domain.Aut
 	}
 
 	t := &eventbus.Transaction{
-		ID:        uuid.New().String(),
+		ID:        transactionID,
 		RootID:    author.ExternalID,
 		SpanID:    span.SpanContext().SpanID.String(),
 		TraceID:   span.SpanContext().TraceID.String(),
@@ -2,22 +2,68 @@ package infrastructure
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
+// authorColumns author table columns, generated columns (e.g. search_vector) are not mapped into the entity
+const authorColumns = `id, external_id, first_name, last_name, display_name, ownership_type, create_time, ` +
+	`update_time, delete_time, active, verified, picture, total_views, country, status`
+
+// authorRank full-text search relevance of an author row
+const authorRank = `ts_rank_cd(search_vector, search_query)`
+
 type AuthorBuilder struct {
-	Statement string
+	criteria *pqutil.Criteria
+}
+
+// NewAuthorBuilder returns an author query builder, snippet and rank columns are always empty
+func NewAuthorBuilder() *AuthorBuilder {
+	return &AuthorBuilder{criteria: pqutil.NewCriteria(`SELECT ` + authorColumns + `, NULL AS snippet, 0::real AS rank FROM alexa1.author`)}
+}
+
+// NewAuthorSearchBuilder returns an author full-text search query builder, snippet column holds the highlighted matches.
+// Names are not stemmed and query words are matched by prefix
+func NewAuthorSearchBuilder(query string) *AuthorBuilder {
+	return &AuthorBuilder{criteria: pqutil.NewCriteria(`SELECT `+authorColumns+`, ts_headline('simple', `+
+		`first_name || ' ' || last_name || ' ' || display_name, search_query, 'StartSel=<b>, StopSel=</b>') AS snippet, `+
+		authorRank+` AS rank FROM alexa1.author, to_tsquery('simple', $1) search_query`, pqutil.PrefixQuery(query)).
+		Where(pqutil.Raw(`search_vector @@ search_query`))}
+}
+
+// NewAuthorFetchBuilder returns an author query fetching size rows after the given cursor using the filtering params
+func NewAuthorFetchBuilder(cursor domain.Cursor, size int, filterParams core.FilterParams) (*AuthorBuilder, error) {
+	// Criteria map filter -> Query Builder
+	var b *AuthorBuilder
+	if cursor.Sort == domain.SortRank {
+		b = NewAuthorSearchBuilder(filterParams["query"])
+	} else {
+		b = NewAuthorBuilder().Query(filterParams["query"])
+	}
+	b.DisplayName(filterParams["display_name"]).Ownership(filterParams["ownership_type"]).Owner(filterParams["owner_id"]).
+		Country(filterParams["country"])
+
+	// Keyset pagination
+	if _, err := b.Seek(cursor); err != nil {
+		return nil, err
+	}
+
+	return b.Active(strings.ToUpper(filterParams["show_disabled"]) != "TRUE").Status(domain.StatusDone).Limit(size), nil
 }
 
-// Query returns a query from most important fields
+// Query returns a query from most important fields, not indexed
 func (b *AuthorBuilder) Query(query string) *AuthorBuilder {
 	if query == "" {
 		return b
 	}
 
-	b.Statement += `(LOWER(first_name) LIKE LOWER('%` + query + `%') OR LOWER(last_name) LIKE LOWER('%` + query + `%') 
-	OR LOWER(display_name) LIKE LOWER('%` + query + `%'))`
-
+	b.criteria.Where(pqutil.Contains(query, "first_name", "last_name", "display_name"))
 	return b
 }
 
@@ -27,17 +73,18 @@ func (b *AuthorBuilder) DisplayName(displayName string) *AuthorBuilder {
 		return b
 	}
 
-	b.Statement += `LOWER(DISPLAY_NAME) = LOWER('` + displayName + `')`
+	b.criteria.Where(pqutil.FoldCompare("display_name", pqutil.Equal, displayName))
 	return b
 }
 
-// Ownership returns a query to search by ownership_type field
+// Ownership returns a query to search by ownership_type field, non-enum values are ignored
 func (b *AuthorBuilder) Ownership(ownershipType string) *AuthorBuilder {
-	if ownershipType == "" {
+	ownershipType = strings.ToLower(ownershipType)
+	if ownershipType != string(domain.PrivateOwner) && ownershipType != string(domain.CommunityOwner) {
 		return b
 	}
 
-	b.Statement += `ownership_type = '` + ownershipType + `'`
+	b.criteria.Where(pqutil.Compare("ownership_type", pqutil.Equal, ownershipType))
 	return b
 }
 
@@ -47,44 +94,71 @@ func (b *AuthorBuilder) Owner(ownerID string) *AuthorBuilder {
 		return b
 	}
 
-	b.Statement += fmt.Sprintf(`owner_id = '%s'`, ownerID)
+	b.criteria.Where(pqutil.In("external_id", `SELECT author_id FROM alexa1.owners WHERE owner_id =`, ownerID))
 	return b
 }
 
+// Country returns a query to search by country field
 func (b *AuthorBuilder) Country(countryCode string) *AuthorBuilder {
 	if countryCode == "" {
 		return b
 	}
 
-	b.Statement += fmt.Sprintf(`country = '%s'`, countryCode)
+	b.criteria.Where(pqutil.Compare("country", pqutil.Equal, countryCode))
 	return b
 }
 
-// Filter returns a query to filter useful fields like timestamp, id, or total_views
-/*
-key = field,
-op = operator,
-id = entity external_id,
-state = is entity active
-*/
-func (b *AuthorBuilder) Filter(key, op, id, state string) *AuthorBuilder {
-	state = strings.ToUpper(state)
-	b.Statement += fmt.Sprintf(`%s %s (SELECT %s FROM alexa1.author WHERE external_id = '%s' AND active = %s)`,
-		key, op, key, id, state)
-	return b
+// Seek returns a keyset query fetching rows after the cursor boundary sorted by the cursor key,
+// backward cursors fetch rows before the boundary in reverse order
+func (b *AuthorBuilder) Seek(cursor domain.Cursor) (*AuthorBuilder, error) {
+	field := cursor.Sort
+	if cursor.Sort == domain.SortRank {
+		field = authorRank
+	}
+
+	// Backward pages are read in reverse from the boundary
+	direction := pqutil.Direction(cursor.Direction)
+	if cursor.Backward {
+		direction = pqutil.Ascending
+		if cursor.Direction == domain.SortAscending {
+			direction = pqutil.Descending
+		}
+	}
+
+	if cursor.ID != "" {
+		value, err := parseCursorValue(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		op := pqutil.Less
+		if direction == pqutil.Ascending {
+			op = pqutil.Greater
+		}
+		b.criteria.Where(pqutil.Seek([]string{field, "external_id"}, op, value, cursor.ID))
+	}
+
+	b.criteria.OrderBy(field, direction).OrderBy("external_id", direction)
+	return b, nil
 }
 
 /* Generic SQL */
 
-// Active return a query to search by entity's state
-func (b *AuthorBuilder) Active(state string) *AuthorBuilder {
-	b.Statement += "active = " + state
+// Active returns a query to search by entity's state
+func (b *AuthorBuilder) Active(state bool) *AuthorBuilder {
+	b.criteria.Where(pqutil.Compare("active", pqutil.Equal, state))
+	return b
+}
+
+// Status returns a query to search by entity's SAGA status
+func (b *AuthorBuilder) Status(status string) *AuthorBuilder {
+	b.criteria.Where(pqutil.Compare("status", pqutil.Equal, status))
 	return b
 }
 
-// Raw returns a query with the raw SQL query
-func (b *AuthorBuilder) Raw(statement string) *AuthorBuilder {
-	b.Statement += statement
+// Where returns a query with the given expressions
+func (b *AuthorBuilder) Where(expressions ...pqutil.Expression) *AuthorBuilder {
+	b.criteria.Where(expressions...)
 	return b
 }
 
@@ -92,32 +166,43 @@ func (b *AuthorBuilder) Raw(statement string) *AuthorBuilder {
 /*
 key = field,
 def = default order,
-param = sorting from params, will replace default value
+param = sorting from params, will replace default value if valid
 */
-func (b *AuthorBuilder) OrderBy(key, def, param string) *AuthorBuilder {
-	if param != "" {
-		b.Statement += fmt.Sprintf(` ORDER BY %s %s`, key, param)
-		return b
-	}
-
-	b.Statement += fmt.Sprintf(` ORDER BY %s %s`, key, strings.ToUpper(def))
+func (b *AuthorBuilder) OrderBy(key string, def pqutil.Direction, param string) *AuthorBuilder {
+	b.criteria.OrderBy(key, pqutil.ParseDirection(param, def))
 	return b
 }
 
 // Limit returns a query with a limiter, useful for pagination
 func (b *AuthorBuilder) Limit(limit int) *AuthorBuilder {
-	b.Statement += fmt.Sprintf(` FETCH FIRST %d ROWS ONLY`, limit)
+	b.criteria.Limit(limit)
 	return b
 }
 
-// And returns a query with the AND statement
-func (b *AuthorBuilder) And() *AuthorBuilder {
-	b.Statement += " AND "
-	return b
+// Build returns the SQL statement and its args
+func (b *AuthorBuilder) Build() (string, []interface{}) {
+	return b.criteria.Build()
 }
 
-// Or returns a query with the OR statement
-func (b *AuthorBuilder) Or() *AuthorBuilder {
-	b.Statement += " OR "
-	return b
+// parseCursorValue returns the typed sort key value of the given cursor
+func parseCursorValue(cursor domain.Cursor) (value interface{}, err error) {
+	switch cursor.Sort {
+	case domain.SortCreateTime, domain.SortUpdateTime:
+		value, err = time.Parse(time.RFC3339Nano, cursor.Value)
+	case domain.SortTotalViews:
+		value, err = strconv.ParseInt(cursor.Value, 10, 64)
+	case domain.SortRank:
+		var rank float64
+		rank, err = strconv.ParseFloat(cursor.Value, 32)
+		value = float32(rank)
+	default:
+		value = cursor.Value
+	}
+
+	if err != nil {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "page_token", "a valid page token"))
+	}
+
+	return value, nil
 }
@@ -0,0 +1,182 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	authorSelect = `SELECT ` + authorColumns + `, NULL AS snippet, 0::real AS rank FROM alexa1.author`
+	authorSearch = `SELECT ` + authorColumns + `, ts_headline('simple', first_name || ' ' || last_name || ' ' || display_name, ` +
+		`search_query, 'StartSel=<b>, StopSel=</b>') AS snippet, ts_rank_cd(search_vector, search_query) AS rank ` +
+		`FROM alexa1.author, to_tsquery('simple', $1) search_query`
+)
+
+func TestNewAuthorFetchBuilder(t *testing.T) {
+	tests := []struct {
+		name      string
+		cursor    *domain.Cursor
+		filter    core.FilterParams
+		statement string
+		args      []interface{}
+	}{
+		{
+			name:   "default",
+			filter: core.FilterParams{},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "query",
+			filter: core.FilterParams{"query": "musk') OR 1=1 --"},
+			statement: authorSelect + ` WHERE (LOWER(first_name) LIKE LOWER($1) OR LOWER(last_name) LIKE LOWER($1)` +
+				` OR LOWER(display_name) LIKE LOWER($1)) AND active = $2 AND status = $3` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"%musk') OR 1=1 --%", true, "STATUS_DONE"},
+		},
+		{
+			name:   "query wildcards",
+			filter: core.FilterParams{"query": `_%\`},
+			statement: authorSelect + ` WHERE (LOWER(first_name) LIKE LOWER($1) OR LOWER(last_name) LIKE LOWER($1)` +
+				` OR LOWER(display_name) LIKE LOWER($1)) AND active = $2 AND status = $3` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{`%\_\%\\%`, true, "STATUS_DONE"},
+		},
+		{
+			name:   "display_name",
+			filter: core.FilterParams{"display_name": "Elon Musk"},
+			statement: authorSelect + ` WHERE LOWER(display_name) = LOWER($1) AND active = $2 AND status = $3` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"Elon Musk", true, "STATUS_DONE"},
+		},
+		{
+			name:   "ownership_type",
+			filter: core.FilterParams{"ownership_type": "PUBLIC"},
+			statement: authorSelect + ` WHERE ownership_type = $1 AND active = $2 AND status = $3` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"public", true, "STATUS_DONE"},
+		},
+		{
+			name:   "invalid ownership_type",
+			filter: core.FilterParams{"ownership_type": "root"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "owner_id",
+			filter: core.FilterParams{"owner_id": "123"},
+			statement: authorSelect + ` WHERE external_id IN (SELECT author_id FROM alexa1.owners WHERE owner_id = $1) AND active = $2 AND status = $3` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"123", true, "STATUS_DONE"},
+		},
+		{
+			name:   "country",
+			filter: core.FilterParams{"country": "MX"},
+			statement: authorSelect + ` WHERE country = $1 AND active = $2 AND status = $3` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"MX", true, "STATUS_DONE"},
+		},
+		{
+			name:   "show_disabled",
+			filter: core.FilterParams{"show_disabled": "true"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{false, "STATUS_DONE"},
+		},
+		{
+			name:   "sort",
+			filter: core.FilterParams{"sort": "ASC"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY total_views ASC, external_id ASC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "invalid sort",
+			filter: core.FilterParams{"sort": "desc, (SELECT 1)"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "filter_by id",
+			filter: core.FilterParams{"filter_by": "id"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY create_time ASC, external_id ASC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "filter_by update_time",
+			filter: core.FilterParams{"filter_by": "update_time"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY update_time DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "filter_by display_name",
+			filter: core.FilterParams{"filter_by": "display_name"},
+			statement: authorSelect + ` WHERE active = $1 AND status = $2 ORDER BY display_name ASC, external_id ASC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "search_mode fulltext",
+			filter: core.FilterParams{"query": "Elon Mu", "search_mode": "fulltext", "country": "US"},
+			statement: authorSearch + ` WHERE search_vector @@ search_query AND country = $2 AND active = $3 AND status = $4` +
+				` ORDER BY ts_rank_cd(search_vector, search_query) DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"elon:* & mu:*", "US", true, "STATUS_DONE"},
+		},
+		{
+			name:   "create_time cursor",
+			cursor: &domain.Cursor{Sort: domain.SortCreateTime, Direction: domain.SortAscending, Value: "2020-06-01T10:00:00Z", ID: "abc"},
+			filter: core.FilterParams{"owner_id": "123"},
+			statement: authorSelect + ` WHERE external_id IN (SELECT author_id FROM alexa1.owners WHERE owner_id = $1)` +
+				` AND (create_time, external_id) > ($2, $3)` +
+				` AND active = $4 AND status = $5 ORDER BY create_time ASC, external_id ASC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"123", time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC), "abc", true, "STATUS_DONE"},
+		},
+		{
+			name: "backward display_name cursor",
+			cursor: &domain.Cursor{Sort: domain.SortDisplayName, Direction: domain.SortAscending, Value: "Elon Musk", ID: "abc",
+				Backward: true},
+			filter: core.FilterParams{},
+			statement: authorSelect + ` WHERE (display_name, external_id) < ($1, $2)` +
+				` AND active = $3 AND status = $4 ORDER BY display_name DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"Elon Musk", "abc", true, "STATUS_DONE"},
+		},
+		{
+			name:   "rank cursor",
+			cursor: &domain.Cursor{Sort: domain.SortRank, Direction: domain.SortDescending, Value: "0.5", ID: "abc"},
+			filter: core.FilterParams{"query": "musk:*", "search_mode": "FullText"},
+			statement: authorSearch + ` WHERE search_vector @@ search_query` +
+				` AND (ts_rank_cd(search_vector, search_query), external_id) < ($2, $3) AND active = $4 AND status = $5` +
+				` ORDER BY ts_rank_cd(search_vector, search_query) DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"musk:*", float32(0.5), "abc", true, "STATUS_DONE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := domain.NewCursor(tt.filter)
+			if tt.cursor != nil {
+				cursor = *tt.cursor
+			}
+
+			b, err := NewAuthorFetchBuilder(cursor, 10, tt.filter)
+			assert.Nil(t, err)
+
+			statement, args := b.Build()
+			assert.Equal(t, tt.statement, statement)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+
+	_, err := NewAuthorFetchBuilder(domain.Cursor{Sort: domain.SortCreateTime, Direction: domain.SortAscending, Value: "x",
+		ID: "abc"}, 10, core.FilterParams{})
+	assert.NotNil(t, err)
+}
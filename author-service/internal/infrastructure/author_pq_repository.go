@@ -5,13 +5,13 @@ import (
 	"database/sql"
 	"github.com/alexandria-oss/core"
 	"github.com/alexandria-oss/core/exception"
-	"strings"
-	"sync"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-redis/redis/v7"
 	"github.com/lib/pq"
 	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
 // AuthorPQRepository DBMS Author repository
@@ -19,7 +19,6 @@ type AuthorPQRepository struct {
 	db     *sql.DB
 	mem    *redis.Client
 	logger log.Logger
-	mu     *sync.Mutex
 }
 
 const tableName = "author"
@@ -30,75 +29,71 @@ func NewAuthorPQRepository(dbPool *sql.DB, memPool *redis.Client, logger log.Log
 		db:     dbPool,
 		mem:    memPool,
 		logger: logger,
-		mu:     new(sync.Mutex),
 	}
 }
 
 func (r *AuthorPQRepository) Save(ctx context.Context, author domain.Author) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		err = conn.Close()
-	}()
-	// Use Go CDK OpenCensus database metrics
-	_ = r.logger.Log("method", "author.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
-
-	statement := `CALL alexa1.create_author($1, $2, $3, $4, $5, $6, $7)`
-
-	_, err = conn.ExecContext(ctx, statement, author.ExternalID, author.FirstName, author.LastName, author.DisplayName, author.OwnershipType,
-		author.OwnerID, author.Country)
-	if err != nil {
-		if customErr, ok := err.(*pq.Error); ok {
-			if customErr.Code == "23505" {
-				return exception.EntityExists
+	// Author and its owner pool are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "author.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
+
+		statement := `CALL alexa1.create_author($1, $2, $3, $4, $5, $6)`
+		_, err = conn.ExecContext(ctx, statement, author.ExternalID, author.FirstName, author.LastName, author.DisplayName, author.OwnershipType,
+			author.Country)
+		if err == nil {
+			err = saveOwners(ctx, conn, author)
+		}
+		if err != nil {
+			if customErr, ok := err.(*pq.Error); ok {
+				if customErr.Code == "23505" {
+					return exception.EntityExists
+				}
 			}
 		}
-	}
 
-	return err
+		return err
+	})
 }
 
 func (r *AuthorPQRepository) SaveRaw(ctx context.Context, author domain.Author) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		err = conn.Close()
-	}()
-	// Use Go CDK OpenCensus database metrics
-	_ = r.logger.Log("method", "author.infrastructure.postgres.save_raw", "db_connection", r.db.Stats().OpenConnections)
-
-	statement := `INSERT INTO alexa1.author VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
-
-	_, err = conn.ExecContext(ctx, statement, author.ID, author.ExternalID, author.FirstName, author.LastName, author.DisplayName, author.OwnerID,
-		author.OwnershipType, author.CreateTime, author.UpdateTime, author.DeleteTime, author.Active, author.Verified, author.Picture, author.TotalViews,
-		author.Country, author.Status)
-	if err != nil {
-		if customErr, ok := err.(*pq.Error); ok {
-			if customErr.Code == "23505" {
-				return exception.EntityExists
+	// Author and its owner pool are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "author.infrastructure.postgres.save_raw", "db_connection", r.db.Stats().OpenConnections)
+
+		statement := `INSERT INTO alexa1.author(` + authorColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+		_, err = conn.ExecContext(ctx, statement, author.ID, author.ExternalID, author.FirstName, author.LastName, author.DisplayName,
+			author.OwnershipType, author.CreateTime, author.UpdateTime, author.DeleteTime, author.Active, author.Verified, author.Picture, author.TotalViews,
+			author.Country, author.Status)
+		if err == nil {
+			err = saveOwners(ctx, conn, author)
+		}
+		if err != nil {
+			if customErr, ok := err.(*pq.Error); ok {
+				if customErr.Code == "23505" {
+					return exception.EntityExists
+				}
 			}
 		}
-	}
 
-	return err
+		return err
+	})
 }
 
 func (r *AuthorPQRepository) FetchByID(ctx context.Context, id string, showDisabled bool) (*domain.Author, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	// Cache-aside pattern
-	if r.mem != nil {
+	// Cache-aside pattern, running transactions must read their own writes
+	if r.mem != nil && !pqutil.InTransaction(ctx) {
 		authorChan := make(chan *domain.Author)
 		defer close(authorChan)
 
@@ -121,24 +116,22 @@ func (r *AuthorPQRepository) FetchByID(ctx context.Context, id string, showDisab
 		}
 	}
 
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		err = conn.Close()
-	}()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "author.infrastructure.postgres.fetch_by_id", "db_connection", r.db.Stats().OpenConnections)
 
-	statement := `SELECT * FROM alexa1.author WHERE external_id = $1`
+	statement := `SELECT ` + authorColumns + ` FROM alexa1.author WHERE external_id = $1`
 	if !showDisabled {
 		statement += ` AND active = TRUE`
 	}
 
 	author := new(domain.Author)
 	err = conn.QueryRowContext(ctx, statement, id).Scan(&author.ID, &author.ExternalID, &author.FirstName,
-		&author.LastName, &author.DisplayName, &author.OwnerID, &author.OwnershipType, &author.CreateTime, &author.UpdateTime, &author.DeleteTime,
+		&author.LastName, &author.DisplayName, &author.OwnershipType, &author.CreateTime, &author.UpdateTime, &author.DeleteTime,
 		&author.Active, &author.Verified, &author.Picture, &author.TotalViews, &author.Country, &author.Status)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -148,178 +141,172 @@ func (r *AuthorPQRepository) FetchByID(ctx context.Context, id string, showDisab
 		return nil, err
 	}
 
-	// Write-through
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Store(ctxR, r.mem, author.ExternalID, tableName, author)
+	owners, err := fetchOwners(ctx, conn, author.ExternalID)
+	if err != nil {
+		return nil, err
 	}
+	author.Owners = owners[author.ExternalID]
+
+	// Write-through
+	r.cache(ctx, author)
 
 	return author, nil
 }
 
-func (r *AuthorPQRepository) Fetch(ctx context.Context, params core.PaginationParams, filterParams core.FilterParams) ([]*domain.Author, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+func (r *AuthorPQRepository) Fetch(ctx context.Context, cursor domain.Cursor, size int, filterParams core.FilterParams) ([]*domain.Author, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		err = conn.Close()
-	}()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "author.infrastructure.postgres.fetch", "db_connection", r.db.Stats().OpenConnections)
 
-	b := AuthorBuilder{`SELECT * FROM alexa1.author WHERE `}
-	// Criteria map filter -> Query Builder
-	for filterType, value := range filterParams {
-		// Avoid nil values and comparison computation
-		if value == "" {
-			continue
-		}
-		switch {
-		case filterType == "query":
-			b.Query(value).And()
-			continue
-		case filterType == "display_name":
-			b.DisplayName(value).And()
-			continue
-		case filterType == "ownership_type":
-			// Avoid non-enum type values
-			value = strings.ToLower(value)
-			if value == string(domain.PrivateOwner) || value == string(domain.CommunityOwner) {
-				b.Ownership(value).And()
-			}
-			continue
-		case filterType == "owner_id":
-			b.Owner(value).And()
-			continue
-		case filterType == "country":
-			b.Country(value).And()
-			continue
-		}
+	b, err := NewAuthorFetchBuilder(cursor, size, filterParams)
+	if err != nil {
+		return nil, err
 	}
+	statement, args := b.Build()
 
-	isActive := "TRUE"
-	if strings.ToUpper(filterParams["show_disabled"]) == "TRUE" {
-		isActive = "FALSE"
+	// Query - entity mapping
+	rows, err := conn.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	} else if rows.Err() != nil {
+		return nil, rows.Err()
 	}
+	defer func() {
+		err = rows.Close()
+	}()
 
-	sort := ""
-	if strings.ToUpper(filterParams["sort"]) == "DESC" {
-		sort = "DESC"
-	} else if strings.ToUpper(filterParams["sort"]) == "ASC" {
-		sort = "ASC"
+	authors := make([]*domain.Author, 0)
+	for rows.Next() {
+		author := new(domain.Author)
+		err = rows.Scan(&author.ID, &author.ExternalID, &author.FirstName,
+			&author.LastName, &author.DisplayName, &author.OwnershipType, &author.CreateTime, &author.UpdateTime, &author.DeleteTime,
+			&author.Active, &author.Verified, &author.Picture, &author.TotalViews, &author.Country, &author.Status, &author.Snippet, &author.Rank)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, author)
 	}
 
-	// Keyset pagination, filtering type binding
-	if filterParams["filter_by"] == "id" {
-		// Filtering by ID
-		if params.Token != "" {
-			b.Filter("id", ">=", params.Token, isActive).And()
-		}
+	if len(authors) == 0 {
+		return nil, exception.EntitiesNotFound
+	}
 
-		b.Active(isActive).And().Raw(`status = 'STATUS_DONE'`).OrderBy("id", "asc", sort)
-	} else if filterParams["filter_by"] == "timestamp" {
-		// Filtering by Timestamp
-		if params.Token != "" {
-			b.Filter("update_time", "<=", params.Token, isActive).And()
-		}
+	// Owner pools of the whole page are fetched at once
+	ids := make([]string, 0, len(authors))
+	for _, author := range authors {
+		ids = append(ids, author.ExternalID)
+	}
+	owners, err := fetchOwners(ctx, conn, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, author := range authors {
+		author.Owners = owners[author.ExternalID]
+	}
 
-		b.Active(isActive).And().Raw(`status = 'STATUS_DONE'`).OrderBy("update_time", "desc", sort)
-	} else {
-		// Filtering by Popularity - Default
-		if params.Token != "" {
-			b.Filter("total_views", "<=", params.Token, isActive).And()
-		}
+	return authors, nil
+}
 
-		b.Active(isActive).And().Raw(`status = 'STATUS_DONE'`).OrderBy("total_views", "desc", sort)
+func (r *AuthorPQRepository) FetchByOwner(ctx context.Context, ownerID string) ([]*domain.Author, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
 	}
-	b.Limit(params.Size)
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "author.infrastructure.postgres.fetch_by_owner", "db_connection", r.db.Stats().OpenConnections)
 
-	// Query - entity mapping
-	rows, err := conn.QueryContext(ctx, b.Statement)
+	statement := `SELECT ` + authorColumns + ` FROM alexa1.author WHERE external_id IN (SELECT author_id FROM
+    alexa1.owners WHERE owner_id = $1) ORDER BY id`
+	rows, err := conn.QueryContext(ctx, statement, ownerID)
 	if err != nil {
 		return nil, err
-	} else if rows.Err() != nil {
-		return nil, rows.Err()
 	}
 	defer func() {
 		err = rows.Close()
 	}()
 
 	authors := make([]*domain.Author, 0)
+	ids := make([]string, 0)
 	for rows.Next() {
 		author := new(domain.Author)
 		err = rows.Scan(&author.ID, &author.ExternalID, &author.FirstName,
-			&author.LastName, &author.DisplayName, &author.OwnerID, &author.OwnershipType, &author.CreateTime, &author.UpdateTime, &author.DeleteTime,
+			&author.LastName, &author.DisplayName, &author.OwnershipType, &author.CreateTime, &author.UpdateTime, &author.DeleteTime,
 			&author.Active, &author.Verified, &author.Picture, &author.TotalViews, &author.Country, &author.Status)
 		if err != nil {
 			return nil, err
 		}
 		authors = append(authors, author)
+		ids = append(ids, author.ExternalID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
 	if len(authors) == 0 {
-		return nil, exception.EntitiesNotFound
+		return authors, nil
+	}
+
+	owners, err := fetchOwners(ctx, conn, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, author := range authors {
+		author.Owners = owners[author.ExternalID]
 	}
 
 	return authors, nil
 }
 
 func (r *AuthorPQRepository) Replace(ctx context.Context, author domain.Author) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		err = conn.Close()
-	}()
-	// Use Go CDK OpenCensus database metrics
-	_ = r.logger.Log("method", "author.infrastructure.postgres.replace", "db_connection", r.db.Stats().OpenConnections)
+	// Author and its owner pool are replaced atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "author.infrastructure.postgres.replace", "db_connection", r.db.Stats().OpenConnections)
 
-	statement := `UPDATE alexa1.author SET first_name = $1, last_name = $2, display_name = $3, ownership_type = $4,
-    update_time = $5, total_views = $6, owner_id = $7, status = $8, country = $9, picture = $10 WHERE external_id = $11 AND active = true`
+		// total_views is only written by IncrementViews, replacing it would lose concurrent views
+		statement := `UPDATE alexa1.author SET first_name = $1, last_name = $2, display_name = $3, ownership_type = $4,
+    update_time = $5, status = $6, country = $7, picture = $8 WHERE external_id = $9 AND active = true`
 
-	res, err := conn.ExecContext(ctx, statement, author.FirstName, author.LastName, author.DisplayName, author.OwnershipType, author.UpdateTime, author.TotalViews,
-		author.OwnerID, author.Status, author.Country, author.Picture, author.ExternalID)
-	if err != nil {
-		if customErr, ok := err.(*pq.Error); ok {
-			if customErr.Code == "23505" {
-				return exception.EntityExists
+		res, err := conn.ExecContext(ctx, statement, author.FirstName, author.LastName, author.DisplayName, author.OwnershipType, author.UpdateTime,
+			author.Status, author.Country, author.Picture, author.ExternalID)
+		if err != nil {
+			if customErr, ok := err.(*pq.Error); ok {
+				if customErr.Code == "23505" {
+					return exception.EntityExists
+				}
 			}
+			return err
+		} else if affect, err := res.RowsAffected(); affect == 0 || err != nil {
+			return exception.EntityNotFound
 		}
-		return err
-	} else if affect, err := res.RowsAffected(); affect == 0 || err != nil {
-		return exception.EntityNotFound
-	}
 
-	// write-through cache pattern
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Store(ctxR, r.mem, author.ExternalID, tableName, author)
-	}
+		if err = saveOwners(ctx, conn, author); err != nil {
+			return err
+		}
 
-	return nil
+		// write-through cache pattern
+		r.cache(ctx, &author)
+
+		return nil
+	})
 }
 
 func (r *AuthorPQRepository) Remove(ctx context.Context, id string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		err = conn.Close()
-	}()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "author.infrastructure.postgres.remove", "db_connection", r.db.Stats().OpenConnections)
 
@@ -333,26 +320,17 @@ func (r *AuthorPQRepository) Remove(ctx context.Context, id string) error {
 	}
 
 	// write-through cache pattern
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Remove(ctxR, r.mem, id, tableName)
-	}
+	r.evict(ctx, id)
 
 	return nil
 }
 
 func (r *AuthorPQRepository) Restore(ctx context.Context, id string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		err = conn.Close()
-	}()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "author.infrastructure.postgres.restore", "db_connection", r.db.Stats().OpenConnections)
 
@@ -364,28 +342,18 @@ func (r *AuthorPQRepository) Restore(ctx context.Context, id string) error {
 		return exception.EntityNotFound
 	}
 
-	// Invalidate cache
 	// write-through cache pattern
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Remove(ctxR, r.mem, id, tableName)
-	}
+	r.evict(ctx, id)
 
 	return nil
 }
 
 func (r *AuthorPQRepository) HardRemove(ctx context.Context, id string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		err = conn.Close()
-	}()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "author.infrastructure.postgres.hard_remove", "db_connection", r.db.Stats().OpenConnections)
 
@@ -399,27 +367,17 @@ func (r *AuthorPQRepository) HardRemove(ctx context.Context, id string) error {
 	}
 
 	// write-through cache pattern
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-
-		go Remove(ctxR, r.mem, id, tableName)
-	}
+	r.evict(ctx, id)
 
 	return nil
 }
 
 func (r *AuthorPQRepository) ChangeState(ctx context.Context, id, state string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		err = conn.Close()
-	}()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "author.infrastructure.postgres.change_state", "db_connection", r.db.Stats().OpenConnections)
 
@@ -432,11 +390,115 @@ func (r *AuthorPQRepository) ChangeState(ctx context.Context, id, state string)
 	}
 
 	// write-through cache pattern
+	r.evict(ctx, id)
+
+	return nil
+}
+
+func (r *AuthorPQRepository) IncrementViews(ctx context.Context, views map[string]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "author.infrastructure.postgres.increment_views", "db_connection", r.db.Stats().OpenConnections)
+
+	ids := make([]string, 0, len(views))
+	totals := make([]int64, 0, len(views))
+	for id, total := range views {
+		ids = append(ids, id)
+		totals = append(totals, total)
+	}
+
+	// Single statement, every increment is applied atomically by the DBMS
+	statement := `UPDATE alexa1.author SET total_views = author.total_views + v.total FROM 
+    (SELECT unnest($1::varchar[]) AS external_id, unnest($2::bigint[]) AS total) v WHERE author.external_id = v.external_id`
+	if _, err = conn.ExecContext(ctx, statement, pq.Array(ids), pq.Array(totals)); err != nil {
+		return err
+	}
+
+	// Cached entities are refreshed once per batch instead of once per view
 	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Remove(ctxR, r.mem, id, tableName)
+		for _, id := range ids {
+			Remove(ctx, r.mem, id, tableName)
+		}
 	}
 
 	return nil
 }
+
+// cache stores the given author once the running unit of work gets committed, rolled back changes are never cached
+func (r *AuthorPQRepository) cache(ctx context.Context, author *domain.Author) {
+	if r.mem == nil {
+		return
+	}
+
+	pqutil.AfterCommit(ctx, func() {
+		go Store(context.Background(), r.mem, author.ExternalID, tableName, author)
+	})
+}
+
+// evict removes the given author from the cache once the running unit of work gets committed
+func (r *AuthorPQRepository) evict(ctx context.Context, id string) {
+	if r.mem == nil {
+		return
+	}
+
+	pqutil.AfterCommit(ctx, func() {
+		go Remove(context.Background(), r.mem, id, tableName)
+	})
+}
+
+// saveOwners replaces the owner pool of the given author
+func saveOwners(ctx context.Context, conn pqutil.Executor, author domain.Author) error {
+	_, err := conn.ExecContext(ctx, `DELETE FROM alexa1.owners WHERE author_id = $1`, author.ExternalID)
+	if err != nil || len(author.Owners) == 0 {
+		return err
+	}
+
+	ids := make([]string, 0, len(author.Owners))
+	roles := make([]string, 0, len(author.Owners))
+	createTimes := make([]string, 0, len(author.Owners))
+	for _, owner := range author.Owners {
+		ids = append(ids, owner.ID)
+		roles = append(roles, owner.Role)
+		createTimes = append(createTimes, owner.CreateTime.UTC().Format(time.RFC3339Nano))
+	}
+
+	statement := `INSERT INTO alexa1.owners(author_id, owner_id, role_type, create_time) SELECT $1, unnest($2::varchar[]),
+    unnest($3::alexa1.role_enum[]), unnest($4::timestamp[])`
+	_, err = conn.ExecContext(ctx, statement, author.ExternalID, pq.Array(ids), pq.Array(roles), pq.Array(createTimes))
+	return err
+}
+
+// fetchOwners returns the owner pool of each given author (external_id -> owners), oldest owners first
+func fetchOwners(ctx context.Context, conn pqutil.Executor, ids ...string) (map[string][]*domain.Owner, error) {
+	statement := `SELECT author_id, owner_id, role_type, create_time FROM alexa1.owners WHERE author_id = ANY($1)
+    ORDER BY create_time, owner_id`
+	rows, err := conn.QueryContext(ctx, statement, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	owners := make(map[string][]*domain.Owner, len(ids))
+	for rows.Next() {
+		var authorID string
+		owner := new(domain.Owner)
+		if err = rows.Scan(&authorID, &owner.ID, &owner.Role, &owner.CreateTime); err != nil {
+			return nil, err
+		}
+		owners[authorID] = append(owners[authorID], owner)
+	}
+
+	return owners, rows.Err()
+}
@@ -311,3 +311,63 @@ func (e *AuthorSAGAKafkaEventBus) BlobFailed(ctx context.Context, msg string) er
 
 	return err
 }
+
+func (e *AuthorSAGAKafkaEventBus) TimedOut(ctx context.Context, tx domain.PendingTransaction) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Do any local low-volatile operation before any TCP/UDP connection
+	txJSON, err := json.Marshal(tx)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"transaction", "pending transaction"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "author: timed_out")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.AuthorSAGATimedOut))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	ev := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityMid, eventbus.ProviderKafka, txJSON)
+	ev.TracingContext = string(spanJSON)
+	m := &pubsub.Message{
+		Body: ev.Content,
+		Metadata: map[string]string{
+			"transaction_id":  tx.ID,
+			"root_id":         tx.RootID,
+			"operation":       tx.Operation,
+			"tracing_context": ev.TracingContext,
+			"service":         ev.ServiceName,
+			"event_id":        ev.ID,
+			"event_type":      ev.EventType,
+			"priority":        ev.Priority,
+			"provider":        ev.Provider,
+			"dispatch_time":   ev.DispatchTime,
+		},
+		BeforeSend: nil,
+	}
+
+	p, err := eventbus.NewKafkaProducer(ctx, domain.AuthorSAGATimedOut)
+	if err != nil {
+		return err
+	}
+	defer p.Shutdown(ctx)
+
+	_, err = e.defaultCircuitBreaker("timed_out").Execute(func() (interface{}, error) {
+		return nil, p.Send(ctx, m)
+	})
+
+	return err
+}
@@ -0,0 +1,26 @@
+package infrastructure
+
+import (
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.cascade.action", domain.CascadeSoftDelete)
+	viper.SetDefault("alexandria.cascade.community_owner", "community")
+	viper.SetDefault("alexandria.cascade.dry_run", false)
+}
+
+// NewCascadePolicy returns the user removal cascade policy from the current configuration
+func NewCascadePolicy() (domain.CascadePolicy, error) {
+	action, err := domain.ParseCascadeAction(viper.GetString("alexandria.cascade.action"))
+	if err != nil {
+		return domain.CascadePolicy{}, err
+	}
+
+	return domain.CascadePolicy{
+		Action:         action,
+		CommunityOwner: viper.GetString("alexandria.cascade.community_owner"),
+		DryRun:         viper.GetBool("alexandria.cascade.dry_run"),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-redis/redis/v7"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func init() {
+	viper.SetDefault("alexandria.cascade.report.ttl", "168h")
+}
+
+// CascadeRedisRepository cascade report store, reports expire after the configured TTL
+type CascadeRedisRepository struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewCascadeRedisRepository(client *redis.Client) *CascadeRedisRepository {
+	return &CascadeRedisRepository{
+		client: client,
+		ttl:    viper.GetDuration("alexandria.cascade.report.ttl"),
+	}
+}
+
+func (r *CascadeRedisRepository) Save(ctx context.Context, report domain.CascadeReport) error {
+	if r.client == nil {
+		return fmt.Errorf("redis client is not available")
+	}
+
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	return r.client.WithContext(ctx).Set(cascadeReportKey(report.UserID), reportJSON, r.ttl).Err()
+}
+
+func (r *CascadeRedisRepository) FetchByID(ctx context.Context, userID string) (*domain.CascadeReport, error) {
+	if r.client == nil {
+		return nil, fmt.Errorf("redis client is not available")
+	}
+
+	reportJSON, err := r.client.WithContext(ctx).Get(cascadeReportKey(userID)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, exception.EntityNotFound
+		}
+
+		return nil, err
+	}
+
+	report := new(domain.CascadeReport)
+	if err = json.Unmarshal(reportJSON, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func cascadeReportKey(userID string) string {
+	return fmt.Sprintf("author_cascade:%s", userID)
+}
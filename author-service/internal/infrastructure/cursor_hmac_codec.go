@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	// Deployments set the secret through the environment instead of shipping it within the config file
+	_ = viper.BindEnv("alexandria.pagination.secret", "ALEXANDRIA_PAGINATION_SECRET")
+}
+
+// CursorHMACCodec encodes cursors into base64 page tokens signed with HMAC-SHA256, tampered tokens are rejected
+type CursorHMACCodec struct {
+	secret []byte
+}
+
+// NewCursorHMACCodec returns a page token codec using the configured pagination secret, the secret is required
+func NewCursorHMACCodec() (*CursorHMACCodec, error) {
+	secret := viper.GetString("alexandria.pagination.secret")
+	if secret == "" {
+		return nil, errors.New("missing alexandria.pagination.secret")
+	}
+
+	return &CursorHMACCodec{secret: []byte(secret)}, nil
+}
+
+// Encode returns the given cursor as a <payload>.<signature> token
+func (c *CursorHMACCodec) Encode(cursor domain.Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.sign(encoded)), nil
+}
+
+// Decode returns the cursor of the given token if its signature is valid
+func (c *CursorHMACCodec) Decode(token string) (*domain.Cursor, error) {
+	errToken := exception.NewErrorDescription(exception.InvalidFieldFormat,
+		fmt.Sprintf(exception.InvalidFieldFormatString, "page_token", "a valid page token"))
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, errToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, c.sign(parts[0])) {
+		return nil, errToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errToken
+	}
+
+	cursor := new(domain.Cursor)
+	if err = json.Unmarshal(payload, cursor); err != nil || !cursor.IsValid() {
+		return nil, errToken
+	}
+
+	return cursor, nil
+}
+
+func (c *CursorHMACCodec) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	_, _ = mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
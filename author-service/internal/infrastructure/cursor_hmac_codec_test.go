@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorHMACCodec(t *testing.T) {
+	codec := &CursorHMACCodec{secret: []byte("secret")}
+	cursor := domain.Cursor{Sort: domain.SortUpdateTime, Direction: domain.SortDescending,
+		Value: "2020-06-01T10:00:00Z", ID: "abc", Backward: true}
+
+	token, err := codec.Encode(cursor)
+	assert.Nil(t, err)
+	assert.NotContains(t, token, "abc")
+
+	decoded, err := codec.Decode(token)
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, *decoded)
+
+	// Tampered payload
+	_, err = codec.Decode("e30" + token[strings.Index(token, "."):])
+	assert.NotNil(t, err)
+
+	// Signed by another secret
+	token, _ = (&CursorHMACCodec{secret: []byte("other")}).Encode(cursor)
+	_, err = codec.Decode(token)
+	assert.NotNil(t, err)
+
+	_, err = codec.Decode("abc")
+	assert.NotNil(t, err)
+}
+
+func TestNewCursorHMACCodec(t *testing.T) {
+	defer viper.Set("alexandria.pagination.secret", nil)
+
+	// Page tokens signed by a well-known secret could be forged
+	viper.Set("alexandria.pagination.secret", "")
+	_, err := NewCursorHMACCodec()
+	assert.NotNil(t, err)
+
+	viper.Set("alexandria.pagination.secret", "secret")
+	codec, err := NewCursorHMACCodec()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), codec.secret)
+
+	// Environment takes precedence over the config file
+	viper.Set("alexandria.pagination.secret", nil)
+	_ = os.Setenv("ALEXANDRIA_PAGINATION_SECRET", "env-secret")
+	defer os.Unsetenv("ALEXANDRIA_PAGINATION_SECRET")
+	codec, err = NewCursorHMACCodec()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("env-secret"), codec.secret)
+}
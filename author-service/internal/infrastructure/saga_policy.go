@@ -0,0 +1,23 @@
+package infrastructure
+
+import (
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.saga.timeout.deadline", "5m")
+	viper.SetDefault("alexandria.saga.timeout.retries", 3)
+	viper.SetDefault("alexandria.saga.timeout.interval", "30s")
+	viper.SetDefault("alexandria.saga.timeout.batch", 50)
+}
+
+// NewSAGAPolicy returns the SAGA timeout policy from the current configuration
+func NewSAGAPolicy() domain.SAGAPolicy {
+	return domain.SAGAPolicy{
+		Deadline:  viper.GetDuration("alexandria.saga.timeout.deadline"),
+		Retries:   viper.GetInt("alexandria.saga.timeout.retries"),
+		Interval:  viper.GetDuration("alexandria.saga.timeout.interval"),
+		BatchSize: viper.GetInt("alexandria.saga.timeout.batch"),
+	}
+}
@@ -0,0 +1,19 @@
+package infrastructure
+
+import (
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.persistence.views.interval", "1s")
+	viper.SetDefault("alexandria.persistence.views.batch", 100)
+}
+
+// NewViewPolicy returns the total_views aggregation policy from the current configuration
+func NewViewPolicy() domain.ViewPolicy {
+	return domain.ViewPolicy{
+		Interval:  viper.GetDuration("alexandria.persistence.views.interval"),
+		BatchSize: viper.GetInt("alexandria.persistence.views.batch"),
+	}
+}
@@ -0,0 +1,194 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+)
+
+// AuthorCascade applies the cascade policy over the authors of removed users, cascades are
+// idempotent, authors already cascaded are no longer owned by the user or get skipped
+type AuthorCascade struct {
+	log        log.Logger
+	repository domain.AuthorRepository
+	event      domain.AuthorEventBus
+	reports    domain.CascadeReportRepository
+	policy     domain.CascadePolicy
+}
+
+func NewAuthorCascade(logger log.Logger, repository domain.AuthorRepository, bus domain.AuthorEventBus,
+	reports domain.CascadeReportRepository, policy domain.CascadePolicy) *AuthorCascade {
+	return &AuthorCascade{
+		log:        logger,
+		repository: repository,
+		event:      bus,
+		reports:    reports,
+		policy:     policy,
+	}
+}
+
+// Cascade applies the given action over the authors of the given user, the policy's action is used if
+// none was given. Dry-runs only report the affected authors, the policy's dry-run mode turns every cascade
+// into a dry-run
+func (u *AuthorCascade) Cascade(ctx context.Context, userID, action string, dryRun bool) (*domain.CascadeReport, error) {
+	if userID == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField,
+			fmt.Sprintf(exception.RequiredFieldString, "user_id"))
+	}
+	if action == "" {
+		action = u.policy.Action
+	}
+	dryRun = dryRun || u.policy.DryRun
+	action, err := domain.ParseCascadeAction(action)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	authors, err := u.repository.FetchByOwner(ctxR, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domain.NewCascadeReport(userID, action, dryRun)
+	items := make([]*domain.CascadeItem, 0, len(authors))
+	for _, author := range authors {
+		// Shared authors are kept, only the removed user leaves them
+		if len(author.Owners) > 1 {
+			items = append(items, report.Plan(author.ExternalID, domain.CascadeRemoveOwner))
+			continue
+		}
+		items = append(items, report.Plan(author.ExternalID, action))
+	}
+
+	if dryRun {
+		report.Finish()
+		// Dry-runs never hide the report of an actual cascade
+		if prev, err := u.reports.FetchByID(ctxR, userID); err != nil || prev.DryRun {
+			u.saveReport(ctxR, report)
+		}
+		return report, nil
+	}
+
+	u.saveReport(ctxR, report)
+	for i, author := range authors {
+		status, err := u.apply(ctxR, author, userID, items[i].Action)
+		report.Resolve(items[i], status, err)
+		u.saveReport(ctxR, report)
+	}
+	report.Finish()
+	u.saveReport(ctxR, report)
+
+	if report.Failed > 0 {
+		return report, fmt.Errorf("%d of %d authors of user %s could not be cascaded", report.Failed,
+			report.Total, userID)
+	}
+
+	_ = u.log.Log("method", "author.interactor.cascade", "msg",
+		fmt.Sprintf("%d authors of user %s cascaded", report.Total, userID))
+	return report, nil
+}
+
+// GetReport returns the latest cascade report of the given user
+func (u *AuthorCascade) GetReport(ctx context.Context, userID string) (*domain.CascadeReport, error) {
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	return u.reports.FetchByID(ctxR, userID)
+}
+
+// apply cascades a single author, failed events roll the author back
+func (u *AuthorCascade) apply(ctx context.Context, author *domain.Author, userID, action string) (string, error) {
+	authorBackup := *author
+
+	switch action {
+	case domain.CascadeRemoveOwner:
+		// The oldest remaining owner takes over the removed user's admin role
+		if owner := author.Owner(userID); owner != nil && owner.Role == domain.RoleAdmin &&
+			len(author.OwnerPool(domain.RoleAdmin)) == 1 {
+			for _, o := range author.Owners {
+				if o.ID != userID {
+					if err := author.ChangeRole(o.ID, domain.RoleAdmin); err != nil {
+						return "", err
+					}
+					break
+				}
+			}
+		}
+		if err := author.RemoveOwner(userID); err != nil {
+			return "", err
+		}
+
+		return u.replace(ctx, author, authorBackup)
+	case domain.CascadeTransfer:
+		author.OwnershipType = string(domain.CommunityOwner)
+		author.Owners = []*domain.Owner{domain.NewOwner(u.policy.CommunityOwner, domain.RoleAdmin)}
+
+		return u.replace(ctx, author, authorBackup)
+	case domain.CascadeSoftDelete:
+		if !author.Active {
+			return domain.CascadeItemSkipped, nil
+		}
+
+		if err := u.repository.Remove(ctx, author.ExternalID); err != nil {
+			return "", err
+		}
+		if err := u.event.Removed(ctx, author.ExternalID); err != nil {
+			// Rollback
+			if errR := u.repository.Restore(ctx, author.ExternalID); errR != nil {
+				_ = u.log.Log("method", "author.interactor.cascade", "err", errR.Error())
+			}
+			return "", err
+		}
+
+		return domain.CascadeItemDone, nil
+	case domain.CascadeHardDelete:
+		if err := u.repository.HardRemove(ctx, author.ExternalID); err != nil {
+			return "", err
+		}
+		// Blob service removes the author's picture
+		if err := u.event.HardRemoved(ctx, author.ExternalID); err != nil {
+			// Rollback
+			if errR := u.repository.SaveRaw(ctx, authorBackup); errR != nil {
+				_ = u.log.Log("method", "author.interactor.cascade", "err", errR.Error())
+			}
+			return "", err
+		}
+
+		return domain.CascadeItemDone, nil
+	default:
+		return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "cascade_action", action))
+	}
+}
+
+// replace stores the given author and propagates its side-effects, rolls back to backup on failure
+func (u *AuthorCascade) replace(ctx context.Context, author *domain.Author, authorBackup domain.Author) (string, error) {
+	author.UpdateTime = time.Now()
+	if err := u.repository.Replace(ctx, *author); err != nil {
+		return "", err
+	}
+
+	if err := u.event.Updated(ctx, *author); err != nil {
+		// Rollback
+		if errR := u.repository.Replace(ctx, authorBackup); errR != nil {
+			_ = u.log.Log("method", "author.interactor.cascade", "err", errR.Error())
+		}
+		return "", err
+	}
+
+	return domain.CascadeItemDone, nil
+}
+
+// saveReport stores the cascade progress, reports are informative hence failures are only logged
+func (u *AuthorCascade) saveReport(ctx context.Context, report *domain.CascadeReport) {
+	if err := u.reports.Save(ctx, *report); err != nil {
+		_ = u.log.Log("method", "author.interactor.cascade", "err", err.Error())
+	}
+}
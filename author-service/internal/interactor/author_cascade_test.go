@@ -0,0 +1,148 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// cascadeRepository in-memory authors, any other repository call panics
+type cascadeRepository struct {
+	domain.AuthorRepository
+	authors map[string]*domain.Author
+}
+
+func (r *cascadeRepository) FetchByOwner(_ context.Context, ownerID string) ([]*domain.Author, error) {
+	authors := make([]*domain.Author, 0)
+	for _, id := range []string{"author-a", "author-b", "author-c"} {
+		if author, ok := r.authors[id]; ok && author.Owner(ownerID) != nil {
+			a := *author
+			authors = append(authors, &a)
+		}
+	}
+	return authors, nil
+}
+
+func (r *cascadeRepository) Replace(_ context.Context, author domain.Author) error {
+	r.authors[author.ExternalID] = &author
+	return nil
+}
+
+func (r *cascadeRepository) Remove(_ context.Context, id string) error {
+	r.authors[id].Active = false
+	return nil
+}
+
+func (r *cascadeRepository) Restore(_ context.Context, id string) error {
+	r.authors[id].Active = true
+	return nil
+}
+
+// cascadeEventBus records published events, fails removals of the given author
+type cascadeEventBus struct {
+	domain.AuthorEventBus
+	events []string
+	failID string
+}
+
+func (b *cascadeEventBus) Updated(_ context.Context, author domain.Author) error {
+	b.events = append(b.events, domain.AuthorUpdated+":"+author.ExternalID)
+	return nil
+}
+
+func (b *cascadeEventBus) Removed(_ context.Context, id string) error {
+	if id == b.failID {
+		return errors.New("kafka not available")
+	}
+	b.events = append(b.events, domain.AuthorRemoved+":"+id)
+	return nil
+}
+
+type cascadeReports map[string]domain.CascadeReport
+
+func (r cascadeReports) Save(_ context.Context, report domain.CascadeReport) error {
+	r[report.UserID] = report
+	return nil
+}
+
+func (r cascadeReports) FetchByID(_ context.Context, userID string) (*domain.CascadeReport, error) {
+	report, ok := r[userID]
+	if !ok {
+		return nil, exception.EntityNotFound
+	}
+	return &report, nil
+}
+
+func newCascadeAuthor(id string, owners ...*domain.Owner) *domain.Author {
+	author := domain.NewAuthor("Isaac", "Newton", "", "private", owners[0])
+	author.ExternalID = id
+	author.Owners = owners
+	return author
+}
+
+func TestAuthorCascade_Cascade(t *testing.T) {
+	repo := &cascadeRepository{authors: map[string]*domain.Author{
+		"author-a": newCascadeAuthor("author-a", domain.NewOwner("user-1", domain.RoleAdmin)),
+		"author-b": newCascadeAuthor("author-b", domain.NewOwner("user-1", domain.RoleAdmin),
+			domain.NewOwner("user-2", domain.RoleContrib)),
+		"author-c": newCascadeAuthor("author-c", domain.NewOwner("user-2", domain.RoleAdmin)),
+	}}
+	bus := &cascadeEventBus{failID: "author-a"}
+	reports := cascadeReports{}
+	u := NewAuthorCascade(log.NewNopLogger(), repo, bus, reports, domain.CascadePolicy{
+		Action:         domain.CascadeSoftDelete,
+		CommunityOwner: "community",
+	})
+
+	_, err := u.Cascade(context.Background(), "user-1", "purge", false)
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+
+	// Dry-runs only list the affected authors
+	report, err := u.Cascade(context.Background(), "user-1", "", true)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, domain.CascadeSoftDelete, report.Items[0].Action)
+	assert.Equal(t, domain.CascadeRemoveOwner, report.Items[1].Action)
+	assert.Equal(t, domain.CascadeItemPlanned, report.Items[1].Status)
+	assert.Empty(t, bus.events)
+	assert.True(t, repo.authors["author-a"].Active)
+
+	// Failed items are rolled back and reported
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.NotNil(t, err)
+	assert.Equal(t, domain.CascadeFailed, report.Status)
+	assert.Equal(t, domain.CascadeItemFailed, report.Items[0].Status)
+	assert.True(t, repo.authors["author-a"].Active)
+	// Shared authors keep an admin
+	assert.Equal(t, []string{"user-2"}, repo.authors["author-b"].OwnerPool(domain.RoleAdmin))
+	assert.Equal(t, []string{domain.AuthorUpdated + ":author-b"}, bus.events)
+
+	// Retries only apply pending items
+	bus.failID = ""
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, report.Total)
+	assert.Equal(t, domain.CascadeDone, report.Status)
+	assert.False(t, repo.authors["author-a"].Active)
+
+	report, err = u.GetReport(context.Background(), "user-1")
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CascadeDone, report.Status)
+
+	// Already cascaded authors are skipped
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CascadeItemSkipped, report.Items[0].Status)
+
+	// Transferred authors are owned by the community
+	report, err = u.Cascade(context.Background(), "user-2", domain.CascadeTransfer, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, string(domain.CommunityOwner), repo.authors["author-c"].OwnershipType)
+	assert.Equal(t, []string{"community"}, repo.authors["author-c"].OwnerPool())
+}
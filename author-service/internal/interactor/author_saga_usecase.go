@@ -8,6 +8,7 @@ import (
 	"github.com/alexandria-oss/core/exception"
 	"github.com/alexandria-oss/core/httputil"
 	"github.com/go-kit/kit/log"
+	"github.com/google/uuid"
 	"github.com/maestre3d/alexandria/author-service/internal/domain"
 	"strings"
 )
@@ -17,14 +18,19 @@ type AuthorSAGA struct {
 	repository domain.AuthorRepository
 	eventSAGA  domain.AuthorSAGAEventBus
 	eventBus   domain.AuthorEventBus
+	pending    domain.PendingTransactionRepository
+	uow        domain.UnitOfWork
 }
 
-func NewAuthorSAGA(logger log.Logger, repo domain.AuthorRepository, event domain.AuthorSAGAEventBus, eventBus domain.AuthorEventBus) *AuthorSAGA {
+func NewAuthorSAGA(logger log.Logger, repo domain.AuthorRepository, event domain.AuthorSAGAEventBus, eventBus domain.AuthorEventBus,
+	pending domain.PendingTransactionRepository, uow domain.UnitOfWork) *AuthorSAGA {
 	return &AuthorSAGA{
 		logger:     logger,
 		repository: repo,
 		eventSAGA:  event,
 		eventBus:   eventBus,
+		pending:    pending,
+		uow:        uow,
 	}
 }
 
@@ -122,20 +128,41 @@ func (u *AuthorSAGA) Verify(ctx context.Context, service string, authorsJSON []b
 			"owner_pool", "[]string"))
 	}
 
+	// The whole pool is verified, every missing author is reported at once
+	missing := make([]string, 0)
+	seen := make(map[string]bool, len(authors))
 	for _, id := range authors {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
 		_, err := u.repository.FetchByID(ctxR, id, false)
-		if code := httputil.ErrorToCode(err); err != nil && code != 500 {
-			// Rollback if user (e.g. HTTP 404) error
-			errE := u.eventSAGA.Failed(ctxR, service, err.Error())
-			if errE != nil {
-				// Error during publishing
-				return errE
-			}
+		if code := httputil.ErrorToCode(err); err != nil && code == 500 {
+			// Infrastructure errors must not resolve the transaction, the event is consumed again
+			_ = u.logger.Log("method", "author.interactor.saga.verify", "err", err.Error())
+			return err
+		} else if err != nil {
+			missing = append(missing, id)
+		}
+	}
 
-			_ = u.logger.Log("method", "author.interactor.saga.verify", "msg", strings.ToUpper(service)+"_"+domain.AuthorFailed+
-				" integration event published")
+	if len(seen) == 0 || len(missing) > 0 {
+		msg := "missing authors"
+		if len(missing) > 0 {
+			msg = fmt.Sprintf("authors %s not found", strings.Join(missing, ", "))
+		}
+
+		// Rollback if user (e.g. HTTP 404) error
+		err = u.eventSAGA.Failed(ctxR, service, msg)
+		if err != nil {
+			// Error during publishing
 			return err
 		}
+
+		_ = u.logger.Log("method", "author.interactor.saga.verify", "msg", strings.ToUpper(service)+"_"+domain.AuthorFailed+
+			" integration event published")
+		return exception.NewErrorDescription(exception.EntityNotFound, msg)
 	}
 
 	// All Authors have been verified
@@ -151,7 +178,7 @@ func (u *AuthorSAGA) Verify(ctx context.Context, service string, authorsJSON []b
 
 // Finishing actions
 
-func (u *AuthorSAGA) Done(ctx context.Context, rootID, operation string) error {
+func (u *AuthorSAGA) Done(ctx context.Context, rootID, transactionID, operation string) error {
 	if operation != domain.AuthorCreated && operation != domain.AuthorUpdated {
 		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
 			"operation", domain.AuthorCreated+" or "+domain.AuthorUpdated))
@@ -160,63 +187,53 @@ func (u *AuthorSAGA) Done(ctx context.Context, rootID, operation string) error {
 	ctxR, cl := context.WithCancel(ctx)
 	defer cl()
 
-	err := u.repository.ChangeState(ctxR, rootID, domain.StatusDone)
-	if err != nil {
-		return err
-	}
 	// Domain Event nomenclature -> APP_NAME.SERVICE.ACTION
-	// Propagate side-effects
-	errC := make(chan error)
-	defer close(errC)
+	// Side-effects are propagated before commit, state change is rolled back if the event could not be sent
+	event := domain.AuthorCreated
+	superseded := false
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		// Responses of replaced transactions must not change the author
+		_, ok, err := u.claim(ctxT, rootID, transactionID)
+		if err != nil {
+			return err
+		} else if !ok {
+			superseded = true
+			return nil
+		}
 
-	go func() {
-		ctxE, cl := context.WithCancel(ctx)
-		defer cl()
+		if err = u.repository.ChangeState(ctxT, rootID, domain.StatusDone); err != nil {
+			return err
+		}
 
 		// Get author to properly propagate side-effects with respective payload
 		// Using repo directly to avoid non-organic views
-		author, err := u.repository.FetchByID(ctxE, rootID, false)
+		author, err := u.repository.FetchByID(ctxT, rootID, false)
 		if err != nil {
-			errC <- err
-			return
+			return err
 		}
 
-		event := domain.AuthorCreated
-		if operation == domain.AuthorCreated {
-			err = u.eventSAGA.Created(ctxE, *author)
-		} else if operation == domain.AuthorUpdated {
-			err = u.eventBus.Updated(ctxE, *author)
+		if operation == domain.AuthorUpdated {
 			event = domain.AuthorUpdated
-		}
-		if err != nil {
-			// Rollback
-			err = u.repository.ChangeState(ctxE, rootID, domain.StatusPending)
-			if err != nil {
-				// Failed to rollback
-				errC <- err
-				return
-			}
-			_ = u.logger.Log("method", "author.interactor.saga.done", "msg", "could not send event, rolled back")
-			errC <- err
-			return
+			return u.eventBus.Updated(ctxT, *author)
 		}
 
-		_ = u.logger.Log("method", "author.interactor.saga.done", "msg", event+" event published")
-		errC <- nil
-	}()
-
-	select {
-	case err = <-errC:
-		if err != nil {
-			return err
-		}
+		return u.eventSAGA.Created(ctxT, *author)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "author.interactor.saga.done", "err", err.Error())
+		return err
+	} else if superseded {
+		_ = u.logger.Log("method", "author.interactor.saga.done", "msg", "transaction already resolved or replaced",
+			"root_id", rootID, "transaction_id", transactionID)
+		return nil
 	}
 
+	_ = u.logger.Log("method", "author.interactor.saga.done", "msg", event+" event published")
 	return nil
 }
 
 // Failed Restore or hard delete author for rollback, mostly for SAGA transactions
-func (u *AuthorSAGA) Failed(ctx context.Context, rootID, operation, snapshot string) error {
+func (u *AuthorSAGA) Failed(ctx context.Context, rootID, transactionID, operation, snapshot string) error {
 	if operation != domain.AuthorCreated && operation != domain.AuthorUpdated {
 		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
 			"operation", domain.AuthorCreated+" or "+domain.AuthorUpdated))
@@ -226,9 +243,151 @@ func (u *AuthorSAGA) Failed(ctx context.Context, rootID, operation, snapshot str
 	defer cl()
 
 	// Perform preferred local rollback
-	var err error
+	superseded := false
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		_, ok, err := u.claim(ctxT, rootID, transactionID)
+		if err != nil {
+			return err
+		} else if !ok {
+			superseded = true
+			return nil
+		}
+
+		return u.rollback(ctxT, rootID, operation, snapshot)
+	})
+	if err != nil {
+		return err
+	} else if superseded {
+		_ = u.logger.Log("method", "author.interactor.failed", "msg", "transaction already resolved or replaced",
+			"root_id", rootID, "transaction_id", transactionID)
+		return nil
+	}
+
+	_ = u.logger.Log("method", "author.interactor.failed", "msg", fmt.Sprintf("author %s rolled back", rootID),
+		"operation", operation)
+
+	return nil
+}
+
+// Timeout actions
+
+// Retry Re-emit the verification request of the given expired transaction under a new transaction ID, discard
+// the transaction if its author no longer exists
+func (u *AuthorSAGA) Retry(ctx context.Context, tx domain.PendingTransaction) error {
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	discarded, superseded := false, false
+	renewedID := uuid.New().String()
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		// Disabled authors keep their transaction, it must be verified if restored
+		author, err := u.repository.FetchByID(ctxT, tx.RootID, true)
+		if errors.Is(err, exception.EntityNotFound) {
+			// Hard removed authors are never verified, nothing is left to roll back
+			_, discarded, err = u.claim(ctxT, tx.RootID, tx.ID)
+			superseded = !discarded
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		// Responses of the expired request are ignored from now on
+		err = u.pending.Attempt(ctxT, tx.RootID, tx.ID, renewedID)
+		if errors.Is(err, exception.EntityNotFound) {
+			superseded = true
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// Attempt is not counted if the request could not be sent, it is re-emitted on the next deadline
+		if tx.Operation == domain.AuthorUpdated {
+			authorSnapshot := new(domain.Author)
+			err = json.Unmarshal([]byte(tx.Snapshot), authorSnapshot)
+			if err != nil {
+				return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+					"snapshot", "snapshot entity"))
+			}
+
+			return u.eventBus.StartUpdate(ctxT, renewedID, *author, *authorSnapshot)
+		}
+
+		return u.eventBus.StartCreate(ctxT, renewedID, *author)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "author.interactor.saga.retry", "err", err.Error(), "root_id", tx.RootID)
+		return err
+	}
+
+	if superseded {
+		_ = u.logger.Log("method", "author.interactor.saga.retry", "msg", "transaction already resolved or replaced",
+			"root_id", tx.RootID, "transaction_id", tx.ID)
+		return nil
+	} else if discarded {
+		_ = u.logger.Log("method", "author.interactor.saga.retry", "msg", "transaction of removed author discarded",
+			"root_id", tx.RootID)
+		return nil
+	}
+
+	_ = u.logger.Log("method", "author.interactor.saga.retry", "msg", domain.OwnerVerify+" integration event published",
+		"root_id", tx.RootID, "attempt", tx.Attempts+1)
+	return nil
+}
+
+// TimeOut Roll back the given expired transaction the same way Failed does and propagate the timeout
+func (u *AuthorSAGA) TimeOut(ctx context.Context, tx domain.PendingTransaction) error {
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	var claimed *domain.PendingTransaction
+	err := u.uow.Do(ctxR, func(ctxT context.Context) (err error) {
+		// A verification response might have resolved the transaction since it was fetched
+		var ok bool
+		claimed, ok, err = u.claim(ctxT, tx.RootID, tx.ID)
+		if err != nil || !ok {
+			return err
+		}
+
+		return u.rollback(ctxT, claimed.RootID, claimed.Operation, claimed.Snapshot)
+	})
+	if err != nil {
+		return err
+	} else if claimed == nil {
+		_ = u.logger.Log("method", "author.interactor.saga.time_out", "msg", "transaction already resolved or replaced",
+			"root_id", tx.RootID, "transaction_id", tx.ID)
+		return nil
+	}
+
+	err = u.eventSAGA.TimedOut(ctxR, *claimed)
+	if err != nil {
+		// Author was already rolled back, timeout propagation is best-effort
+		_ = u.logger.Log("method", "author.interactor.saga.time_out", "err", err.Error(), "root_id", tx.RootID)
+		return nil
+	}
+
+	_ = u.logger.Log("method", "author.interactor.saga.time_out", "msg", domain.AuthorSAGATimedOut+" event published",
+		"root_id", tx.RootID, "operation", tx.Operation)
+	return nil
+}
+
+// claim Take the given running transaction, ok is false if the transaction was already resolved or replaced by
+// another one, the author must be left untouched then
+func (u *AuthorSAGA) claim(ctx context.Context, rootID, transactionID string) (tx *domain.PendingTransaction, ok bool,
+	err error) {
+	tx, err = u.pending.Claim(ctx, rootID, transactionID)
+	if errors.Is(err, exception.EntityNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	return tx, true, nil
+}
+
+// rollback Hard remove a created author or restore an updated author from the given snapshot
+func (u *AuthorSAGA) rollback(ctx context.Context, rootID, operation, snapshot string) (err error) {
 	if operation == domain.AuthorCreated {
-		err = u.repository.HardRemove(ctxR, rootID)
+		err = u.repository.HardRemove(ctx, rootID)
 	} else if operation == domain.AuthorUpdated {
 		authorSnapshot := new(domain.Author)
 		err = json.Unmarshal([]byte(snapshot), authorSnapshot)
@@ -237,7 +396,7 @@ func (u *AuthorSAGA) Failed(ctx context.Context, rootID, operation, snapshot str
 				"snapshot", "snapshot entity"))
 		}
 
-		err = u.repository.Replace(ctxR, *authorSnapshot)
+		err = u.repository.Replace(ctx, *authorSnapshot)
 	}
 
 	// Avoid not found errors to send acknowledgement to broker
@@ -245,8 +404,5 @@ func (u *AuthorSAGA) Failed(ctx context.Context, rootID, operation, snapshot str
 		return err
 	}
 
-	_ = u.logger.Log("method", "author.interactor.failed", "msg", fmt.Sprintf("author %s rolled back", rootID),
-		"operation", operation)
-
 	return nil
 }
@@ -0,0 +1,258 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// verifyRepository existing authors, fetching the down author fails
+type verifyRepository struct {
+	domain.AuthorRepository
+	authors map[string]bool
+	fetched []string
+}
+
+func (r *verifyRepository) FetchByID(_ context.Context, id string, _ bool) (*domain.Author, error) {
+	r.fetched = append(r.fetched, id)
+	if id == "down" {
+		return nil, errors.New("connection refused")
+	} else if !r.authors[id] {
+		return nil, exception.EntityNotFound
+	}
+
+	return &domain.Author{ExternalID: id}, nil
+}
+
+// verifyEventBus records the verification outcome
+type verifyEventBus struct {
+	domain.AuthorSAGAEventBus
+	verified bool
+	failed   string
+}
+
+func (b *verifyEventBus) Verified(_ context.Context, _ string) error {
+	b.verified = true
+	return nil
+}
+
+func (b *verifyEventBus) Failed(_ context.Context, _, msg string) error {
+	b.failed = msg
+	return nil
+}
+
+func TestAuthorSAGA_Verify(t *testing.T) {
+	verify := func(pool string) (*verifyRepository, *verifyEventBus, error) {
+		repo := &verifyRepository{authors: map[string]bool{"a": true, "b": true}}
+		bus := new(verifyEventBus)
+		err := NewAuthorSAGA(log.NewNopLogger(), repo, bus, nil, nil, nil).Verify(context.Background(), "media",
+			[]byte(pool))
+		return repo, bus, err
+	}
+
+	repo, bus, err := verify(`["a", "b", "a"]`)
+	assert.Nil(t, err)
+	assert.True(t, bus.verified)
+	assert.Equal(t, []string{"a", "b"}, repo.fetched)
+
+	// Every missing author is reported
+	repo, bus, err = verify(`["x", "a", "y"]`)
+	assert.True(t, errors.Is(err, exception.EntityNotFound))
+	assert.False(t, bus.verified)
+	assert.Equal(t, "authors x, y not found", bus.failed)
+	assert.Equal(t, []string{"x", "a", "y"}, repo.fetched)
+
+	_, bus, err = verify(`[]`)
+	assert.NotNil(t, err)
+	assert.Equal(t, "missing authors", bus.failed)
+
+	// Unavailable repositories never resolve the transaction
+	_, bus, err = verify(`["a", "down"]`)
+	assert.NotNil(t, err)
+	assert.False(t, bus.verified)
+	assert.Empty(t, bus.failed)
+}
+
+// retryRepository stores authors in memory
+type retryRepository struct {
+	domain.AuthorRepository
+	authors map[string]*domain.Author
+}
+
+func (r *retryRepository) FetchByID(_ context.Context, id string, _ bool) (*domain.Author, error) {
+	author, ok := r.authors[id]
+	if !ok {
+		return nil, exception.EntityNotFound
+	}
+
+	return author, nil
+}
+
+func (r *retryRepository) ChangeState(_ context.Context, id, state string) error {
+	author, ok := r.authors[id]
+	if !ok {
+		return exception.EntityNotFound
+	}
+
+	author.Status = state
+	return nil
+}
+
+func (r *retryRepository) HardRemove(_ context.Context, id string) error {
+	if _, ok := r.authors[id]; !ok {
+		return exception.EntityNotFound
+	}
+
+	delete(r.authors, id)
+	return nil
+}
+
+type pendingRepository struct {
+	transactions map[string]*domain.PendingTransaction
+}
+
+func (r *pendingRepository) Save(_ context.Context, tx domain.PendingTransaction) error {
+	r.transactions[tx.RootID] = &tx
+	return nil
+}
+
+func (r *pendingRepository) FetchExpired(_ context.Context, since time.Time, limit int) ([]*domain.PendingTransaction, error) {
+	transactions := make([]*domain.PendingTransaction, 0)
+	for _, tx := range r.transactions {
+		if tx.UpdateTime.Before(since) && len(transactions) < limit {
+			transactions = append(transactions, tx)
+		}
+	}
+
+	return transactions, nil
+}
+
+func (r *pendingRepository) Attempt(_ context.Context, rootID, transactionID, renewedID string) error {
+	tx, ok := r.transactions[rootID]
+	if !ok || tx.ID != transactionID {
+		return exception.EntityNotFound
+	}
+
+	tx.ID = renewedID
+	tx.Attempts++
+	tx.UpdateTime = time.Now()
+	return nil
+}
+
+func (r *pendingRepository) Claim(_ context.Context, rootID, transactionID string) (*domain.PendingTransaction, error) {
+	tx, ok := r.transactions[rootID]
+	if !ok || tx.ID != transactionID {
+		return nil, exception.EntityNotFound
+	}
+
+	delete(r.transactions, rootID)
+	return tx, nil
+}
+
+// retryEventBus records emitted events by name
+type retryEventBus struct {
+	domain.AuthorEventBus
+	domain.AuthorSAGAEventBus
+	events []string
+}
+
+func (b *retryEventBus) StartCreate(_ context.Context, _ string, author domain.Author) error {
+	b.events = append(b.events, domain.OwnerVerify+":"+author.ExternalID)
+	return nil
+}
+
+func (b *retryEventBus) Created(_ context.Context, author domain.Author) error {
+	b.events = append(b.events, domain.AuthorCreated+":"+author.ExternalID)
+	return nil
+}
+
+func (b *retryEventBus) TimedOut(_ context.Context, tx domain.PendingTransaction) error {
+	b.events = append(b.events, domain.AuthorSAGATimedOut+":"+tx.RootID)
+	return nil
+}
+
+type retryUnitOfWork struct{}
+
+func (retryUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newRetrySAGA(rootIDs ...string) (*AuthorSAGA, *retryRepository, *pendingRepository, *retryEventBus) {
+	repo := &retryRepository{authors: map[string]*domain.Author{}}
+	pending := &pendingRepository{transactions: map[string]*domain.PendingTransaction{}}
+	bus := new(retryEventBus)
+	for _, id := range rootIDs {
+		repo.authors[id] = &domain.Author{ExternalID: id, Status: domain.StatusPending}
+		tx := domain.NewPendingTransaction(id, domain.AuthorCreated, "")
+		tx.UpdateTime = time.Now().Add(-time.Hour)
+		pending.transactions[id] = tx
+	}
+
+	return NewAuthorSAGA(log.NewNopLogger(), repo, bus, bus, pending, retryUnitOfWork{}), repo, pending, bus
+}
+
+func TestAuthorSAGA_Retry(t *testing.T) {
+	saga, repo, pending, bus := newRetrySAGA("frank")
+	tx := *pending.transactions["frank"]
+
+	assert.Nil(t, saga.Retry(context.Background(), tx))
+	assert.Equal(t, []string{domain.OwnerVerify + ":frank"}, bus.events)
+	assert.Equal(t, 1, pending.transactions["frank"].Attempts)
+	// Re-emitted request starts a new transaction, responses of the expired request are ignored
+	assert.NotEqual(t, tx.ID, pending.transactions["frank"].ID)
+	assert.Nil(t, saga.Done(context.Background(), "frank", tx.ID, domain.AuthorCreated))
+	assert.Equal(t, domain.StatusPending, repo.authors["frank"].Status)
+
+	// Hard removed authors are never verified, transaction must not be retried forever
+	delete(repo.authors, "frank")
+	assert.Nil(t, saga.Retry(context.Background(), *pending.transactions["frank"]))
+	assert.Len(t, bus.events, 1)
+	assert.Empty(t, pending.transactions)
+}
+
+func TestAuthorSAGA_TimeOutResolved(t *testing.T) {
+	saga, repo, pending, bus := newRetrySAGA("frank")
+	tx := *pending.transactions["frank"]
+
+	// Verification response claims the transaction before the watchdog
+	assert.Nil(t, saga.Done(context.Background(), "frank", tx.ID, domain.AuthorCreated))
+	assert.Equal(t, domain.StatusDone, repo.authors["frank"].Status)
+	assert.Empty(t, pending.transactions)
+
+	// Verified authors are never rolled back
+	assert.Nil(t, saga.TimeOut(context.Background(), tx))
+	assert.Contains(t, repo.authors, "frank")
+	assert.Equal(t, []string{domain.AuthorCreated + ":frank"}, bus.events)
+}
+
+func TestAuthorSAGAWatchdog_Resolve(t *testing.T) {
+	saga, repo, pending, bus := newRetrySAGA("frank", "jane", "removed")
+	pending.transactions["jane"].Attempts = 3
+	delete(repo.authors, "removed")
+	w := NewAuthorSAGAWatchdog(saga, pending, domain.SAGAPolicy{
+		Deadline:  time.Minute,
+		Retries:   3,
+		Interval:  time.Minute,
+		BatchSize: 10,
+	}, log.NewNopLogger())
+
+	total, err := w.Resolve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, total)
+	assert.ElementsMatch(t, []string{domain.OwnerVerify + ":frank", domain.AuthorSAGATimedOut + ":jane"}, bus.events)
+	// Timed out authors are rolled back, removed author transactions are discarded
+	assert.NotContains(t, repo.authors, "jane")
+	assert.Len(t, pending.transactions, 1)
+	assert.Contains(t, pending.transactions, "frank")
+
+	// Retried transaction is not expired yet
+	total, err = w.Resolve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+}
@@ -2,6 +2,7 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/alexandria-oss/core"
 	"github.com/alexandria-oss/core/exception"
@@ -16,18 +17,34 @@ import (
 type Author struct {
 	log        log.Logger
 	repository domain.AuthorRepository
+	cursor     domain.CursorCodec
 	event      domain.AuthorEventBus
+	view       domain.AuthorViewEvent
+	pending    domain.PendingTransactionRepository
 }
 
 // NewAuthor Create a new author interact
-func NewAuthor(logger log.Logger, repository domain.AuthorRepository, bus domain.AuthorEventBus) *Author {
-	return &Author{logger, repository, bus}
+func NewAuthor(logger log.Logger, repository domain.AuthorRepository, cursor domain.CursorCodec, bus domain.AuthorEventBus,
+	view domain.AuthorViewEvent, pending domain.PendingTransactionRepository) *Author {
+	return &Author{logger, repository, cursor, bus, view, pending}
 }
 
 // Create Store a new entity
 func (u *Author) Create(ctx context.Context, aggregate *domain.AuthorAggregate) (*domain.Author, error) {
-	author := domain.NewAuthor(aggregate.FirstName, aggregate.LastName, aggregate.DisplayName, aggregate.OwnershipType, aggregate.OwnerID,
-		aggregate.Country)
+	identity, ok := domain.IdentityFromContext(ctx)
+	if !ok {
+		return nil, exception.NewErrorDescription(domain.ErrForbidden, "caller is not authenticated")
+	}
+	// Author is administrated by its caller, administrators may create authors on behalf of another user
+	if !identity.Admin || aggregate.OwnerID == "" {
+		aggregate.OwnerID = identity.Subject
+	}
+
+	author := domain.NewAuthor(aggregate.FirstName, aggregate.LastName, aggregate.DisplayName, aggregate.OwnershipType,
+		domain.NewOwner(aggregate.OwnerID, domain.RoleAdmin))
+	if aggregate.Country != "" {
+		author.Country = aggregate.Country
+	}
 	err := author.IsValid()
 	if err != nil {
 		return nil, err
@@ -41,6 +58,14 @@ func (u *Author) Create(ctx context.Context, aggregate *domain.AuthorAggregate)
 		return nil, err
 	}
 
+	// Keep track of the transaction until it gets resolved, see AuthorSAGAWatchdog
+	tx := domain.NewPendingTransaction(author.ExternalID, domain.AuthorCreated, "")
+	err = u.pending.Save(ctxR, *tx)
+	if err != nil {
+		_ = u.repository.HardRemove(ctxR, author.ExternalID)
+		return nil, err
+	}
+
 	// Domain Event nomenclature -> APP_NAME.SERVICE.ACTION
 	// Transaction/interaction event, required owner/user validation, use concurrent-safe routine
 	errChan := make(chan error)
@@ -48,7 +73,7 @@ func (u *Author) Create(ctx context.Context, aggregate *domain.AuthorAggregate)
 		ctxE, cl := context.WithCancel(ctx)
 		defer cl()
 
-		err = u.event.StartCreate(ctxE, *author)
+		err = u.event.StartCreate(ctxE, tx.ID, *author)
 		if err != nil {
 			_ = u.log.Log("method", "author.interactor.create", "err", err.Error())
 
@@ -57,6 +82,9 @@ func (u *Author) Create(ctx context.Context, aggregate *domain.AuthorAggregate)
 			if err != nil {
 				_ = u.log.Log("method", "author.interactor.create", "err", err.Error())
 			}
+			if _, errP := u.pending.Claim(ctxE, author.ExternalID, tx.ID); errP != nil {
+				_ = u.log.Log("method", "author.interactor.create", "err", errP.Error())
+			}
 
 			_ = u.log.Log("method", "author.interactor.create", "msg", "could not send event, rolled back")
 		} else {
@@ -76,20 +104,53 @@ func (u *Author) Create(ctx context.Context, aggregate *domain.AuthorAggregate)
 	return author, err
 }
 
-// List Get an author's list
-func (u *Author) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Author, nextToken string, err error) {
+// List Obtain a page of authors with its next and previous page tokens, tokens are empty if there is no such page
+func (u *Author) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Author, nextToken, prevToken string, err error) {
 	ctxR, cl := context.WithCancel(ctx)
 	defer cl()
 
 	params := core.NewPaginationParams(pageToken, pageSize)
-	params.Size++
-	output, err = u.repository.Fetch(ctxR, *params, filterParams)
+	cursor := domain.NewCursor(filterParams)
+	if params.Token != "" {
+		c, err := u.cursor.Decode(params.Token)
+		if err != nil {
+			return nil, "", "", err
+		}
+		cursor = *c
+	}
 
-	nextToken = ""
-	if len(output) >= params.Size {
-		nextToken = output[len(output)-1].ExternalID
-		output = output[0 : len(output)-1]
+	// Fetch an extra row to know if there is another page
+	output, err = u.repository.Fetch(ctxR, cursor, params.Size+1, filterParams)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	hasMore := len(output) > params.Size
+	if hasMore {
+		output = output[0:params.Size]
+	}
+	if cursor.Backward {
+		for i, j := 0, len(output)-1; i < j; i, j = i+1, j-1 {
+			output[i], output[j] = output[j], output[i]
+		}
+	}
+
+	// Backward pages always have a next page, forward pages have a previous one if they are not the first page
+	if len(output) > 0 {
+		if hasMore || cursor.Backward {
+			nextToken, err = u.cursor.Encode(domain.NewAuthorCursor(cursor, output[len(output)-1], false))
+			if err != nil {
+				return nil, "", "", err
+			}
+		}
+		if (hasMore && cursor.Backward) || (!cursor.Backward && cursor.ID != "") {
+			prevToken, err = u.cursor.Encode(domain.NewAuthorCursor(cursor, output[0], true))
+			if err != nil {
+				return nil, "", "", err
+			}
+		}
 	}
+
 	return
 }
 
@@ -103,14 +164,18 @@ func (u *Author) Get(ctx context.Context, id string) (*domain.Author, error) {
 		return nil, err
 	}
 
+	// Views are folded into total_views asynchronously, see AuthorViewAggregator
 	if author != nil {
-		author.TotalViews++
-		// Using repo directly to avoid unused fields on use case's update
-		err = u.repository.Replace(ctx, *author)
-		if err != nil {
-			_ = u.log.Log("method", "author.interactor.get", "msg", fmt.Sprintf("could not update total_views for author %s, error: %s",
-				author.ExternalID, err.Error()))
-		}
+		view := domain.NewAuthorView(author.ExternalID, domain.ViewerFromContext(ctx))
+		go func() {
+			ctxE, cancelE := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancelE()
+
+			if err := u.view.Viewed(ctxE, *view); err != nil {
+				_ = u.log.Log("method", "author.interactor.get", "msg", fmt.Sprintf("could not publish view for author %s, error: %s",
+					view.ID, err.Error()))
+			}
+		}()
 	}
 
 	return author, nil
@@ -133,7 +198,11 @@ func (u *Author) Update(ctx context.Context, aggregate *domain.AuthorUpdateAggre
 	if err != nil {
 		return nil, err
 	}
-	authorBackup := author
+	// Every owner may edit the author
+	if err = domain.AuthorizeOwner(ctx, author.OwnerPool()...); err != nil {
+		return nil, err
+	}
+	authorBackup := *author
 
 	// Update entity dynamically
 	if aggregate.RootAggregate.FirstName != "" {
@@ -147,8 +216,13 @@ func (u *Author) Update(ctx context.Context, aggregate *domain.AuthorUpdateAggre
 	}
 	// If new owner id was given, then set author state to pending to start proper
 	// transaction
-	if aggregate.RootAggregate.OwnerID != "" {
-		author.OwnerID = aggregate.RootAggregate.OwnerID
+	if ownerID := aggregate.RootAggregate.OwnerID; ownerID != "" &&
+		(len(author.Owners) != 1 || author.Owners[0].ID != ownerID) {
+		// Only admin owners may transfer the author, new owner takes the whole owner pool
+		if err := domain.AuthorizeOwner(ctx, author.OwnerPool(domain.RoleAdmin)...); err != nil {
+			return nil, err
+		}
+		author.Owners = []*domain.Owner{domain.NewOwner(ownerID, domain.RoleAdmin)}
 		author.Status = domain.StatusPending
 	}
 	if aggregate.RootAggregate.OwnershipType != "" {
@@ -161,6 +235,12 @@ func (u *Author) Update(ctx context.Context, aggregate *domain.AuthorUpdateAggre
 				fmt.Sprintf(exception.InvalidFieldFormatString, "verified", "boolean"))
 		}
 
+		// Verification is granted by the platform, not by the author owners
+		if verified != author.Verified {
+			if err = domain.AuthorizeAdmin(ctx); err != nil {
+				return nil, err
+			}
+		}
 		author.Verified = verified
 	}
 	if aggregate.Picture != "" {
@@ -177,55 +257,80 @@ func (u *Author) Update(ctx context.Context, aggregate *domain.AuthorUpdateAggre
 		return nil, err
 	}
 
-	err = u.repository.Replace(ctxR, *author)
+	err = u.replace(ctx, author, authorBackup, "author.interactor.update")
 	if err != nil {
 		return nil, err
 	}
 
-	// Domain Event nomenclature -> APP_NAME.SERVICE.ACTION
-	// Transaction/interaction event, required owner/user validation, use concurrent-safe routine
-	errChan := make(chan error)
-	go func() {
-		ctxE, cl := context.WithCancel(ctx)
-		defer cl()
+	return author, nil
+}
 
-		// If author owner id is changed, then start transaction with integration event, if not
-		// send a simple domain event to propagate side-effects
-		var eventStr string
-		if author.Status == domain.StatusPending {
-			err = u.event.StartUpdate(ctxE, *author, *authorBackup)
-			if err == nil {
-				eventStr = domain.OwnerVerify + " event published"
-			}
-		} else {
-			err = u.event.Updated(ctxE, *author)
-			if err == nil {
-				eventStr = domain.AuthorUpdated + " event published"
-			}
-		}
+// AddOwner Invite the given user to the owner pool, the author stays pending until the whole
+// owner pool gets verified
+func (u *Author) AddOwner(ctx context.Context, id, ownerID, role string) (*domain.Author, error) {
+	role, err := domain.ParseRole(role)
+	if err != nil {
+		return nil, err
+	}
 
-		if err != nil {
-			_ = u.log.Log("method", "author.interactor.update", "err", err.Error())
+	author, err := u.fetchOwned(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	authorBackup := *author
 
-			// Rollback
-			err = u.repository.Replace(ctxE, *authorBackup)
-			if err != nil {
-				_ = u.log.Log("method", "author.interactor.update", "err", err.Error())
-			}
+	if err = author.AddOwner(domain.NewOwner(ownerID, role)); err != nil {
+		return nil, err
+	}
+	author.UpdateTime = time.Now()
+	// Must execute transaction for user validation
+	author.Status = domain.StatusPending
 
-			_ = u.log.Log("method", "author.interactor.update", "msg", "could not send event, rolled back")
-		} else {
-			_ = u.log.Log("method", "author.interactor.update", "msg", eventStr)
-		}
+	err = u.replace(ctx, author, authorBackup, "author.interactor.add_owner")
+	if err != nil {
+		return nil, err
+	}
 
-		errChan <- err
-	}()
+	return author, nil
+}
 
-	select {
-	case err = <-errChan:
-		if err != nil {
-			return nil, err
-		}
+// RemoveOwner Remove the given user from the owner pool, owners may also remove themselves
+func (u *Author) RemoveOwner(ctx context.Context, id, ownerID string) (*domain.Author, error) {
+	author, err := u.fetchOwned(ctx, id, ownerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	authorBackup := *author
+
+	if err = author.RemoveOwner(ownerID); err != nil {
+		return nil, err
+	}
+	author.UpdateTime = time.Now()
+
+	err = u.replace(ctx, author, authorBackup, "author.interactor.remove_owner")
+	if err != nil {
+		return nil, err
+	}
+
+	return author, nil
+}
+
+// ChangeOwnerRole Set the role of the given owner
+func (u *Author) ChangeOwnerRole(ctx context.Context, id, ownerID, role string) (*domain.Author, error) {
+	author, err := u.fetchOwned(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	authorBackup := *author
+
+	if err = author.ChangeRole(ownerID, role); err != nil {
+		return nil, err
+	}
+	author.UpdateTime = time.Now()
+
+	err = u.replace(ctx, author, authorBackup, "author.interactor.change_owner_role")
+	if err != nil {
+		return nil, err
 	}
 
 	return author, nil
@@ -236,7 +341,16 @@ func (u *Author) Delete(ctx context.Context, id string) error {
 	ctxR, cl := context.WithCancel(ctx)
 	defer cl()
 
-	err := u.repository.Remove(ctxR, id)
+	author, err := u.repository.FetchByID(ctxR, id, false)
+	if err != nil {
+		return err
+	}
+	// Only admin owners may delete the author
+	if err = domain.AuthorizeOwner(ctx, author.OwnerPool(domain.RoleAdmin)...); err != nil {
+		return err
+	}
+
+	err = u.repository.Remove(ctxR, id)
 	if err != nil {
 		return err
 	}
@@ -277,7 +391,15 @@ func (u *Author) Restore(ctx context.Context, id string) error {
 	ctxR, cl := context.WithCancel(ctx)
 	defer cl()
 
-	err := u.repository.Restore(ctxR, id)
+	author, err := u.repository.FetchByID(ctxR, id, true)
+	if err != nil {
+		return err
+	}
+	if err = domain.AuthorizeOwner(ctx, author.OwnerPool(domain.RoleAdmin)...); err != nil {
+		return err
+	}
+
+	err = u.repository.Restore(ctxR, id)
 	if err != nil {
 		return err
 	}
@@ -359,3 +481,105 @@ func (u *Author) HardDelete(ctx context.Context, id string) error {
 
 	return err
 }
+
+// replace Store the given author and propagate its side-effects, authors in pending status
+// start an OWNER_VERIFY transaction over their whole owner pool. Rolls back to backup on failure
+func (u *Author) replace(ctx context.Context, author *domain.Author, authorBackup domain.Author, method string) error {
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	err := u.repository.Replace(ctxR, *author)
+	if err != nil {
+		return err
+	}
+
+	var tx *domain.PendingTransaction
+	if author.Status == domain.StatusPending {
+		// Keep track of the transaction until it gets resolved, see AuthorSAGAWatchdog
+		snapshotJSON, err := json.Marshal(authorBackup)
+		if err == nil {
+			tx = domain.NewPendingTransaction(author.ExternalID, domain.AuthorUpdated, string(snapshotJSON))
+			err = u.pending.Save(ctxR, *tx)
+		}
+		if err != nil {
+			_ = u.repository.Replace(ctxR, authorBackup)
+			return err
+		}
+	}
+
+	// Domain Event nomenclature -> APP_NAME.SERVICE.ACTION
+	// Transaction/interaction event, required owner/user validation, use concurrent-safe routine
+	errChan := make(chan error)
+	go func() {
+		ctxE, cl := context.WithCancel(ctx)
+		defer cl()
+
+		// If author owner pool is changed, then start transaction with integration event, if not
+		// send a simple domain event to propagate side-effects
+		var eventStr string
+		if author.Status == domain.StatusPending {
+			err = u.event.StartUpdate(ctxE, tx.ID, *author, authorBackup)
+			if err == nil {
+				eventStr = domain.OwnerVerify + " event published"
+			}
+		} else {
+			err = u.event.Updated(ctxE, *author)
+			if err == nil {
+				eventStr = domain.AuthorUpdated + " event published"
+			}
+		}
+
+		if err != nil {
+			_ = u.log.Log("method", method, "err", err.Error())
+
+			// Rollback, callers get the publishing error
+			if errR := u.repository.Replace(ctxE, authorBackup); errR != nil {
+				_ = u.log.Log("method", method, "err", errR.Error())
+			}
+			if tx != nil {
+				if _, errP := u.pending.Claim(ctxE, author.ExternalID, tx.ID); errP != nil {
+					_ = u.log.Log("method", method, "err", errP.Error())
+				}
+			}
+
+			_ = u.log.Log("method", method, "msg", "could not send event, rolled back")
+		} else {
+			_ = u.log.Log("method", method, "msg", eventStr)
+		}
+
+		errChan <- err
+	}()
+
+	select {
+	case err = <-errChan:
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchOwned returns the given author if the caller may manage the given owner, only admin owners
+// and the allowed users may do it
+func (u *Author) fetchOwned(ctx context.Context, id, ownerID string, allowed ...string) (*domain.Author, error) {
+	if id == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField, fmt.Sprintf(exception.RequiredFieldString, "id"))
+	} else if ownerID == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField, fmt.Sprintf(exception.RequiredFieldString, "owner_id"))
+	}
+
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	// Using repository directly to avoid non-organic total_views increment
+	author, err := u.repository.FetchByID(ctxR, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = domain.AuthorizeOwner(ctx, append(author.OwnerPool(domain.RoleAdmin), allowed...)...); err != nil {
+		return nil, err
+	}
+
+	return author, nil
+}
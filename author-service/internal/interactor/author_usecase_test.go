@@ -1,7 +1,101 @@
 package interactor
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
 
 func TestAuthorUseCase_Create(t *testing.T) {
 
 }
+
+// updateRepository in-memory authors fetched by id, any other repository call panics
+type updateRepository struct {
+	domain.AuthorRepository
+	authors map[string]*domain.Author
+}
+
+func (r *updateRepository) FetchByID(_ context.Context, id string, _ bool) (*domain.Author, error) {
+	author := *r.authors[id]
+	return &author, nil
+}
+
+func (r *updateRepository) Replace(_ context.Context, author domain.Author) error {
+	r.authors[author.ExternalID] = &author
+	return nil
+}
+
+// updateEventBus accepts every updated author
+type updateEventBus struct {
+	domain.AuthorEventBus
+}
+
+func (updateEventBus) Updated(_ context.Context, _ domain.Author) error {
+	return nil
+}
+
+func newUpdateAuthor(id string, owner *domain.Owner) *domain.Author {
+	author := domain.NewAuthor("Isaac", "Newton", "", "private", owner)
+	author.ExternalID = id
+	author.Status = domain.StatusDone
+	return author
+}
+
+func TestAuthor_Update(t *testing.T) {
+	author := newUpdateAuthor("author-a", domain.NewOwner("user-1", domain.RoleAdmin))
+	repo := &updateRepository{authors: map[string]*domain.Author{"author-a": author}}
+	u := NewAuthor(log.NewNopLogger(), repo, nil, updateEventBus{}, nil, nil)
+	update := func(ctx context.Context, verified string) error {
+		_, err := u.Update(ctx, &domain.AuthorUpdateAggregate{
+			ID:            "author-a",
+			RootAggregate: &domain.AuthorAggregate{DisplayName: "Sir Isaac Newton"},
+			Verified:      verified,
+		})
+		return err
+	}
+
+	// Owners may edit the author but only administrators may verify it
+	owner := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-1"})
+	assert.True(t, errors.Is(update(owner, "true"), domain.ErrForbidden))
+	assert.False(t, repo.authors["author-a"].Verified)
+	assert.Nil(t, update(owner, "false"))
+	assert.Equal(t, "Sir Isaac Newton", repo.authors["author-a"].DisplayName)
+
+	admin := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-2", Admin: true})
+	assert.Nil(t, update(admin, "true"))
+	assert.True(t, repo.authors["author-a"].Verified)
+
+	// Owners keep editing verified authors
+	assert.Nil(t, update(owner, "true"))
+	assert.True(t, errors.Is(update(owner, "false"), domain.ErrForbidden))
+	assert.True(t, repo.authors["author-a"].Verified)
+}
+
+// unavailableEventBus fails every published event
+type unavailableEventBus struct {
+	domain.AuthorEventBus
+}
+
+func (unavailableEventBus) Updated(_ context.Context, _ domain.Author) error {
+	return errors.New("kafka not available")
+}
+
+func TestAuthor_UpdateRollback(t *testing.T) {
+	author := newUpdateAuthor("author-a", domain.NewOwner("user-1", domain.RoleAdmin))
+	repo := &updateRepository{authors: map[string]*domain.Author{"author-a": author}}
+	u := NewAuthor(log.NewNopLogger(), repo, nil, unavailableEventBus{}, nil, nil)
+
+	// Author is restored and the publishing error is returned
+	owner := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-1"})
+	_, err := u.Update(owner, &domain.AuthorUpdateAggregate{
+		ID:            "author-a",
+		RootAggregate: &domain.AuthorAggregate{DisplayName: "Sir Isaac Newton"},
+	})
+	assert.EqualError(t, err, "kafka not available")
+	assert.Equal(t, author.DisplayName, repo.authors["author-a"].DisplayName)
+}
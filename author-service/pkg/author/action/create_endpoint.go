@@ -56,10 +56,10 @@ func MakeCreateAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logger, d
 	action := "create"
 	ep = middleware.WrapResiliency(ep, "author", action)
 	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
-		logger,
-		duration,
-		tracer,
-		zipkinTracer,
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
 	})
 }
 
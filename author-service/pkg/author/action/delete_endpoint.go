@@ -35,10 +35,10 @@ func MakeDeleteAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logger, d
 	action := "delete"
 	ep = middleware.WrapResiliency(ep, "author", action)
 	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
-		logger,
-		duration,
-		tracer,
-		zipkinTracer,
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
 	})
 }
 
@@ -0,0 +1,58 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type GetCascadeRequest struct {
+	UserID string `json:"user_id"`
+}
+
+type GetCascadeResponse struct {
+	Report *domain.CascadeReport `json:"report"`
+	Err    error                 `json:"-"`
+}
+
+func MakeGetCascadeAuthorEndpoint(svc usecase.AuthorCascadeInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(GetCascadeRequest)
+		report, err := svc.GetReport(ctx, req.UserID)
+		if err != nil {
+			return GetCascadeResponse{
+				Report: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return GetCascadeResponse{
+			Report: report,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "get_cascade"
+	ep = middleware.WrapResiliency(ep, "author", action)
+	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetCascadeResponse{}
+)
+
+func (r GetCascadeResponse) Failed() error { return r.Err }
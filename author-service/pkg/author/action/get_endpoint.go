@@ -43,10 +43,10 @@ func MakeGetAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logger, dura
 	action := "get"
 	ep = middleware.WrapResiliency(ep, "author", action)
 	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
-		logger,
-		duration,
-		tracer,
-		zipkinTracer,
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
 	})
 }
 
@@ -35,10 +35,10 @@ func MakeHardDeleteAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logge
 	action := "hard_delete"
 	ep = middleware.WrapResiliency(ep, "author", action)
 	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
-		logger,
-		duration,
-		tracer,
-		zipkinTracer,
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
 	})
 }
 
@@ -22,6 +22,7 @@ type ListRequest struct {
 type ListResponse struct {
 	Authors       []*domain.Author `json:"authors"`
 	NextPageToken string           `json:"next_page_token"`
+	PrevPageToken string           `json:"prev_page_token"`
 	Err           error            `json:"-"`
 }
 
@@ -29,11 +30,12 @@ func MakeListAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logger, dur
 	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
 	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(ListRequest)
-		authors, nextToken, err := svc.List(ctx, req.PageToken, req.PageSize, req.FilterParams)
+		authors, nextToken, prevToken, err := svc.List(ctx, req.PageToken, req.PageSize, req.FilterParams)
 		if err != nil {
 			return ListResponse{
 				Authors:       nil,
 				NextPageToken: "",
+				PrevPageToken: "",
 				Err:           err,
 			}, err
 		}
@@ -41,6 +43,7 @@ func MakeListAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logger, dur
 		return ListResponse{
 			Authors:       authors,
 			NextPageToken: nextToken,
+			PrevPageToken: prevToken,
 			Err:           nil,
 		}, nil
 	}
@@ -49,10 +52,10 @@ func MakeListAuthorEndpoint(svc usecase.AuthorInteractor, logger log.Logger, dur
 	action := "list"
 	ep = middleware.WrapResiliency(ep, "author", action)
 	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
-		logger,
-		duration,
-		tracer,
-		zipkinTracer,
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
 	})
 }
 
@@ -0,0 +1,59 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
+	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type RemoveOwnerRequest struct {
+	ID      string `json:"id"`
+	OwnerID string `json:"owner_id"`
+}
+
+type RemoveOwnerResponse struct {
+	Author *domain.Author `json:"author"`
+	Err    error          `json:"-"`
+}
+
+func MakeRemoveOwnerEndpoint(svc usecase.AuthorInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(RemoveOwnerRequest)
+		author, err := svc.RemoveOwner(ctx, req.ID, req.OwnerID)
+		if err != nil {
+			return RemoveOwnerResponse{
+				Author: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return RemoveOwnerResponse{
+			Author: author,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "remove_owner"
+	ep = middleware.WrapResiliency(ep, "author", action)
+	return middleware.WrapInstrumentation(ep, "author", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = RemoveOwnerResponse{}
+)
+
+func (r RemoveOwnerResponse) Failed() error { return r.Err }
@@ -30,19 +30,20 @@ func (mw LoggingAuthorMiddleware) Create(ctx context.Context, aggregate *domain.
 	return
 }
 
-func (mw LoggingAuthorMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Author, nextToken string, err error) {
+func (mw LoggingAuthorMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Author, nextToken, prevToken string, err error) {
 	defer func(begin time.Time) {
 		_ = mw.Logger.Log(
 			"method", "author.list",
 			"input", fmt.Sprintf("page_token: %s, page_size: %s, filter: %s", pageToken, pageSize, filterParams),
 			"output", fmt.Sprintf("%+v", output),
 			"next_token", nextToken,
+			"prev_token", prevToken,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	output, nextToken, prevToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
 	return
 }
 
@@ -76,6 +77,51 @@ func (mw LoggingAuthorMiddleware) Update(ctx context.Context, aggregate *domain.
 	return
 }
 
+func (mw LoggingAuthorMiddleware) AddOwner(ctx context.Context, id, ownerID, role string) (output *domain.Author, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "author.add_owner",
+			"input", fmt.Sprintf("id: %s, owner_id: %s, role: %s", id, ownerID, role),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.AddOwner(ctx, id, ownerID, role)
+	return
+}
+
+func (mw LoggingAuthorMiddleware) RemoveOwner(ctx context.Context, id, ownerID string) (output *domain.Author, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "author.remove_owner",
+			"input", fmt.Sprintf("id: %s, owner_id: %s", id, ownerID),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.RemoveOwner(ctx, id, ownerID)
+	return
+}
+
+func (mw LoggingAuthorMiddleware) ChangeOwnerRole(ctx context.Context, id, ownerID, role string) (output *domain.Author, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "author.change_owner_role",
+			"input", fmt.Sprintf("id: %s, owner_id: %s, role: %s", id, ownerID, role),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.ChangeOwnerRole(ctx, id, ownerID, role)
+	return
+}
+
 func (mw LoggingAuthorMiddleware) Delete(ctx context.Context, id string) (err error) {
 	defer func(begin time.Time) {
 		_ = mw.Logger.Log(
@@ -141,33 +187,34 @@ func (mw LoggingAuthorSAGAMiddleware) Verify(ctx context.Context, service string
 	return
 }
 
-func (mw LoggingAuthorSAGAMiddleware) Done(ctx context.Context, rootID, operation string) (err error) {
+func (mw LoggingAuthorSAGAMiddleware) Done(ctx context.Context, rootID, transactionID, operation string) (err error) {
 	defer func(begin time.Time) {
 		_ = mw.Logger.Log(
 			"method", "author.saga.done",
-			"input", fmt.Sprintf("root_id: %s, operation: %s", rootID, operation),
+			"input", fmt.Sprintf("root_id: %s, transaction_id: %s, operation: %s", rootID, transactionID, operation),
 			"output", err,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	err = mw.Next.Done(ctx, rootID, operation)
+	err = mw.Next.Done(ctx, rootID, transactionID, operation)
 	return
 }
 
-func (mw LoggingAuthorSAGAMiddleware) Failed(ctx context.Context, rootID, operation, snapshot string) (err error) {
+func (mw LoggingAuthorSAGAMiddleware) Failed(ctx context.Context, rootID, transactionID, operation, snapshot string) (err error) {
 	defer func(begin time.Time) {
 		_ = mw.Logger.Log(
 			"method", "author.saga.failed",
-			"input", fmt.Sprintf("root_id: %s, operation: %s, snapshot: %s", rootID, operation, snapshot),
+			"input", fmt.Sprintf("root_id: %s, transaction_id: %s, operation: %s, snapshot: %s", rootID, transactionID,
+				operation, snapshot),
 			"output", err,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	err = mw.Next.Failed(ctx, rootID, operation, snapshot)
+	err = mw.Next.Failed(ctx, rootID, transactionID, operation, snapshot)
 	return
 }
 
@@ -200,3 +247,38 @@ func (mw LoggingAuthorSAGAMiddleware) RemovePicture(ctx context.Context, rootID
 	err = mw.Next.RemovePicture(ctx, rootID)
 	return
 }
+
+type LoggingAuthorCascadeMiddleware struct {
+	Logger log.Logger
+	Next   usecase.AuthorCascadeInteractor
+}
+
+func (mw LoggingAuthorCascadeMiddleware) Cascade(ctx context.Context, userID, action string, dryRun bool) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "author.cascade.cascade",
+			"input", fmt.Sprintf("user_id: %s, action: %s, dry_run: %t", userID, action, dryRun),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Cascade(ctx, userID, action, dryRun)
+	return
+}
+
+func (mw LoggingAuthorCascadeMiddleware) GetReport(ctx context.Context, userID string) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "author.cascade.get_report",
+			"input", fmt.Sprintf("user_id: %s", userID),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.GetReport(ctx, userID)
+	return
+}
@@ -27,14 +27,14 @@ func (mw MetricAuthorMiddleware) Create(ctx context.Context, aggregate *domain.A
 	return
 }
 
-func (mw MetricAuthorMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Author, nextToken string, err error) {
+func (mw MetricAuthorMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Author, nextToken, prevToken string, err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "author.list", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	output, nextToken, prevToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
 	return
 }
 
@@ -60,6 +60,39 @@ func (mw MetricAuthorMiddleware) Update(ctx context.Context, aggregate *domain.A
 	return
 }
 
+func (mw MetricAuthorMiddleware) AddOwner(ctx context.Context, id, ownerID, role string) (output *domain.Author, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "author.add_owner", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.AddOwner(ctx, id, ownerID, role)
+	return
+}
+
+func (mw MetricAuthorMiddleware) RemoveOwner(ctx context.Context, id, ownerID string) (output *domain.Author, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "author.remove_owner", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.RemoveOwner(ctx, id, ownerID)
+	return
+}
+
+func (mw MetricAuthorMiddleware) ChangeOwnerRole(ctx context.Context, id, ownerID, role string) (output *domain.Author, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "author.change_owner_role", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.ChangeOwnerRole(ctx, id, ownerID, role)
+	return
+}
+
 func (mw MetricAuthorMiddleware) Delete(ctx context.Context, id string) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "author.delete", "error", fmt.Sprint(err != nil)}
@@ -110,25 +143,25 @@ func (mw MetricAuthorSAGAMiddleware) Verify(ctx context.Context, service string,
 	return
 }
 
-func (mw MetricAuthorSAGAMiddleware) Done(ctx context.Context, rootID, operation string) (err error) {
+func (mw MetricAuthorSAGAMiddleware) Done(ctx context.Context, rootID, transactionID, operation string) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "author.saga.done", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	err = mw.Next.Done(ctx, rootID, operation)
+	err = mw.Next.Done(ctx, rootID, transactionID, operation)
 	return
 }
 
-func (mw MetricAuthorSAGAMiddleware) Failed(ctx context.Context, rootID, operation, snapshot string) (err error) {
+func (mw MetricAuthorSAGAMiddleware) Failed(ctx context.Context, rootID, transactionID, operation, snapshot string) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "author.saga.failed", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	err = mw.Next.Failed(ctx, rootID, operation, snapshot)
+	err = mw.Next.Failed(ctx, rootID, transactionID, operation, snapshot)
 	return
 }
 
@@ -153,3 +186,48 @@ func (mw MetricAuthorSAGAMiddleware) RemovePicture(ctx context.Context, rootID [
 	err = mw.Next.RemovePicture(ctx, rootID)
 	return
 }
+
+type MetricAuthorViewMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.AuthorViewAggregator
+}
+
+func (mw MetricAuthorViewMiddleware) Fold(ctx context.Context, view domain.AuthorView) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "author.view.fold", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Fold(ctx, view)
+	return
+}
+
+type MetricAuthorCascadeMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.AuthorCascadeInteractor
+}
+
+func (mw MetricAuthorCascadeMiddleware) Cascade(ctx context.Context, userID, action string, dryRun bool) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "author.cascade.cascade", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Cascade(ctx, userID, action, dryRun)
+	return
+}
+
+func (mw MetricAuthorCascadeMiddleware) GetReport(ctx context.Context, userID string) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "author.cascade.get_report", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.GetReport(ctx, userID)
+	return
+}
@@ -8,18 +8,30 @@ import (
 
 type AuthorInteractor interface {
 	Create(ctx context.Context, aggregate *domain.AuthorAggregate) (*domain.Author, error)
-	List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) ([]*domain.Author, string, error)
+	List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) ([]*domain.Author, string, string, error)
 	Get(ctx context.Context, id string) (*domain.Author, error)
 	Update(ctx context.Context, aggregate *domain.AuthorUpdateAggregate) (*domain.Author, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	HardDelete(ctx context.Context, id string) error
+	AddOwner(ctx context.Context, id, ownerID, role string) (*domain.Author, error)
+	RemoveOwner(ctx context.Context, id, ownerID string) (*domain.Author, error)
+	ChangeOwnerRole(ctx context.Context, id, ownerID, role string) (*domain.Author, error)
 }
 
 type AuthorSAGAInteractor interface {
 	Verify(ctx context.Context, service string, authorsJSON []byte) error
-	Done(ctx context.Context, rootID, operation string) error
-	Failed(ctx context.Context, rootID, operation, backup string) error
+	Done(ctx context.Context, rootID, transactionID, operation string) error
+	Failed(ctx context.Context, rootID, transactionID, operation, backup string) error
 	UpdatePicture(ctx context.Context, rootID string, urlJSON []byte) error
 	RemovePicture(ctx context.Context, rootID []byte) error
 }
+
+type AuthorViewAggregator interface {
+	Fold(ctx context.Context, view domain.AuthorView) error
+}
+
+type AuthorCascadeInteractor interface {
+	Cascade(ctx context.Context, userID, action string, dryRun bool) (*domain.CascadeReport, error)
+	GetReport(ctx context.Context, userID string) (*domain.CascadeReport, error)
+}
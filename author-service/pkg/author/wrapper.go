@@ -75,3 +75,66 @@ func WrapAuthorSAGAInstrumentation(authorUseCase usecase.AuthorSAGAInteractor, l
 
 	return svc
 }
+
+// WrapAuthorViewInstrumentation
+// Inject metrics to the view aggregator, views are not logged since every read produces one,
+// failed batches are logged by the aggregator itself
+func WrapAuthorViewInstrumentation(aggregator usecase.AuthorViewAggregator) usecase.AuthorViewAggregator {
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "author_service",
+		Name:        "view_request_count",
+		Help:        "number of views folded",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "author_service",
+		Name:        "view_request_latency",
+		Help:        "total duration of view folding in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.AuthorViewAggregator
+	svc = aggregator
+	svc = middleware.MetricAuthorViewMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
+
+// WrapAuthorCascadeInstrumentation
+// Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapAuthorCascadeInstrumentation(cascadeUseCase usecase.AuthorCascadeInteractor, logger log.Logger) usecase.AuthorCascadeInteractor {
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "author_service",
+		Name:        "cascade_request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "author_service",
+		Name:        "cascade_request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.AuthorCascadeInteractor
+	svc = cascadeUseCase
+	svc = middleware.LoggingAuthorCascadeMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricAuthorCascadeMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
@@ -11,16 +11,21 @@ import (
 	"github.com/alexandria-oss/core/transport"
 	"github.com/alexandria-oss/core/transport/proxy"
 	"github.com/go-kit/kit/log"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/author-service/internal/dependency"
+	"github.com/maestre3d/alexandria/author-service/internal/interactor"
 	"github.com/maestre3d/alexandria/author-service/pkg/author"
 	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
 	"github.com/maestre3d/alexandria/author-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	"github.com/openzipkin/zipkin-go"
 	"github.com/openzipkin/zipkin-go/model"
 	"github.com/openzipkin/zipkin-go/reporter"
 	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 var Ctx = context.Background()
@@ -42,7 +47,10 @@ var httpProxySet = wire.NewSet(
 	config.NewKernel,
 	zipkinSet,
 	tracer.WrapZipkinOpenTracing,
+	auth.NewAuthenticator,
 	bind.NewAuthorHTTP,
+	provideAuthorCascadeInteractor,
+	bind.NewAuthorCascadeHTTP,
 	provideHTTPHandlers,
 	proxy.NewHTTP,
 )
@@ -51,11 +59,14 @@ var rpcProxySet = wire.NewSet(
 	bind.NewAuthorRPC,
 	bind.NewHealthRPC,
 	provideRPCServers,
-	proxy.NewRPC,
+	provideRPCPolicy,
+	provideRPCProxy,
 )
 
 var eventProxySet = wire.NewSet(
+	provideMessageStore,
 	provideAuthorSAGAInteractor,
+	provideAuthorViewAggregator,
 	bind.NewAuthorEventConsumer,
 	provideEventConsumers,
 	proxy.NewEvent,
@@ -83,10 +94,27 @@ func provideAuthorSAGAInteractor(logger log.Logger) (usecase.AuthorSAGAInteracto
 	return authorService, cleanup, err
 }
 
+func provideAuthorCascadeInteractor(logger log.Logger) (usecase.AuthorCascadeInteractor, func(), error) {
+	dependency.Ctx = Ctx
+	cascadeUseCase, cleanup, err := dependency.InjectAuthorCascadeUseCase()
+
+	return author.WrapAuthorCascadeInstrumentation(cascadeUseCase, logger), cleanup, err
+}
+
+func provideAuthorViewAggregator() (usecase.AuthorViewAggregator, func(), error) {
+	dependency.Ctx = Ctx
+	aggregator, cleanup, err := dependency.InjectAuthorViewAggregator()
+
+	return author.WrapAuthorViewInstrumentation(aggregator), cleanup, err
+}
+
 // Bind/Map used http handlers
-func provideHTTPHandlers(authorHandler *bind.AuthorHandler) []proxy.Handler {
+func provideHTTPHandlers(authorHandler *bind.AuthorHandler, cascadeHandler *bind.AuthorCascadeHandler,
+	authenticator *auth.Authenticator) []proxy.Handler {
 	handlers := make([]proxy.Handler, 0)
 	handlers = append(handlers, authorHandler)
+	handlers = append(handlers, cascadeHandler)
+	handlers = append(handlers, authenticator)
 	return handlers
 }
 
@@ -98,6 +126,50 @@ func provideRPCServers(authorServer *bind.AuthorRPCServer, healthServer *bind.He
 	return servers
 }
 
+// Bind/Map access level of every rpc method
+func provideRPCPolicy() auth.Policy {
+	policy := auth.Policy{}
+	for _, p := range []auth.Policy{bind.AuthorRPCPolicy, bind.HealthRPCPolicy} {
+		for method, access := range p {
+			policy[method] = access
+		}
+	}
+	return policy
+}
+
+// provideRPCProxy replaces proxy.NewRPC to guard rpc methods, grpc-go v1.27 accepts a single
+// unary interceptor so callers are authenticated before go-kit's interceptor runs
+func provideRPCProxy(servers []proxy.RPCServer, authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	authorize := authenticator.UnaryServerInterceptor(policy)
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return authorize(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return kitgrpc.Interceptor(ctx, req, info, handler)
+		})
+	}))
+	for _, srv := range servers {
+		srv.SetRoutes(rpcServer)
+	}
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+func provideMessageStore() (messaging.Store, func(), error) {
+	dependency.Ctx = Ctx
+
+	return dependency.InjectMessageStore()
+}
+
+func provideSAGAWatchdog() (*interactor.AuthorSAGAWatchdog, func(), error) {
+	dependency.Ctx = Ctx
+
+	return dependency.InjectAuthorSAGAWatchdog()
+}
+
 func provideEventConsumers(authorHandler *bind.AuthorEventConsumer) []proxy.Consumer {
 	consumers := make([]proxy.Consumer, 0)
 	consumers = append(consumers, authorHandler)
@@ -157,3 +229,9 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 
 	return &transport.Transport{}, nil, nil
 }
+
+func InjectSAGAWatchdog() (*interactor.AuthorSAGAWatchdog, func(), error) {
+	wire.Build(provideSAGAWatchdog)
+
+	return &interactor.AuthorSAGAWatchdog{}, nil, nil
+}
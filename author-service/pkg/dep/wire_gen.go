@@ -14,16 +14,21 @@ import (
 	"github.com/alexandria-oss/core/transport"
 	"github.com/alexandria-oss/core/transport/proxy"
 	"github.com/go-kit/kit/log"
+	grpc2 "github.com/go-kit/kit/transport/grpc"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/author-service/internal/dependency"
+	"github.com/maestre3d/alexandria/author-service/internal/interactor"
 	"github.com/maestre3d/alexandria/author-service/pkg/author"
 	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
 	"github.com/maestre3d/alexandria/author-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	"github.com/openzipkin/zipkin-go"
 	"github.com/openzipkin/zipkin-go/model"
 	"github.com/openzipkin/zipkin-go/reporter"
 	"github.com/openzipkin/zipkin-go/reporter/http"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 // Injectors from wire.go:
@@ -47,22 +52,62 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	authorRPCServer := bind.NewAuthorRPC(authorInteractor, logLogger, opentracingTracer, zipkinTracer)
 	healthRPCServer := bind.NewHealthRPC()
 	v := provideRPCServers(authorRPCServer, healthRPCServer)
-	server, cleanup3 := proxy.NewRPC(v)
+	authenticator, err := auth.NewAuthenticator(logLogger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policy := provideRPCPolicy()
+	server, cleanup3 := provideRPCProxy(v, authenticator, policy)
 	authorHandler := bind.NewAuthorHTTP(authorInteractor, logLogger, opentracingTracer, zipkinTracer)
-	v2 := provideHTTPHandlers(authorHandler)
-	http, cleanup4 := proxy.NewHTTP(kernel, v2...)
-	authorSAGAInteractor, cleanup5, err := provideAuthorSAGAInteractor(logLogger)
+	authorCascadeInteractor, cleanup4, err := provideAuthorCascadeInteractor(logLogger)
 	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authorCascadeHandler := bind.NewAuthorCascadeHTTP(authorCascadeInteractor, logLogger, opentracingTracer, zipkinTracer)
+	v2 := provideHTTPHandlers(authorHandler, authorCascadeHandler, authenticator)
+	http, cleanup5 := proxy.NewHTTP(kernel, v2...)
+	authorSAGAInteractor, cleanup6, err := provideAuthorSAGAInteractor(logLogger)
+	if err != nil {
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	authorEventConsumer := bind.NewAuthorEventConsumer(authorSAGAInteractor, logLogger)
+	authorViewAggregator, cleanup7, err := provideAuthorViewAggregator()
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	store, cleanup8, err := provideMessageStore()
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authorEventConsumer := bind.NewAuthorEventConsumer(authorSAGAInteractor, authorViewAggregator, authorCascadeInteractor, logLogger, store)
 	v3 := provideEventConsumers(authorEventConsumer)
-	event, cleanup6, err := proxy.NewEvent(context, kernel, v3...)
+	event, cleanup9, err := proxy.NewEvent(context, kernel, v3...)
 	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
 		cleanup5()
 		cleanup4()
 		cleanup3()
@@ -72,6 +117,9 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	}
 	transportTransport := transport.NewTransport(server, http, event, kernel)
 	return transportTransport, func() {
+		cleanup9()
+		cleanup8()
+		cleanup7()
 		cleanup6()
 		cleanup5()
 		cleanup4()
@@ -81,6 +129,16 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	}, nil
 }
 
+func InjectSAGAWatchdog() (*interactor.AuthorSAGAWatchdog, func(), error) {
+	authorSAGAWatchdog, cleanup, err := provideSAGAWatchdog()
+	if err != nil {
+		return nil, nil, err
+	}
+	return authorSAGAWatchdog, func() {
+		cleanup()
+	}, nil
+}
+
 // wire.go:
 
 var Ctx = context.Background()
@@ -95,13 +153,18 @@ var zipkinSet = wire.NewSet(
 
 var httpProxySet = wire.NewSet(
 	authorInteractorSet,
-	provideContext, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, bind.NewAuthorHTTP, provideHTTPHandlers, proxy.NewHTTP,
+	provideContext, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, auth.NewAuthenticator, bind.NewAuthorHTTP, provideAuthorCascadeInteractor, bind.NewAuthorCascadeHTTP, provideHTTPHandlers, proxy.NewHTTP,
 )
 
-var rpcProxySet = wire.NewSet(bind.NewAuthorRPC, bind.NewHealthRPC, provideRPCServers, proxy.NewRPC)
+var rpcProxySet = wire.NewSet(bind.NewAuthorRPC, bind.NewHealthRPC, provideRPCServers,
+	provideRPCPolicy,
+	provideRPCProxy,
+)
 
 var eventProxySet = wire.NewSet(
-	provideAuthorSAGAInteractor, bind.NewAuthorEventConsumer, provideEventConsumers, proxy.NewEvent,
+	provideMessageStore,
+	provideAuthorSAGAInteractor,
+	provideAuthorViewAggregator, bind.NewAuthorEventConsumer, provideEventConsumers, proxy.NewEvent,
 )
 
 func provideContext() context.Context {
@@ -126,10 +189,27 @@ func provideAuthorSAGAInteractor(logger2 log.Logger) (usecase.AuthorSAGAInteract
 	return authorService, cleanup, err
 }
 
+func provideAuthorCascadeInteractor(logger2 log.Logger) (usecase.AuthorCascadeInteractor, func(), error) {
+	dependency.Ctx = Ctx
+	cascadeUseCase, cleanup, err := dependency.InjectAuthorCascadeUseCase()
+
+	return author.WrapAuthorCascadeInstrumentation(cascadeUseCase, logger2), cleanup, err
+}
+
+func provideAuthorViewAggregator() (usecase.AuthorViewAggregator, func(), error) {
+	dependency.Ctx = Ctx
+	aggregator, cleanup, err := dependency.InjectAuthorViewAggregator()
+
+	return author.WrapAuthorViewInstrumentation(aggregator), cleanup, err
+}
+
 // Bind/Map used http handlers
-func provideHTTPHandlers(authorHandler *bind.AuthorHandler) []proxy.Handler {
+func provideHTTPHandlers(authorHandler *bind.AuthorHandler, cascadeHandler *bind.AuthorCascadeHandler,
+	authenticator *auth.Authenticator) []proxy.Handler {
 	handlers := make([]proxy.Handler, 0)
 	handlers = append(handlers, authorHandler)
+	handlers = append(handlers, cascadeHandler)
+	handlers = append(handlers, authenticator)
 	return handlers
 }
 
@@ -141,6 +221,50 @@ func provideRPCServers(authorServer *bind.AuthorRPCServer, healthServer *bind.He
 	return servers
 }
 
+// Bind/Map access level of every rpc method
+func provideRPCPolicy() auth.Policy {
+	policy := auth.Policy{}
+	for _, p := range []auth.Policy{bind.AuthorRPCPolicy, bind.HealthRPCPolicy} {
+		for method, access := range p {
+			policy[method] = access
+		}
+	}
+	return policy
+}
+
+// provideRPCProxy replaces proxy.NewRPC to guard rpc methods, grpc-go v1.27 accepts a single
+// unary interceptor so callers are authenticated before go-kit's interceptor runs
+func provideRPCProxy(servers []proxy.RPCServer, authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	authorize := authenticator.UnaryServerInterceptor(policy)
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return authorize(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return grpc2.Interceptor(ctx, req, info, handler)
+		})
+	}))
+	for _, srv := range servers {
+		srv.SetRoutes(rpcServer)
+	}
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+func provideMessageStore() (messaging.Store, func(), error) {
+	dependency.Ctx = Ctx
+
+	return dependency.InjectMessageStore()
+}
+
+func provideSAGAWatchdog() (*interactor.AuthorSAGAWatchdog, func(), error) {
+	dependency.Ctx = Ctx
+
+	return dependency.InjectAuthorSAGAWatchdog()
+}
+
 func provideEventConsumers(authorHandler *bind.AuthorEventConsumer) []proxy.Consumer {
 	consumers := make([]proxy.Consumer, 0)
 	consumers = append(consumers, authorHandler)
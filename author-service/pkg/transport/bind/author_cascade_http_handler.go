@@ -0,0 +1,144 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/author-service/pkg/author/action"
+)
+
+// AuthorCascadeHandler exposes the user removal cascades to administrators
+type AuthorCascadeHandler struct {
+	service      usecase.AuthorCascadeInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewAuthorCascadeHTTP(svc usecase.AuthorCascadeInteractor, logger log.Logger, tracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer) *AuthorCascadeHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "author_service",
+		Name:        "cascade_request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("author_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &AuthorCascadeHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy, cascades are only exposed to administrators
+func (h *AuthorCascadeHandler) SetRoutes(public, private, admin *mux.Router) {
+	r := admin.PathPrefix("/author/cascade").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("/{user_id}").Methods(http.MethodGet).Handler(h.GetReport())
+	r.Path("/{user_id}").Methods(http.MethodPost).Handler(h.Cascade())
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+func (h *AuthorCascadeHandler) Cascade() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeCascadeAuthorEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeCascadeRequest,
+		encodeCascadeResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Cascade", h.logger)))...,
+	)
+}
+
+func (h *AuthorCascadeHandler) GetReport() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeGetCascadeAuthorEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeGetCascadeRequest,
+		encodeGetCascadeResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "GetCascade", h.logger)))...,
+	)
+}
+
+/* Decoders */
+
+func decodeCascadeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	// Malformed flags are treated as dry-runs, nothing gets removed by mistake
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			dryRun = true
+		}
+	}
+
+	return action.CascadeRequest{
+		UserID: mux.Vars(r)["user_id"],
+		Action: r.URL.Query().Get("action"),
+		DryRun: dryRun,
+	}, nil
+}
+
+func decodeGetCascadeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.GetCascadeRequest{UserID: mux.Vars(r)["user_id"]}, nil
+}
+
+/* Encoders */
+
+func encodeCascadeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.CascadeResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeGetCascadeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.GetCascadeResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		} else if r.Report == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntityNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-kit/kit/log/level"
 	"github.com/maestre3d/alexandria/author-service/internal/domain"
 	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	"github.com/sony/gobreaker"
 	"go.opencensus.io/trace"
 	"gocloud.dev/pubsub"
@@ -16,14 +17,23 @@ import (
 )
 
 type AuthorEventConsumer struct {
-	svc    usecase.AuthorSAGAInteractor
-	logger log.Logger
+	svc     usecase.AuthorSAGAInteractor
+	views   usecase.AuthorViewAggregator
+	cascade usecase.AuthorCascadeInteractor
+	logger  log.Logger
+	guard   *messaging.Guard
+	dlq     *messaging.DeadLetter
 }
 
-func NewAuthorEventConsumer(svc usecase.AuthorSAGAInteractor, logger log.Logger) *AuthorEventConsumer {
+func NewAuthorEventConsumer(svc usecase.AuthorSAGAInteractor, views usecase.AuthorViewAggregator,
+	cascade usecase.AuthorCascadeInteractor, logger log.Logger, store messaging.Store) *AuthorEventConsumer {
 	return &AuthorEventConsumer{
-		svc:    svc,
-		logger: logger,
+		svc:     svc,
+		views:   views,
+		cascade: cascade,
+		logger:  logger,
+		guard:   messaging.NewGuard(store, "author", logger),
+		dlq:     messaging.NewDeadLetter("author", logger),
 	}
 }
 
@@ -91,11 +101,29 @@ func (c *AuthorEventConsumer) SetBinders(s *eventbus.Server, ctx context.Context
 		return err
 	}
 
+	viewed, err := c.bindAuthorViewed(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	userRemoved, err := c.bindUserRemoved(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	userHardRemoved, err := c.bindUserHardRemoved(ctx, service)
+	if err != nil {
+		return err
+	}
+
 	s.AddConsumer(aVerify)
 	s.AddConsumer(verifyBind)
 	s.AddConsumer(failedBind)
 	s.AddConsumer(blobU)
 	s.AddConsumer(blobF)
+	s.AddConsumer(viewed)
+	s.AddConsumer(userRemoved)
+	s.AddConsumer(userHardRemoved)
 
 	return nil
 }
@@ -119,7 +147,7 @@ func (c *AuthorEventConsumer) bindAuthorVerify(ctx context.Context, service stri
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onAuthorVerify,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.AuthorVerify, c.onAuthorVerify),
 	}, nil
 }
 
@@ -141,7 +169,7 @@ func (c *AuthorEventConsumer) bindAuthorVerified(ctx context.Context, service st
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onAuthorVerified,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.OwnerVerified, c.onAuthorVerified),
 	}, nil
 }
 
@@ -161,7 +189,7 @@ func (c *AuthorEventConsumer) bindAuthorFailed(ctx context.Context, service stri
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onAuthorFailed,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.OwnerFailed, c.onAuthorFailed),
 	}, nil
 }
 
@@ -181,7 +209,7 @@ func (c *AuthorEventConsumer) bindBlobUploaded(ctx context.Context, service stri
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onBlobUploaded,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.BlobUploaded, c.onBlobUploaded),
 	}, nil
 }
 
@@ -201,7 +229,71 @@ func (c *AuthorEventConsumer) bindBlobRemoved(ctx context.Context, service strin
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onBlobRemoved,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.BlobRemoved, c.onBlobRemoved),
+	}, nil
+}
+
+// View aggregation listener
+func (c *AuthorEventConsumer) bindAuthorViewed(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("author_viewed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.AuthorViewed)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		// Handlers wait for their batch to be stored, must be able to fill a whole batch
+		MaxHandler: 100,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.AuthorViewed, c.onAuthorViewed),
+	}, nil
+}
+
+// User removal listeners, authors of removed users are cascaded using the configured policy
+
+func (c *AuthorEventConsumer) bindUserRemoved(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("user_removed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.UserRemoved)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		MaxHandler: 10,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.UserRemoved, c.onUserRemoved),
+	}, nil
+}
+
+func (c *AuthorEventConsumer) bindUserHardRemoved(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("user_hard_removed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.UserHardRemoved)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		MaxHandler: 10,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.UserHardRemoved, c.onUserHardRemoved),
 	}, nil
 }
 
@@ -210,6 +302,9 @@ func (c *AuthorEventConsumer) bindBlobRemoved(ctx context.Context, service strin
 func (c *AuthorEventConsumer) onAuthorVerify(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_author_verify") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -231,24 +326,29 @@ func (c *AuthorEventConsumer) onAuthorVerify(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.AuthorVerify))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
-	err = c.svc.Verify(ctxU, eC.Event.ServiceName, eC.Event.Content)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.Verify(ctxU, eC.Event.ServiceName, eC.Event.Content)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
 
 func (c *AuthorEventConsumer) onAuthorVerified(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_author_verified") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -270,25 +370,30 @@ func (c *AuthorEventConsumer) onAuthorVerified(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.OwnerVerified))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
-	err = c.svc.Done(ctxU, eC.Transaction.RootID, eC.Transaction.Operation)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.Done(ctxU, eC.Transaction.RootID, eC.Transaction.ID, eC.Transaction.Operation)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
 	// Always send acknowledge if operation succeed
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
 
 func (c *AuthorEventConsumer) onAuthorFailed(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_author_failed") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -310,24 +415,30 @@ func (c *AuthorEventConsumer) onAuthorFailed(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.OwnerFailed))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
-	err = c.svc.Failed(ctxU, eC.Transaction.RootID, eC.Transaction.Operation, eC.Transaction.Snapshot)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.Failed(ctxU, eC.Transaction.RootID, eC.Transaction.ID, eC.Transaction.Operation,
+			eC.Transaction.Snapshot)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
 
 func (c *AuthorEventConsumer) onBlobUploaded(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_blob_uploaded") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -349,24 +460,29 @@ func (c *AuthorEventConsumer) onBlobUploaded(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.BlobUploaded))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
-	err = c.svc.UpdatePicture(ctxU, eC.Transaction.RootID, eC.Event.Content)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.UpdatePicture(ctxU, eC.Transaction.RootID, eC.Event.Content)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
 
 func (c *AuthorEventConsumer) onBlobRemoved(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_blob_removed") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -388,17 +504,126 @@ func (c *AuthorEventConsumer) onBlobRemoved(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.BlobUploaded))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
-	err = c.svc.RemovePicture(ctxU, eC.Event.Content)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.RemovePicture(ctxU, eC.Event.Content)
+	})
+	if err != nil {
+		_ = level.Error(c.logger).Log("err", err)
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code == 500 {
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
+		}
+	}
+
+	c.guard.Ack(r, eC)
+}
+
+func (c *AuthorEventConsumer) onAuthorViewed(r *eventbus.Request) {
+	// Wrap whole event for context propagation / OpenTracing-like
+	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_author_viewed") {
+		return
+	}
+
+	view := domain.AuthorView{}
+	if err := json.Unmarshal(eC.Event.Content, &view); err != nil || view.ID == "" {
+		// Malformed views are never counted, retrying them is pointless
+		_ = level.Error(c.logger).Log("err", "malformed author view", "event_id", eC.Event.ID)
+		c.guard.Ack(r, eC)
+		return
+	}
+
+	// Get span context from message
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(r.Message.Metadata["tracing_context"]), &traceCtx)
+	if err != nil {
+		// If span is not valid, then create one from our current context
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	// Start a new span with the parent span
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, "author: author_viewed", traceCtx)
+	defer span.End()
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.AuthorViewed))
+
+	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.views.Fold(ctxU, view)
+	})
+	if err != nil {
+		_ = level.Error(c.logger).Log("err", err)
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code == 500 {
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
+		}
+	}
+
+	c.guard.Ack(r, eC)
+}
+
+func (c *AuthorEventConsumer) onUserRemoved(r *eventbus.Request) {
+	c.onUserCascade(r, "on_user_removed", "author: user_removed", domain.UserRemoved)
+}
+
+func (c *AuthorEventConsumer) onUserHardRemoved(r *eventbus.Request) {
+	c.onUserCascade(r, "on_user_hard_removed", "author: user_hard_removed", domain.UserHardRemoved)
+}
+
+// onUserCascade cascades the authors of the user removed by the given event, event's body is the user id.
+// Partially applied cascades are retried as a whole, already cascaded authors are skipped
+func (c *AuthorEventConsumer) onUserCascade(r *eventbus.Request, handler, spanName, eventName string) {
+	// Wrap whole event for context propagation / OpenTracing-like
+	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, handler) {
+		return
+	}
+
+	// Get span context from message
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(r.Message.Metadata["tracing_context"]), &traceCtx)
+	if err != nil {
+		// If span is not valid, then create one from our current context
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	// Start a new span with the parent span
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, spanName, traceCtx)
+	defer span.End()
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", eventName))
+
+	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
+	err = c.dlq.Execute(ctxU, func() error {
+		_, err := c.cascade.Cascade(ctxU, string(eC.Event.Content), "", false)
+		return err
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, eC)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/alexandria-oss/core"
 	"github.com/alexandria-oss/core/exception"
 	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/endpoint"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	kitoc "github.com/go-kit/kit/tracing/opencensus"
 	"github.com/go-kit/kit/tracing/opentracing"
@@ -47,7 +48,7 @@ func NewAuthorHTTP(svc usecase.AuthorInteractor, logger log.Logger, tracer stdop
 	}, []string{"method", "success"})
 
 	options := []httptransport.ServerOption{
-		httptransport.ServerErrorEncoder(httputil.ResponseErrJSON),
+		httptransport.ServerErrorEncoder(responseErrJSON),
 		kitoc.HTTPServerTrace(),
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
 	}
@@ -82,6 +83,9 @@ func (h *AuthorHandler) SetRoutes(public, private, admin *mux.Router) {
 	pRouter.Path("/{id}").Methods(http.MethodPatch, http.MethodPut).Handler(h.Update())
 	pRouter.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
 	pRouter.Path("/{id}/restore").Methods(http.MethodPatch).Handler(h.Restore())
+	pRouter.Path("/{id}/owner").Methods(http.MethodPost).Handler(h.AddOwner())
+	pRouter.Path("/{id}/owner/{owner_id}").Methods(http.MethodPatch, http.MethodPut).Handler(h.ChangeOwnerRole())
+	pRouter.Path("/{id}/owner/{owner_id}").Methods(http.MethodDelete).Handler(h.RemoveOwner())
 	pRouter.Use(mux.CORSMethodMiddleware(arouter))
 
 	// Public routing
@@ -117,7 +121,8 @@ func (h *AuthorHandler) Get() *httptransport.Server {
 		action.MakeGetAuthorEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
 		decodeGetRequest,
 		encodeGetResponse,
-		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger)))...,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger),
+			viewerHTTPToContext))...,
 	)
 }
 
@@ -157,6 +162,33 @@ func (h *AuthorHandler) HardDelete() *httptransport.Server {
 	)
 }
 
+func (h *AuthorHandler) AddOwner() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeAddOwnerEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeAddOwnerRequest,
+		encodeOwnerResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Add_Owner", h.logger)))...,
+	)
+}
+
+func (h *AuthorHandler) RemoveOwner() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeRemoveOwnerEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeRemoveOwnerRequest,
+		encodeOwnerResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Remove_Owner", h.logger)))...,
+	)
+}
+
+func (h *AuthorHandler) ChangeOwnerRole() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeChangeOwnerRoleEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeChangeOwnerRoleRequest,
+		encodeOwnerResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Change_Owner_Role", h.logger)))...,
+	)
+}
+
 /* Decode HTTP Request */
 
 func decodeCreateRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -176,6 +208,7 @@ func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error)
 		PageSize:  r.URL.Query().Get("page_size"),
 		FilterParams: core.FilterParams{
 			"query":          r.URL.Query().Get("query"),
+			"search_mode":    r.URL.Query().Get("search_mode"),
 			"filter_by":      r.URL.Query().Get("filter_by"),
 			"sort":           r.URL.Query().Get("sort"),
 			"show_disabled":  r.URL.Query().Get("show_disabled"),
@@ -225,13 +258,36 @@ func decodeHardDeleteRequest(_ context.Context, r *http.Request) (interface{}, e
 	return action.HardDeleteRequest{ID: mux.Vars(r)["id"]}, nil
 }
 
+func decodeAddOwnerRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.AddOwnerRequest{
+		ID:      mux.Vars(r)["id"],
+		OwnerID: r.PostFormValue("owner_id"),
+		Role:    r.PostFormValue("role"),
+	}, nil
+}
+
+func decodeRemoveOwnerRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.RemoveOwnerRequest{
+		ID:      mux.Vars(r)["id"],
+		OwnerID: mux.Vars(r)["owner_id"],
+	}, nil
+}
+
+func decodeChangeOwnerRoleRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.ChangeOwnerRoleRequest{
+		ID:      mux.Vars(r)["id"],
+		OwnerID: mux.Vars(r)["owner_id"],
+		Role:    r.PostFormValue("role"),
+	}, nil
+}
+
 /* Encode HTTP Response */
 
 func encodeCreateResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	r := response.(action.CreateResponse)
 	if r.Err != nil {
-		httputil.ResponseErrJSON(ctx, r.Err, w)
+		responseErrJSON(ctx, r.Err, w)
 		return nil
 	}
 
@@ -243,7 +299,7 @@ func encodeListResponse(ctx context.Context, w http.ResponseWriter, response int
 	r, ok := response.(action.ListResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		} else if r.Err == nil && len(r.Authors) == 0 {
 			w.WriteHeader(http.StatusNotFound)
@@ -262,7 +318,7 @@ func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response inte
 	r, ok := response.(action.GetResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		} else if r.Err == nil && r.Author == nil {
 			w.WriteHeader(http.StatusNotFound)
@@ -281,7 +337,7 @@ func encodeUpdateResponse(ctx context.Context, w http.ResponseWriter, response i
 	r, ok := response.(action.UpdateResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
@@ -294,7 +350,7 @@ func encodeDeleteResponse(ctx context.Context, w http.ResponseWriter, response i
 	r, ok := response.(action.DeleteResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
@@ -307,7 +363,7 @@ func encodeRestoreResponse(ctx context.Context, w http.ResponseWriter, response
 	r, ok := response.(action.RestoreResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
@@ -320,10 +376,21 @@ func encodeHardDeleteResponse(ctx context.Context, w http.ResponseWriter, respon
 	r, ok := response.(action.HardDeleteResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
 
 	return json.NewEncoder(w).Encode(r)
 }
+
+// encodeOwnerResponse encodes every owner pool action response, they all return the updated author
+func encodeOwnerResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if r, ok := response.(endpoint.Failer); ok && r.Failed() != nil {
+		responseErrJSON(ctx, r.Failed(), w)
+		return nil
+	}
+
+	return json.NewEncoder(w).Encode(response)
+}
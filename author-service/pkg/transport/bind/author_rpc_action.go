@@ -3,16 +3,18 @@ package bind
 import (
 	"context"
 	"github.com/alexandria-oss/core/exception"
-	"github.com/alexandria-oss/core/grpcutil"
+	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	"github.com/go-kit/kit/tracing/opentracing"
 	"github.com/go-kit/kit/tracing/zipkin"
 	"github.com/go-kit/kit/transport"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/maestre3d/alexandria/author-service/internal/domain"
 	"github.com/maestre3d/alexandria/author-service/pb"
 	"github.com/maestre3d/alexandria/author-service/pkg/author/action"
 	"github.com/maestre3d/alexandria/author-service/pkg/author/usecase"
+	"github.com/maestre3d/alexandria/shared/auth"
 	stdopentracing "github.com/opentracing/opentracing-go"
 	stdzipkin "github.com/openzipkin/zipkin-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,19 +23,37 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// AuthorRPCPolicy access level of each Author method, mirrors the HTTP public, private and admin routes
+var AuthorRPCPolicy = auth.Policy{
+	"/pb.Author/Create":     auth.Private,
+	"/pb.Author/List":       auth.Public,
+	"/pb.Author/Get":        auth.Public,
+	"/pb.Author/Update":     auth.Private,
+	"/pb.Author/Delete":     auth.Private,
+	"/pb.Author/Restore":    auth.Private,
+	"/pb.Author/HardDelete": auth.Admin,
+	// Owner pool permissions are checked by the interactor
+	"/pb.Author/AddOwner":        auth.Private,
+	"/pb.Author/RemoveOwner":     auth.Private,
+	"/pb.Author/ChangeOwnerRole": auth.Private,
+}
+
 type AuthorRPCServer struct {
 	srv pb.AuthorServer
 }
 
 // Compile-time RPC implementation
 type authorRPCImp struct {
-	create     grpctransport.Handler
-	list       grpctransport.Handler
-	get        grpctransport.Handler
-	update     grpctransport.Handler
-	delete     grpctransport.Handler
-	restore    grpctransport.Handler
-	hardDelete grpctransport.Handler
+	create          grpctransport.Handler
+	list            grpctransport.Handler
+	get             grpctransport.Handler
+	update          grpctransport.Handler
+	delete          grpctransport.Handler
+	restore         grpctransport.Handler
+	hardDelete      grpctransport.Handler
+	addOwner        grpctransport.Handler
+	removeOwner     grpctransport.Handler
+	changeOwnerRole grpctransport.Handler
 }
 
 func NewAuthorRPC(svc usecase.AuthorInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *AuthorRPCServer {
@@ -80,7 +100,8 @@ func NewAuthorRPC(svc usecase.AuthorInteractor, logger log.Logger, tracer stdope
 			action.MakeGetAuthorEndpoint(svc, logger, duration, tracer, zipkinTracer),
 			decodeRPCGetRequest,
 			encodeRPCGetResponse,
-			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Get", logger)))...,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Get", logger),
+				viewerGRPCToContext))...,
 		),
 		update: grpctransport.NewServer(
 			action.MakeUpdateAuthorEndpoint(svc, logger, duration, tracer, zipkinTracer),
@@ -106,6 +127,24 @@ func NewAuthorRPC(svc usecase.AuthorInteractor, logger log.Logger, tracer stdope
 			encodeRPCHardDeleteResponse,
 			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "HardDelete", logger)))...,
 		),
+		addOwner: grpctransport.NewServer(
+			action.MakeAddOwnerEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCAddOwnerRequest,
+			encodeRPCOwnerResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "AddOwner", logger)))...,
+		),
+		removeOwner: grpctransport.NewServer(
+			action.MakeRemoveOwnerEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCRemoveOwnerRequest,
+			encodeRPCOwnerResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "RemoveOwner", logger)))...,
+		),
+		changeOwnerRole: grpctransport.NewServer(
+			action.MakeChangeOwnerRoleEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCChangeOwnerRoleRequest,
+			encodeRPCOwnerResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "ChangeOwnerRole", logger)))...,
+		),
 	}
 
 	return &AuthorRPCServer{srv}
@@ -117,65 +156,89 @@ func (a AuthorRPCServer) SetRoutes(srv *grpc.Server) {
 
 /* RPC Action Binding/Implementations */
 
-func (a authorRPCImp) Create(ctx context.Context, req *pb.CreateRequest) (*pb.AuthorMessage, error) {
+func (a authorRPCImp) Create(ctx context.Context, req *pb.AuthorCreateRequest) (*pb.AuthorMessage, error) {
 	_, rep, err := a.create.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.AuthorMessage), nil
 }
 
-func (a authorRPCImp) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+func (a authorRPCImp) List(ctx context.Context, req *pb.ListRequest) (*pb.AuthorListResponse, error) {
 	_, rep, err := a.list.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
-	return rep.(*pb.ListResponse), nil
+	return rep.(*pb.AuthorListResponse), nil
 }
 
-func (a authorRPCImp) Get(ctx context.Context, req *pb.GetRequest) (*pb.AuthorMessage, error) {
+func (a authorRPCImp) Get(ctx context.Context, req *pb.IDRequest) (*pb.AuthorMessage, error) {
 	_, rep, err := a.get.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.AuthorMessage), nil
 }
 
-func (a authorRPCImp) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.AuthorMessage, error) {
+func (a authorRPCImp) Update(ctx context.Context, req *pb.AuthorUpdateRequest) (*pb.AuthorMessage, error) {
 	_, rep, err := a.update.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.AuthorMessage), nil
 }
 
-func (a authorRPCImp) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.Empty, error) {
+func (a authorRPCImp) Delete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
 	_, rep, err := a.delete.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.Empty), nil
 }
 
-func (a authorRPCImp) Restore(ctx context.Context, req *pb.RestoreRequest) (*pb.Empty, error) {
+func (a authorRPCImp) Restore(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
 	_, rep, err := a.restore.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.Empty), nil
 }
 
-func (a authorRPCImp) HardDelete(ctx context.Context, req *pb.HardDeleteRequest) (*pb.Empty, error) {
+func (a authorRPCImp) HardDelete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
 	_, rep, err := a.hardDelete.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.Empty), nil
 }
 
+func (a authorRPCImp) AddOwner(ctx context.Context, req *pb.AuthorOwnerRequest) (*pb.AuthorMessage, error) {
+	_, rep, err := a.addOwner.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.AuthorMessage), nil
+}
+
+func (a authorRPCImp) RemoveOwner(ctx context.Context, req *pb.AuthorOwnerRequest) (*pb.AuthorMessage, error) {
+	_, rep, err := a.removeOwner.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.AuthorMessage), nil
+}
+
+func (a authorRPCImp) ChangeOwnerRole(ctx context.Context, req *pb.AuthorOwnerRequest) (*pb.AuthorMessage, error) {
+	_, rep, err := a.changeOwnerRole.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.AuthorMessage), nil
+}
+
 /* Decoders */
 func decodeRPCCreateRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
-	req := rpcReq.(*pb.CreateRequest)
+	req := rpcReq.(*pb.AuthorCreateRequest)
 	return action.CreateRequest{
 		FirstName:     req.FirstName,
 		LastName:      req.LastName,
@@ -191,17 +254,17 @@ func decodeRPCListRequest(_ context.Context, rpcReq interface{}) (interface{}, e
 	return action.ListRequest{
 		PageToken:    req.PageToken,
 		PageSize:     req.PageSize,
-		FilterParams: req.FilterParams,
+		FilterParams: req.Filter,
 	}, nil
 }
 
 func decodeRPCGetRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
-	req := rpcReq.(*pb.GetRequest)
+	req := rpcReq.(*pb.IDRequest)
 	return action.GetRequest{ID: req.Id}, nil
 }
 
 func decodeRPCUpdateRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
-	req := rpcReq.(*pb.UpdateRequest)
+	req := rpcReq.(*pb.AuthorUpdateRequest)
 	return action.UpdateRequest{
 		ID:            req.Id,
 		FirstName:     req.FirstName,
@@ -216,18 +279,44 @@ func decodeRPCUpdateRequest(_ context.Context, rpcReq interface{}) (interface{},
 }
 
 func decodeRPCDeleteRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
-	req := rpcReq.(*action.DeleteRequest)
-	return action.DeleteRequest{ID: req.ID}, nil
+	req := rpcReq.(*pb.IDRequest)
+	return action.DeleteRequest{ID: req.Id}, nil
 }
 
 func decodeRPCRestoreRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
-	req := rpcReq.(*action.RestoreRequest)
-	return action.RestoreRequest{req.ID}, nil
+	req := rpcReq.(*pb.IDRequest)
+	return action.RestoreRequest{ID: req.Id}, nil
 }
 
 func decodeRPCHardDeleteRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
-	req := rpcReq.(*action.HardDeleteRequest)
-	return action.HardDeleteRequest{req.ID}, nil
+	req := rpcReq.(*pb.IDRequest)
+	return action.HardDeleteRequest{ID: req.Id}, nil
+}
+
+func decodeRPCAddOwnerRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.AuthorOwnerRequest)
+	return action.AddOwnerRequest{
+		ID:      req.Id,
+		OwnerID: req.OwnerID,
+		Role:    req.Role,
+	}, nil
+}
+
+func decodeRPCRemoveOwnerRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.AuthorOwnerRequest)
+	return action.RemoveOwnerRequest{
+		ID:      req.Id,
+		OwnerID: req.OwnerID,
+	}, nil
+}
+
+func decodeRPCChangeOwnerRoleRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.AuthorOwnerRequest)
+	return action.ChangeOwnerRoleRequest{
+		ID:      req.Id,
+		OwnerID: req.OwnerID,
+		Role:    req.Role,
+	}, nil
 }
 
 /* Encoders */
@@ -247,7 +336,7 @@ func encodeRPCCreateResponse(_ context.Context, response interface{}) (interface
 		FirstName:     res.Author.FirstName,
 		LastName:      res.Author.LastName,
 		DisplayName:   res.Author.DisplayName,
-		OwnerID:       res.Author.OwnerID,
+		Owners:        marshalOwnersRPC(res.Author.Owners),
 		OwnershipType: res.Author.OwnershipType,
 		CreateTime:    res.Author.CreateTime.String(),
 		UpdateTime:    res.Author.UpdateTime.String(),
@@ -278,7 +367,7 @@ func encodeRPCListResponse(_ context.Context, response interface{}) (interface{}
 			FirstName:     author.FirstName,
 			LastName:      author.LastName,
 			DisplayName:   author.DisplayName,
-			OwnerID:       author.OwnerID,
+			Owners:        marshalOwnersRPC(author.Owners),
 			OwnershipType: author.OwnershipType,
 			CreateTime:    author.CreateTime.String(),
 			UpdateTime:    author.UpdateTime.String(),
@@ -293,9 +382,10 @@ func encodeRPCListResponse(_ context.Context, response interface{}) (interface{}
 		authorsRPC = append(authorsRPC, authorRPC)
 	}
 
-	return &pb.ListResponse{
+	return &pb.AuthorListResponse{
 		Authors:       authorsRPC,
 		NextPageToken: res.NextPageToken,
+		PrevPageToken: res.PrevPageToken,
 	}, nil
 }
 
@@ -314,7 +404,7 @@ func encodeRPCGetResponse(_ context.Context, response interface{}) (interface{},
 		FirstName:     res.Author.FirstName,
 		LastName:      res.Author.LastName,
 		DisplayName:   res.Author.DisplayName,
-		OwnerID:       res.Author.OwnerID,
+		Owners:        marshalOwnersRPC(res.Author.Owners),
 		OwnershipType: res.Author.OwnershipType,
 		CreateTime:    res.Author.CreateTime.String(),
 		UpdateTime:    res.Author.UpdateTime.String(),
@@ -343,7 +433,7 @@ func encodeRPCUpdateResponse(_ context.Context, response interface{}) (interface
 		FirstName:     res.Author.FirstName,
 		LastName:      res.Author.LastName,
 		DisplayName:   res.Author.DisplayName,
-		OwnerID:       res.Author.OwnerID,
+		Owners:        marshalOwnersRPC(res.Author.Owners),
 		OwnershipType: res.Author.OwnershipType,
 		CreateTime:    res.Author.CreateTime.String(),
 		UpdateTime:    res.Author.UpdateTime.String(),
@@ -381,3 +471,55 @@ func encodeRPCHardDeleteResponse(_ context.Context, response interface{}) (inter
 	}
 	return nil, nil
 }
+
+// encodeRPCOwnerResponse encodes every owner pool action response, they all return the updated author
+func encodeRPCOwnerResponse(_ context.Context, response interface{}) (interface{}, error) {
+	if res, ok := response.(endpoint.Failer); ok && res.Failed() != nil {
+		return nil, res.Failed()
+	}
+
+	var author *domain.Author
+	switch res := response.(type) {
+	case action.AddOwnerResponse:
+		author = res.Author
+	case action.RemoveOwnerResponse:
+		author = res.Author
+	case action.ChangeOwnerRoleResponse:
+		author = res.Author
+	}
+	if author == nil {
+		return nil, exception.EmptyBody
+	}
+
+	return &pb.AuthorMessage{
+		Id:            author.ExternalID,
+		FirstName:     author.FirstName,
+		LastName:      author.LastName,
+		DisplayName:   author.DisplayName,
+		Owners:        marshalOwnersRPC(author.Owners),
+		OwnershipType: author.OwnershipType,
+		CreateTime:    author.CreateTime.String(),
+		UpdateTime:    author.UpdateTime.String(),
+		DeleteTime:    author.DeleteTime.String(),
+		Active:        author.Active,
+		Verified:      author.Verified,
+		Picture:       *author.Picture,
+		TotalViews:    author.TotalViews,
+		Country:       author.Country,
+		Status:        author.Status,
+	}, nil
+}
+
+// marshalOwnersRPC returns the RPC representation of the given owner pool
+func marshalOwnersRPC(owners []*domain.Owner) []*pb.AuthorOwner {
+	ownersRPC := make([]*pb.AuthorOwner, 0, len(owners))
+	for _, owner := range owners {
+		ownersRPC = append(ownersRPC, &pb.AuthorOwner{
+			Id:         owner.ID,
+			Role:       owner.Role,
+			CreateTime: owner.CreateTime.String(),
+		})
+	}
+
+	return ownersRPC
+}
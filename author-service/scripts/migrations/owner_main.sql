@@ -1,15 +1,14 @@
 /******************************
-**	File:   main.sql
+**	File:   owner_main.sql
 **	Name:	Database migrations scripts
-**	Desc:	Main database migrations scripts for Owner microservice
+**	Desc:	Author owner pool migrations scripts, every author is owned by at least
+**			one admin, owner_id column is moved into the owners table
 **	Auth:	Alonso R
 **	Lic:	MIT
 **	Date:	2020-06-12
 *******************************/
 
-CREATE DATABASE 'alexandria/owner';
-SET search_path TO 'alexandria/owner';
-CREATE SCHEMA IF NOT EXISTS alexa1;
+SET search_path TO 'alexandria/author';
 
 CREATE TYPE alexa1.role_enum AS ENUM (
 	'admin',
@@ -17,8 +16,31 @@ CREATE TYPE alexa1.role_enum AS ENUM (
 );
 
 CREATE TABLE IF NOT EXISTS alexa1.owners(
-    author_id   varchar(128) NOT NULL,
+    author_id   varchar(128) NOT NULL REFERENCES alexa1.author(external_id) ON DELETE CASCADE,
     owner_id    varchar(128) NOT NULL,
 	role_type	alexa1.role_enum NOT NULL DEFAULT 'contrib',
-    create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
-);
\ No newline at end of file
+    create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(author_id, owner_id)
+);
+
+-- owner_id filter
+CREATE INDEX IF NOT EXISTS owners_owner_idx ON alexa1.owners(owner_id);
+
+-- Previous single owners become admins
+INSERT INTO alexa1.owners(author_id, owner_id, role_type, create_time)
+SELECT external_id, owner_id, 'admin', create_time FROM alexa1.author ON CONFLICT DO NOTHING;
+
+ALTER TABLE alexa1.author DROP COLUMN IF EXISTS owner_id;
+
+DROP PROCEDURE IF EXISTS alexa1.create_author(varchar, varchar, varchar, varchar, alexa1.ownership_enum, varchar, varchar);
+CREATE PROCEDURE alexa1.create_author(_external_id varchar(128), _first_name varchar(255), _last_name varchar(255), _display_name varchar(255),
+	_ownership_type alexa1.ownership_enum, _country varchar(5))
+LANGUAGE SQL
+AS $$
+	INSERT INTO alexa1.author(external_id, first_name, last_name, display_name, ownership_type, country) VALUES (
+		_external_id, _first_name, _last_name, _display_name, _ownership_type, _country);
+$$;
+
+-- Data querying
+SELECT * FROM alexa1.author WHERE external_id IN (SELECT author_id FROM alexa1.owners
+WHERE owner_id = 'd1d4469b-8502-4792-a1e7-13391aa67f2c') AND active = TRUE;
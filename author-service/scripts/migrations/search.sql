@@ -0,0 +1,23 @@
+/******************************
+**	File:   search.sql
+**	Name:	Full-text search migrations scripts
+**	Desc:	Generated tsvector column for author microservice, names are not
+**			stemmed so the simple text search configuration is used
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/author';
+
+ALTER TABLE alexa1.author ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+	setweight(to_tsvector('simple', COALESCE(display_name, '')), 'A') ||
+	setweight(to_tsvector('simple', COALESCE(first_name, '') || ' ' || COALESCE(last_name, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS author_search_idx ON alexa1.author USING GIN(search_vector);
+
+-- Data querying
+SELECT display_name, ts_rank_cd(search_vector, search_query) AS rank
+FROM alexa1.author, to_tsquery('simple', 'elon:* & mu:*') search_query
+WHERE search_vector @@ search_query ORDER BY rank DESC;
@@ -10,12 +10,18 @@ require (
 	github.com/google/uuid v1.1.1
 	github.com/google/wire v0.4.0
 	github.com/gorilla/mux v1.7.4
+	github.com/lib/pq v1.1.1
+	github.com/maestre3d/alexandria/shared v0.0.0
 	github.com/oklog/run v1.1.0
 	github.com/opentracing/opentracing-go v1.1.0
 	github.com/openzipkin/zipkin-go v0.2.2
 	github.com/prometheus/client_golang v1.5.1
 	github.com/sony/gobreaker v0.4.1
+	github.com/spf13/viper v1.6.3
+	github.com/stretchr/testify v1.5.1
 	go.opencensus.io v0.22.3
 	gocloud.dev v0.20.0
 	gocloud.dev/pubsub/kafkapubsub v0.20.0 // indirect
 )
+
+replace github.com/maestre3d/alexandria/shared => ../shared
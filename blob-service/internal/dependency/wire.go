@@ -6,6 +6,8 @@ import (
 	"context"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/go-kit/kit/log"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/blob-service/internal/domain"
 	"github.com/maestre3d/alexandria/blob-service/internal/infrastructure"
@@ -16,23 +18,42 @@ var Ctx = context.Background()
 
 var persistenceSet = wire.NewSet(
 	logger.NewZapLogger,
-	wire.Bind(new(domain.BlobStorage), new(*infrastructure.BlobS3Storage)),
-	infrastructure.NewBlobS3Storage,
 	provideContext,
 	config.NewKernel,
-	wire.Bind(new(domain.BlobRepository), new(*infrastructure.BlobDynamoRepository)),
-	infrastructure.NewBlobDynamoRepository,
+	infrastructure.NewBlobConfig,
+	wire.Bind(new(domain.BlobStorage), new(*infrastructure.BlobBucketStorage)),
+	infrastructure.NewBlobBucketStorage,
+	provideBlobRepository,
 )
 
 func provideContext() context.Context {
 	return Ctx
 }
 
+// provideBlobRepository returns the configured blob repository, DynamoDB by default
+func provideBlobRepository(ctx context.Context, logger log.Logger, kernel *config.Kernel,
+	cfg *infrastructure.BlobConfig) (domain.BlobRepository, func(), error) {
+	switch cfg.Repository {
+	case infrastructure.RepositoryPostgres:
+		db, cleanup, err := persistence.NewPostgresPool(ctx, kernel)
+		if err != nil {
+			return nil, nil, err
+		}
+		return infrastructure.NewBlobPQRepository(db, logger), cleanup, nil
+	case infrastructure.RepositoryMemory:
+		return infrastructure.NewBlobMemRepository(logger)
+	default:
+		return infrastructure.NewBlobDynamoRepository(logger, kernel), func() {}, nil
+	}
+}
+
 func InjectBlobUseCase() (*interactor.Blob, func(), error) {
 	wire.Build(
 		persistenceSet,
 		wire.Bind(new(domain.BlobEvent), new(*infrastructure.BlobKafkaEvent)),
 		infrastructure.NewBlobKafkaEvent,
+		wire.Bind(new(domain.BlobAccess), new(*infrastructure.BlobMediaAccess)),
+		infrastructure.NewBlobMediaAccess,
 		interactor.NewBlob,
 	)
 	return &interactor.Blob{}, nil, nil
@@ -9,6 +9,8 @@ import (
 	"context"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/go-kit/kit/log"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/blob-service/internal/domain"
 	"github.com/maestre3d/alexandria/blob-service/internal/infrastructure"
@@ -24,11 +26,22 @@ func InjectBlobUseCase() (*interactor.Blob, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	blobDynamoRepository := infrastructure.NewBlobDynamoRepository(logLogger, kernel)
-	blobS3Storage := infrastructure.NewBlobS3Storage(logLogger)
+	blobConfig := infrastructure.NewBlobConfig(kernel)
+	blobRepository, cleanup, err := provideBlobRepository(context, logLogger, kernel, blobConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobBucketStorage, cleanup2, err := infrastructure.NewBlobBucketStorage(context, logLogger, blobConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	blobKafkaEvent := infrastructure.NewBlobKafkaEvent(kernel)
-	blob := interactor.NewBlob(logLogger, blobDynamoRepository, blobS3Storage, blobKafkaEvent)
+	blobMediaAccess := infrastructure.NewBlobMediaAccess(logLogger, kernel)
+	blob := interactor.NewBlob(logLogger, blobRepository, blobBucketStorage, blobKafkaEvent, blobMediaAccess)
 	return blob, func() {
+		cleanup2()
+		cleanup()
 	}, nil
 }
 
@@ -39,10 +52,20 @@ func InjectBlobSagaUseCase() (*interactor.BlobSAGA, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	blobDynamoRepository := infrastructure.NewBlobDynamoRepository(logLogger, kernel)
-	blobS3Storage := infrastructure.NewBlobS3Storage(logLogger)
-	blobSAGA := interactor.NewBlobSaga(logLogger, blobDynamoRepository, blobS3Storage)
+	blobConfig := infrastructure.NewBlobConfig(kernel)
+	blobRepository, cleanup, err := provideBlobRepository(context, logLogger, kernel, blobConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobBucketStorage, cleanup2, err := infrastructure.NewBlobBucketStorage(context, logLogger, blobConfig)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	blobSAGA := interactor.NewBlobSaga(logLogger, blobRepository, blobBucketStorage)
 	return blobSAGA, func() {
+		cleanup2()
+		cleanup()
 	}, nil
 }
 
@@ -50,8 +73,25 @@ func InjectBlobSagaUseCase() (*interactor.BlobSAGA, func(), error) {
 
 var Ctx = context.Background()
 
-var persistenceSet = wire.NewSet(logger.NewZapLogger, wire.Bind(new(domain.BlobStorage), new(*infrastructure.BlobS3Storage)), infrastructure.NewBlobS3Storage, provideContext, config.NewKernel, wire.Bind(new(domain.BlobRepository), new(*infrastructure.BlobDynamoRepository)), infrastructure.NewBlobDynamoRepository)
+var persistenceSet = wire.NewSet(logger.NewZapLogger, provideContext, config.NewKernel, infrastructure.NewBlobConfig, wire.Bind(new(domain.BlobStorage), new(*infrastructure.BlobBucketStorage)), infrastructure.NewBlobBucketStorage, provideBlobRepository)
 
 func provideContext() context.Context {
 	return Ctx
 }
+
+// provideBlobRepository returns the configured blob repository, DynamoDB by default
+func provideBlobRepository(ctx context.Context, logger2 log.Logger, kernel *config.Kernel,
+	cfg *infrastructure.BlobConfig) (domain.BlobRepository, func(), error) {
+	switch cfg.Repository {
+	case infrastructure.RepositoryPostgres:
+		db, cleanup, err := persistence.NewPostgresPool(ctx, kernel)
+		if err != nil {
+			return nil, nil, err
+		}
+		return infrastructure.NewBlobPQRepository(db, logger2), cleanup, nil
+	case infrastructure.RepositoryMemory:
+		return infrastructure.NewBlobMemRepository(logger2)
+	default:
+		return infrastructure.NewBlobDynamoRepository(logger2, kernel), func() {}, nil
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "context"
+
+// BlobAccess tells whether the caller may read the blobs of a root entity (e.g. private media), blobs of
+// hidden entities are reported as not found
+type BlobAccess interface {
+	CanRead(ctx context.Context, service, rootID string) error
+}
+
+type credentialsContextKey struct{}
+
+// WithCredentials returns a copy of ctx holding the caller's raw authorization (e.g. Bearer <JWT>), it is
+// forwarded to the services owning the root entities
+func WithCredentials(ctx context.Context, authorization string) context.Context {
+	return context.WithValue(ctx, credentialsContextKey{}, authorization)
+}
+
+// CredentialsFromContext returns the caller's raw authorization, empty if the caller is anonymous
+func CredentialsFromContext(ctx context.Context) string {
+	authorization, _ := ctx.Value(credentialsContextKey{}).(string)
+	return authorization
+}
@@ -6,5 +6,28 @@ type BlobAggregate struct {
 	BlobType  string `json:"blob_type"`
 	Extension string `json:"extension"`
 	Size      string `json:"size"`
-	Content   File   `json:"content"`
+	// Checksum expected SHA-256 (hex) of the content, optional
+	Checksum string `json:"checksum"`
+	Content  File   `json:"content"`
+}
+
+// UploadAggregate resumable upload session attributes, content is sent in chunks
+type UploadAggregate struct {
+	RootID    string `json:"root_id"`
+	Service   string `json:"service"`
+	BlobType  string `json:"blob_type"`
+	Extension string `json:"extension"`
+	Size      string `json:"size"`
+	// Checksum expected SHA-256 (hex) of the blob, optional
+	Checksum string `json:"checksum"`
+}
+
+// ChunkAggregate chunk of a resumable upload starting at the given offset
+type ChunkAggregate struct {
+	UploadID string `json:"upload_id"`
+	RootID   string `json:"root_id"`
+	Service  string `json:"service"`
+	Offset   string `json:"offset"`
+	Size     string `json:"size"`
+	Content  File   `json:"content"`
 }
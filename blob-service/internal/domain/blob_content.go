@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"hash"
+	"io"
+)
+
+// sniffLen bytes read to detect the content type
+const sniffLen = 512
+
+// contentTypes sniffed content types accepted by every declared blob type
+var contentTypes = map[string][]string{
+	"image/jpeg":      {"image/jpeg"},
+	"application/pdf": {"application/pdf"},
+	"audio/mpeg":      {"audio/mpeg"},
+	"audio/aac":       {"audio/aac"},
+	"audio/mp4":       {"audio/mp4", "video/mp4"},
+	"audio/ogg":       {"application/ogg"},
+	"audio/vorbis":    {"application/ogg"},
+	"video/mp4":       {"video/mp4", "audio/mp4"},
+	"video/ogg":       {"application/ogg"},
+	"video/mpeg":      {"video/mpeg"},
+	"video/h264":      {"video/h264"},
+}
+
+// SniffContentType returns the content type of the given head using its magic bytes,
+// application/octet-stream if unknown
+func SniffContentType(head []byte) string {
+	switch {
+	case bytes.HasPrefix(head, []byte("%PDF-")):
+		return "application/pdf"
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg"
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case len(head) >= 12 && bytes.Equal(head[4:8], []byte("ftyp")):
+		// ISO base media, brand tells audio only files apart
+		if bytes.HasPrefix(head[8:], []byte("M4A ")) {
+			return "audio/mp4"
+		}
+		return "video/mp4"
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return "application/ogg"
+	case bytes.HasPrefix(head, []byte("ID3")):
+		return "audio/mpeg"
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xF6 == 0xF0:
+		// ADTS frame sync, layer is always 0
+		return "audio/aac"
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0 && head[1]&0x06 != 0:
+		// MPEG audio frame sync without ID3 tag
+		return "audio/mpeg"
+	case bytes.HasPrefix(head, []byte{0x00, 0x00, 0x01, 0xBA}) || (len(head) >= 189 && head[0] == 0x47 && head[188] == 0x47):
+		// MPEG program and transport streams
+		return "video/mpeg"
+	case bytes.HasPrefix(head, []byte{0x00, 0x00, 0x00, 0x01}) || bytes.HasPrefix(head, []byte{0x00, 0x00, 0x01}):
+		// H.264 Annex B start code
+		return "video/h264"
+	}
+
+	return "application/octet-stream"
+}
+
+// MatchesContentType returns true if the sniffed content type is accepted by the blob's declared type
+func (e Blob) MatchesContentType(contentType string) bool {
+	for _, accepted := range contentTypes[e.BlobType+"/"+e.Extension] {
+		if accepted == contentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contentVerifier sniffs and hashes the blob's content while it is read
+type contentVerifier struct {
+	blob    *Blob
+	content io.Reader
+	hash    hash.Hash
+	sniffed bool
+}
+
+// VerifyContent returns a reader of the given content rejecting contents not matching the blob's type or checksum.
+// Once read, the blob holds the sniffed content type and the content's SHA-256. Errors are returned instead of EOF,
+// hence writers abort before storing anything
+func VerifyContent(blobRef *Blob, content io.Reader) io.Reader {
+	return &contentVerifier{
+		blob:    blobRef,
+		content: content,
+		hash:    sha256.New(),
+	}
+}
+
+func (v *contentVerifier) Read(p []byte) (int, error) {
+	if !v.sniffed {
+		head := make([]byte, sniffLen)
+		n, err := io.ReadFull(v.content, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+
+		contentType := SniffContentType(head[:n])
+		if !v.blob.MatchesContentType(contentType) {
+			return 0, exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf("content of type %s does not match the declared %s/%s", contentType, v.blob.BlobType,
+					v.blob.Extension))
+		}
+
+		v.sniffed = true
+		v.blob.ContentType = contentType
+		v.content = io.TeeReader(io.MultiReader(bytes.NewReader(head[:n]), v.content), v.hash)
+	}
+
+	n, err := v.content.Read(p)
+	if err == io.EOF {
+		checksum := hex.EncodeToString(v.hash.Sum(nil))
+		if v.blob.Checksum != "" && v.blob.Checksum != checksum {
+			return n, exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf("content checksum %s does not match the given %s", checksum, v.blob.Checksum))
+		}
+		v.blob.Checksum = checksum
+	}
+
+	return n, err
+}
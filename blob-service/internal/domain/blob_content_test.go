@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSniffContentType(t *testing.T) {
+	assert.Equal(t, "application/pdf", SniffContentType([]byte("%PDF-1.7")))
+	assert.Equal(t, "image/jpeg", SniffContentType([]byte{0xFF, 0xD8, 0xFF, 0xE0}))
+	assert.Equal(t, "image/png", SniffContentType([]byte("\x89PNG\r\n\x1a\n")))
+	assert.Equal(t, "video/mp4", SniffContentType([]byte("\x00\x00\x00\x18ftypmp42")))
+	assert.Equal(t, "audio/mp4", SniffContentType([]byte("\x00\x00\x00\x18ftypM4A ")))
+	assert.Equal(t, "application/ogg", SniffContentType([]byte("OggS\x00")))
+	assert.Equal(t, "audio/mpeg", SniffContentType([]byte("ID3\x03")))
+	assert.Equal(t, "audio/mpeg", SniffContentType([]byte{0xFF, 0xFB, 0x90}))
+	assert.Equal(t, "audio/aac", SniffContentType([]byte{0xFF, 0xF1, 0x50}))
+	assert.Equal(t, "application/octet-stream", SniffContentType([]byte("hello")))
+}
+
+func TestVerifyContent(t *testing.T) {
+	content := "%PDF-1.7 alexandria"
+	sum := sha256.Sum256([]byte(content))
+
+	// Declared types must match the content
+	blob := NewBlob("1", Media, "video", "mp4", int64(len(content)))
+	_, err := ioutil.ReadAll(VerifyContent(blob, strings.NewReader(content)))
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+
+	blob = NewBlob("1", Media, "application", "pdf", int64(len(content)))
+	blob.Checksum = strings.Repeat("0", 64)
+	_, err = ioutil.ReadAll(VerifyContent(blob, strings.NewReader(content)))
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+
+	blob.Checksum = hex.EncodeToString(sum[:])
+	read, err := ioutil.ReadAll(VerifyContent(blob, strings.NewReader(content)))
+	assert.Nil(t, err)
+	assert.Equal(t, content, string(read))
+	assert.Equal(t, "application/pdf", blob.ContentType)
+
+	blob.Checksum = ""
+	_, err = ioutil.ReadAll(VerifyContent(blob, strings.NewReader(content)))
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), blob.Checksum)
+}
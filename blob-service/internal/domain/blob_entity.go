@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/alexandria-oss/core/exception"
 	"github.com/go-playground/validator/v10"
@@ -16,24 +18,26 @@ const (
 	User   = "user"
 )
 
+// File blob content, contents are streamed to the storage hence they are read only once
 type File interface {
 	io.Reader
-	io.ReaderAt
-	io.Seeker
 	io.Closer
 }
 
 type Blob struct {
-	ID         string `json:"id" docstore:"id" validate:"required"`
-	Service    string `json:"service" docstore:"service" validate:"required,oneof=media author user"`
-	Name       string `json:"name" docstore:"name" validate:"required,min=1,max=512"`
-	Size       int64  `json:"size" docstore:"size"`
-	BlobType   string `json:"blob_type" docstore:"blob_type" validate:"required"`
-	Extension  string `json:"extension" docstore:"extension" validate:"required,min=1,max=8"`
-	Url        string `json:"url" docstore:"url" validate:"max=2048"`
-	CreateTime int64  `json:"create_time" docstore:"create_time"`
-	UpdateTime int64  `json:"update_time" docstore:"update_time"`
-	Content    File   `json:"-" docstore:"-"`
+	ID        string `json:"id" docstore:"id" validate:"required"`
+	Service   string `json:"service" docstore:"service" validate:"required,oneof=media author user"`
+	Name      string `json:"name" docstore:"name" validate:"required,min=1,max=512"`
+	Size      int64  `json:"size" docstore:"size"`
+	BlobType  string `json:"blob_type" docstore:"blob_type" validate:"required"`
+	Extension string `json:"extension" docstore:"extension" validate:"required,min=1,max=8"`
+	Url       string `json:"url" docstore:"url" validate:"max=2048"`
+	// ContentType sniffed from the content, Checksum is the content's SHA-256 (hex)
+	ContentType string `json:"content_type" docstore:"content_type"`
+	Checksum    string `json:"checksum" docstore:"checksum"`
+	CreateTime  int64  `json:"create_time" docstore:"create_time"`
+	UpdateTime  int64  `json:"update_time" docstore:"update_time"`
+	Content     File   `json:"-" docstore:"-"`
 }
 
 func NewBlob(rootID, service, blobType, extension string, size int64) *Blob {
@@ -49,7 +53,7 @@ func NewBlob(rootID, service, blobType, extension string, size int64) *Blob {
 		UpdateTime: time.Now().Unix(),
 	}
 
-	blob.Url = fmt.Sprintf("https://%s/%s/%s/%s", StorageDomain, StoragePath, blob.Service, blob.Name)
+	// Url is set by the storage once the content is stored
 	// Attach Service_ID as first 4 bytes in Root_ID to avoid entity collision in logging tables
 	blob.ID = GetServiceID(blob.Service) + blob.ID
 
@@ -111,6 +115,11 @@ func (e Blob) IsValid() error {
 		return err
 	}
 
+	if e.Checksum != "" && !isChecksum(e.Checksum) {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "checksum", "sha-256 hex"))
+	}
+
 	// go's file.size is in bytes
 	var maxSize int64
 	// Default Max 10MB
@@ -181,3 +190,12 @@ func (e Blob) IsValid() error {
 
 	return nil
 }
+
+// isChecksum returns true if the given checksum is a SHA-256 hex digest
+func isChecksum(checksum string) bool {
+	if len(checksum) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(checksum)
+	return err == nil
+}
@@ -3,4 +3,9 @@ package domain
 const (
 	// Foreign validation events
 	BlobFailed = "BLOB_FAILED" // Consumed
+
+	// Foreign side-effect events, blobs of permanently removed aggregates are cleaned up
+	AuthorHardRemoved = "AUTHOR_PERMANENTLY_REMOVED" // Consumed
+	MediaHardRemoved  = "MEDIA_PERMANENTLY_REMOVED"  // Consumed
+	UserHardRemoved   = "USER_PERMANENTLY_REMOVED"   // Consumed
 )
@@ -6,4 +6,8 @@ type BlobRepository interface {
 	Save(ctx context.Context, blobRef Blob) error
 	FetchByID(ctx context.Context, id string) (*Blob, error)
 	Remove(ctx context.Context, id string) error
+	// SaveUpload stores an upload session, sessions modified since they were fetched are not stored
+	SaveUpload(ctx context.Context, upload Upload) error
+	FetchUpload(ctx context.Context, id string) (*Upload, error)
+	RemoveUpload(ctx context.Context, id string) error
 }
@@ -1,8 +1,18 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type BlobStorage interface {
+	// Store streams the blob's content to the storage, contents not matching the blob's size, type or checksum
+	// are discarded (see VerifyContent)
 	Store(ctx context.Context, blobRef *Blob) error
 	Delete(ctx context.Context, key, service string) error
+	// StorePart streams a chunk of a resumable upload, chunks not matching the given size are discarded
+	StorePart(ctx context.Context, key, service string, size int64, content io.Reader) error
+	// Compose streams the given parts, in order, as the blob's content. Contents are verified like Store does,
+	// parts are kept
+	Compose(ctx context.Context, blobRef *Blob, parts []string) error
 }
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// UploadTTL time given to complete an upload session, expired sessions are removed once accessed
+const UploadTTL = 24 * time.Hour
+
+// Upload resumable upload session, chunks are appended in order and composed into the blob once
+// every byte was received
+type Upload struct {
+	ID        string `json:"upload_id" docstore:"id"`
+	RootID    string `json:"root_id" docstore:"root_id"`
+	Service   string `json:"service" docstore:"service"`
+	BlobType  string `json:"blob_type" docstore:"blob_type"`
+	Extension string `json:"extension" docstore:"extension"`
+	Size      int64  `json:"size" docstore:"size"`
+	Offset    int64  `json:"offset" docstore:"offset"`
+	// Checksum expected SHA-256 (hex) of the blob, optional
+	Checksum   string   `json:"checksum" docstore:"checksum"`
+	Parts      []string `json:"-" docstore:"parts"`
+	UploaderID string   `json:"-" docstore:"uploader_id"`
+	CreateTime int64    `json:"create_time" docstore:"create_time"`
+	UpdateTime int64    `json:"update_time" docstore:"update_time"`
+	ExpireTime int64    `json:"expire_time" docstore:"expire_time"`
+	// Revision avoids concurrent chunks overriding each other
+	Revision interface{} `json:"-" docstore:"DocstoreRevision"`
+}
+
+// NewUpload returns an empty upload session, the blob's constraints are checked before any chunk is received
+func NewUpload(rootID, service, blobType, extension string, size int64, checksum, uploaderID string,
+	ttl time.Duration) (*Upload, error) {
+	if size <= 0 {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf(exception.InvalidFieldRangeString, "size", "1 B", "n"))
+	}
+	blob := NewBlob(rootID, service, blobType, extension, size)
+	blob.Checksum = strings.ToLower(checksum)
+	if err := blob.IsValid(); err != nil {
+		return nil, err
+	}
+
+	return &Upload{
+		// Prefixed so sessions never collide with blobs sharing the collection
+		ID:         "upload-" + uuid.New().String(),
+		RootID:     rootID,
+		Service:    strings.ToLower(service),
+		BlobType:   blobType,
+		Extension:  strings.ToLower(extension),
+		Size:       size,
+		Offset:     0,
+		Checksum:   blob.Checksum,
+		Parts:      make([]string, 0),
+		UploaderID: uploaderID,
+		CreateTime: time.Now().Unix(),
+		UpdateTime: time.Now().Unix(),
+		ExpireTime: time.Now().Add(ttl).Unix(),
+	}, nil
+}
+
+// IsExpired returns true if the session can no longer be resumed
+func (u Upload) IsExpired() bool {
+	return time.Now().Unix() > u.ExpireTime
+}
+
+// IsComplete returns true if every byte of the blob was received
+func (u Upload) IsComplete() bool {
+	return u.Offset == u.Size
+}
+
+// PartKey returns a new storage key of the chunk starting at the given offset, keys are unique per request so
+// concurrent chunks with the same offset never override the part kept by the session
+func (u Upload) PartKey(offset int64) string {
+	return fmt.Sprintf("uploads/%s/%020d-%s", u.ID, offset, uuid.New().String())
+}
+
+// CanAppend validates a chunk, chunks must start at the current offset and fit in the blob's size
+func (u Upload) CanAppend(offset, size int64) error {
+	if offset != u.Offset {
+		return exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf("offset %d does not match the upload's offset %d", offset, u.Offset))
+	}
+	if size <= 0 || offset+size > u.Size {
+		return exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf(exception.InvalidFieldRangeString, "chunk", "1 B", fmt.Sprintf("%d B", u.Size-u.Offset)))
+	}
+
+	return nil
+}
+
+// Append adds a chunk stored with the given key to the session
+func (u *Upload) Append(key string, size int64) {
+	u.Parts = append(u.Parts, key)
+	u.Offset += size
+	u.UpdateTime = time.Now().Unix()
+}
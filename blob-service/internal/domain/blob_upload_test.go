@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpload_Append(t *testing.T) {
+	_, err := NewUpload("1", User, "image", "png", 1024, "", "user-1", UploadTTL)
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+
+	upload, err := NewUpload("1", User, "image", "jpeg", 1024, "", "user-1", UploadTTL)
+	assert.Nil(t, err)
+	assert.False(t, upload.IsExpired())
+
+	// Chunks must start at the session's offset and fit in the blob
+	assert.True(t, errors.Is(upload.CanAppend(512, 512), exception.InvalidFieldRange))
+	assert.True(t, errors.Is(upload.CanAppend(0, 2048), exception.InvalidFieldRange))
+	assert.Nil(t, upload.CanAppend(0, 512))
+	first := upload.PartKey(0)
+	assert.NotEqual(t, first, upload.PartKey(0))
+	upload.Append(first, 512)
+	assert.False(t, upload.IsComplete())
+
+	assert.Nil(t, upload.CanAppend(512, 512))
+	second := upload.PartKey(512)
+	upload.Append(second, 512)
+	assert.True(t, upload.IsComplete())
+	assert.Equal(t, []string{first, second}, upload.Parts)
+
+	expired, err := NewUpload("1", User, "image", "jpeg", 1024, "", "user-1", -time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, expired.IsExpired())
+}
@@ -1,7 +0,0 @@
-package domain
-
-const (
-	StorageDomain = "cdn.damascus-engineering.com"
-	StoragePath   = "alexandria"
-	StorageRegion = "us-west-2"
-)
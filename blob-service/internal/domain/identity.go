@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/maestre3d/alexandria/shared/auth"
+)
+
+// Identity authenticated caller, transports propagate it through the request context
+type Identity = auth.Identity
+
+// IdentityFromContext returns the caller of the given context, false if the call was not authenticated
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	return auth.IdentityFromContext(ctx)
+}
@@ -0,0 +1,181 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/gcsblob"
+	"gocloud.dev/blob/memblob"
+	_ "gocloud.dev/blob/s3blob"
+	"gocloud.dev/gcerrors"
+	"io"
+	"path"
+	"strings"
+	"sync"
+)
+
+// BlobBucketStorage stores blobs in the bucket of the configured URL (s3://, gs://, file:// or mem://),
+// every service gets its own directory
+type BlobBucketStorage struct {
+	bucket    *blob.Bucket
+	prefix    string
+	publicURL string
+	logger    log.Logger
+}
+
+var (
+	// memBucket in-memory bucket shared by every storage of the process, mem:// URLs open a new bucket otherwise
+	memBucket     *blob.Bucket
+	memBucketOnce = new(sync.Once)
+)
+
+// NewBlobBucketStorage opens the configured bucket, the bucket is kept open until cleanup is called
+func NewBlobBucketStorage(ctx context.Context, logger log.Logger, cfg *BlobConfig) (*BlobBucketStorage, func(), error) {
+	var bucket *blob.Bucket
+	cleanup := func() {}
+	if strings.HasPrefix(cfg.BucketURL, memblob.Scheme+"://") {
+		memBucketOnce.Do(func() {
+			memBucket = memblob.OpenBucket(nil)
+		})
+		bucket = memBucket
+	} else {
+		var err error
+		if bucket, err = blob.OpenBucket(ctx, cfg.BucketURL); err != nil {
+			return nil, nil, err
+		}
+		cleanup = func() {
+			_ = bucket.Close()
+		}
+	}
+
+	return &BlobBucketStorage{
+		bucket:    bucket,
+		prefix:    cfg.Prefix,
+		publicURL: cfg.PublicURL,
+		logger:    logger,
+	}, cleanup, nil
+}
+
+func (s *BlobBucketStorage) Store(ctx context.Context, blobRef *domain.Blob) error {
+	err := s.write(ctx, s.key(blobRef.Service, blobRef.Name), blobRef.Size, domain.VerifyContent(blobRef, blobRef.Content))
+	if err != nil {
+		return err
+	}
+
+	blobRef.Url = s.url(blobRef)
+	return nil
+}
+
+func (s *BlobBucketStorage) StorePart(ctx context.Context, key, service string, size int64, content io.Reader) error {
+	return s.write(ctx, s.key(service, key), size, content)
+}
+
+func (s *BlobBucketStorage) Compose(ctx context.Context, blobRef *domain.Blob, parts []string) error {
+	// Parts are read one at a time, only a single part is open while writing
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		readers = append(readers, &lazyPartReader{ctx: ctx, bucket: s.bucket, key: s.key(blobRef.Service, part)})
+	}
+	defer func() {
+		for _, r := range readers {
+			_ = r.(*lazyPartReader).Close()
+		}
+	}()
+
+	err := s.write(ctx, s.key(blobRef.Service, blobRef.Name), blobRef.Size,
+		domain.VerifyContent(blobRef, io.MultiReader(readers...)))
+	if err != nil {
+		return err
+	}
+
+	blobRef.Url = s.url(blobRef)
+	return nil
+}
+
+func (s *BlobBucketStorage) Delete(ctx context.Context, key, service string) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+	err := s.bucket.Delete(ctxR, s.key(service, key))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return exception.EntityNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// key returns the bucket key of the given service's blob
+func (s *BlobBucketStorage) key(service, key string) string {
+	return path.Join(s.prefix, service, key)
+}
+
+// url returns the public URL of the given blob
+func (s *BlobBucketStorage) url(blobRef *domain.Blob) string {
+	return fmt.Sprintf("%s/%s/%s", s.publicURL, blobRef.Service, blobRef.Name)
+}
+
+// write streams the given content to the bucket, the writer only buffers a single upload part.
+// Contents shorter or longer than size abort the write, hence no partial object is ever stored
+func (s *BlobBucketStorage) write(ctx context.Context, key string, size int64, content io.Reader) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := s.bucket.NewWriter(ctxR, key, nil)
+	if err != nil {
+		return err
+	}
+
+	// Read an extra byte to detect longer contents
+	written, err := io.Copy(w, io.LimitReader(content, size+1))
+	if err == nil && written != size {
+		err = exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf("received %d bytes, expected %d", written, size))
+	}
+	if err != nil {
+		// Canceling the context before closing aborts the write
+		cancel()
+		_ = w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// lazyPartReader opens the part once it is read
+type lazyPartReader struct {
+	ctx    context.Context
+	bucket *blob.Bucket
+	key    string
+	r      *blob.Reader
+}
+
+func (p *lazyPartReader) Read(b []byte) (int, error) {
+	if p.r == nil {
+		r, err := p.bucket.NewReader(p.ctx, p.key, nil)
+		if err != nil {
+			return 0, err
+		}
+		p.r = r
+	}
+
+	n, err := p.r.Read(b)
+	if err == io.EOF {
+		// Release the part before the next one is opened
+		_ = p.Close()
+	}
+	return n, err
+}
+
+func (p *lazyPartReader) Close() error {
+	if p.r == nil {
+		return nil
+	}
+	err := p.r.Close()
+	p.r = nil
+	return err
+}
@@ -0,0 +1,54 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobBucketStorage_Compose(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alexandria-blob")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, cleanup, err := NewBlobBucketStorage(context.Background(), log.NewNopLogger(), &BlobConfig{
+		BucketURL: "file://" + dir,
+		Prefix:    "alexandria",
+		PublicURL: "http://localhost:8080/static",
+	})
+	assert.Nil(t, err)
+	defer cleanup()
+
+	ctx := context.Background()
+	assert.Nil(t, storage.StorePart(ctx, "uploads/1/0", domain.Media, 5, strings.NewReader("%PDF-")))
+	assert.Nil(t, storage.StorePart(ctx, "uploads/1/5", domain.Media, 6, strings.NewReader("1.4 %%")))
+	// Contents not matching the size are never stored
+	err = storage.StorePart(ctx, "uploads/1/11", domain.Media, 2, strings.NewReader("!!!"))
+	assert.True(t, errors.Is(err, exception.InvalidFieldRange))
+	assert.Equal(t, exception.EntityNotFound, storage.Delete(ctx, "uploads/1/11", domain.Media))
+
+	// Parts are verified as a whole
+	blob := domain.NewBlob("1", domain.Media, "application", "pdf", 11)
+	blob.Checksum = strings.Repeat("0", 64)
+	err = storage.Compose(ctx, blob, []string{"uploads/1/0", "uploads/1/5"})
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+	assert.Equal(t, exception.EntityNotFound, storage.Delete(ctx, blob.Name, domain.Media))
+
+	blob.Checksum = ""
+	assert.Nil(t, storage.Compose(ctx, blob, []string{"uploads/1/0", "uploads/1/5"}))
+	assert.Equal(t, "http://localhost:8080/static/media/1.pdf", blob.Url)
+	assert.Equal(t, "application/pdf", blob.ContentType)
+	assert.Len(t, blob.Checksum, 64)
+
+	content, err := ioutil.ReadFile(dir + "/alexandria/media/1.pdf")
+	assert.Nil(t, err)
+	assert.Equal(t, "%PDF-1.4 %%", string(content))
+}
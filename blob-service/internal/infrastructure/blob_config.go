@@ -0,0 +1,45 @@
+package infrastructure
+
+import (
+	"strings"
+
+	"github.com/alexandria-oss/core/config"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.persistence.blob.bucket", "s3://cdn.damascus-engineering.com?region=us-west-2")
+	viper.SetDefault("alexandria.persistence.blob.prefix", "alexandria")
+	viper.SetDefault("alexandria.persistence.blob.public_url", "https://cdn.damascus-engineering.com/alexandria")
+	viper.SetDefault("alexandria.persistence.blob.repository", RepositoryDynamo)
+}
+
+const (
+	// RepositoryDynamo blobs are kept by the AWS DynamoDB collection of alexandria.persistence.doc
+	RepositoryDynamo = "dynamodb"
+	// RepositoryPostgres blobs are kept by the PostgreSQL database of alexandria.persistence.dbms
+	RepositoryPostgres = "postgres"
+	// RepositoryMemory blobs are kept in memory, they are lost after a restart
+	RepositoryMemory = "memory"
+)
+
+// BlobConfig blob storage configuration, complements the kernel's persistence configuration
+type BlobConfig struct {
+	// BucketURL gocloud.dev bucket URL (s3://, gs://, file:// or mem://)
+	BucketURL string
+	// Prefix keys of every blob start with prefix
+	Prefix string
+	// PublicURL base URL blobs are served from, blob URLs are PublicURL/service/name
+	PublicURL  string
+	Repository string
+}
+
+// NewBlobConfig returns the blob storage configuration, the kernel config must be loaded before
+func NewBlobConfig(_ *config.Kernel) *BlobConfig {
+	return &BlobConfig{
+		BucketURL:  viper.GetString("alexandria.persistence.blob.bucket"),
+		Prefix:     strings.Trim(viper.GetString("alexandria.persistence.blob.prefix"), "/"),
+		PublicURL:  strings.TrimSuffix(viper.GetString("alexandria.persistence.blob.public_url"), "/"),
+		Repository: strings.ToLower(strings.TrimSpace(viper.GetString("alexandria.persistence.blob.repository"))),
+	}
+}
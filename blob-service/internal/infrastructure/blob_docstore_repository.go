@@ -0,0 +1,168 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"gocloud.dev/docstore"
+	_ "gocloud.dev/docstore/awsdynamodb"
+	"gocloud.dev/docstore/memdocstore"
+	"gocloud.dev/gcerrors"
+	"sync"
+)
+
+// BlobDocstoreRepository Blob gocloud.dev docstore repo implementation, backed by AWS DynamoDB or memory
+type BlobDocstoreRepository struct {
+	open   func(ctx context.Context) (*docstore.Collection, func(), error)
+	logger log.Logger
+	mu     *sync.Mutex
+}
+
+// NewBlobDynamoRepository returns a repository using the AWS DynamoDB collection of the kernel
+func NewBlobDynamoRepository(logger log.Logger, cfg *config.Kernel) *BlobDocstoreRepository {
+	return &BlobDocstoreRepository{
+		open: func(ctx context.Context) (*docstore.Collection, func(), error) {
+			return persistence.NewDynamoDBCollectionPool(ctx, cfg)
+		},
+		logger: logger,
+		mu:     new(sync.Mutex),
+	}
+}
+
+var (
+	// memCollection in-memory collection shared by every repository of the process
+	memCollection     *docstore.Collection
+	memCollectionErr  error
+	memCollectionOnce = new(sync.Once)
+)
+
+// NewBlobMemRepository returns a repository using an in-memory collection, blobs are lost after a restart
+func NewBlobMemRepository(logger log.Logger) (*BlobDocstoreRepository, func(), error) {
+	memCollectionOnce.Do(func() {
+		memCollection, memCollectionErr = memdocstore.OpenCollection("id", nil)
+	})
+	if memCollectionErr != nil {
+		return nil, nil, memCollectionErr
+	}
+
+	return &BlobDocstoreRepository{
+		open: func(_ context.Context) (*docstore.Collection, func(), error) {
+			return memCollection, func() {}, nil
+		},
+		logger: logger,
+		mu:     new(sync.Mutex),
+	}, func() {}, nil
+}
+
+func (r *BlobDocstoreRepository) Save(ctx context.Context, blobRef domain.Blob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	coll, cleanup, err := r.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return coll.Put(ctx, &blobRef)
+}
+
+func (r *BlobDocstoreRepository) FetchByID(ctx context.Context, id string) (*domain.Blob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	coll, cleanup, err := r.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	b := &domain.Blob{ID: id}
+	err = coll.Get(ctx, b)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, exception.EntityNotFound
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (r *BlobDocstoreRepository) Remove(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	coll, cleanup, err := r.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	b := &domain.Blob{ID: id}
+	return coll.Delete(ctx, b)
+}
+
+func (r *BlobDocstoreRepository) SaveUpload(ctx context.Context, upload domain.Upload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	coll, cleanup, err := r.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// Fetched sessions hold a revision, Put fails if the session was modified since then
+	err = coll.Put(ctx, &upload)
+	if err != nil {
+		switch gcerrors.Code(err) {
+		case gcerrors.FailedPrecondition:
+			return exception.NewErrorDescription(exception.EntityExists,
+				fmt.Sprintf("upload %s was modified concurrently, fetch its offset and retry", upload.ID))
+		case gcerrors.NotFound:
+			return exception.EntityNotFound
+		}
+	}
+
+	return err
+}
+
+func (r *BlobDocstoreRepository) FetchUpload(ctx context.Context, id string) (*domain.Upload, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	coll, cleanup, err := r.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	u := &domain.Upload{ID: id}
+	err = coll.Get(ctx, u)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, exception.EntityNotFound
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (r *BlobDocstoreRepository) RemoveUpload(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	coll, cleanup, err := r.open(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return coll.Delete(ctx, &domain.Upload{ID: id})
+}
@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobDocstoreRepository_SaveUpload(t *testing.T) {
+	repo, cleanup, err := NewBlobMemRepository(log.NewNopLogger())
+	assert.Nil(t, err)
+	defer cleanup()
+
+	ctx := context.Background()
+	upload, err := domain.NewUpload("1", domain.User, "image", "jpeg", 1024, "", "user-1", domain.UploadTTL)
+	assert.Nil(t, err)
+	assert.Nil(t, repo.SaveUpload(ctx, *upload))
+
+	first, err := repo.FetchUpload(ctx, upload.ID)
+	assert.Nil(t, err)
+	second, err := repo.FetchUpload(ctx, upload.ID)
+	assert.Nil(t, err)
+
+	// Only the first writer moves the offset
+	first.Append(first.PartKey(0), 512)
+	assert.Nil(t, repo.SaveUpload(ctx, *first))
+	second.Append(second.PartKey(0), 512)
+	assert.True(t, errors.Is(repo.SaveUpload(ctx, *second), exception.EntityExists))
+
+	stored, err := repo.FetchUpload(ctx, upload.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(512), stored.Offset)
+
+	assert.Nil(t, repo.RemoveUpload(ctx, upload.ID))
+	_, err = repo.FetchUpload(ctx, upload.ID)
+	assert.Equal(t, exception.EntityNotFound, err)
+}
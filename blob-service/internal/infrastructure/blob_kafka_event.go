@@ -12,6 +12,7 @@ import (
 	"github.com/sony/gobreaker"
 	"go.opencensus.io/trace"
 	"gocloud.dev/pubsub"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -107,6 +108,9 @@ func (e *BlobKafkaEvent) Uploaded(ctx context.Context, blob domain.Blob, snapsho
 			"trace_id":        transaction.TraceID,
 			"operation":       transaction.Operation,
 			"snapshot":        transaction.Snapshot,
+			"content_type":    blob.ContentType,
+			"checksum":        blob.Checksum,
+			"size":            strconv.FormatInt(blob.Size, 10),
 			"tracing_context": event.TracingContext,
 			"service":         event.ServiceName,
 			"event_id":        event.ID,
@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.blob.access.media", "http://media:8080")
+	viper.SetDefault("alexandria.blob.access.timeout", "5s")
+}
+
+// BlobMediaAccess asks the media service whether the caller may see a media, only media blobs are checked
+type BlobMediaAccess struct {
+	client   *http.Client
+	mediaURL string
+	logger   log.Logger
+}
+
+// NewBlobMediaAccess returns a media access check using alexandria.blob.access.media as media HTTP API
+func NewBlobMediaAccess(logger log.Logger, _ *config.Kernel) *BlobMediaAccess {
+	return &BlobMediaAccess{
+		client:   &http.Client{Timeout: viper.GetDuration("alexandria.blob.access.timeout")},
+		mediaURL: strings.TrimSuffix(viper.GetString("alexandria.blob.access.media"), "/"),
+		logger:   logger,
+	}
+}
+
+// CanRead sends a HEAD request of the given media on behalf of the caller, access is refused if the media service
+// cannot be reached
+func (a *BlobMediaAccess) CanRead(ctx context.Context, service, rootID string) error {
+	if service != domain.Media {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, a.mediaURL+core.PublicAPI+"/media/"+rootID, nil)
+	if err != nil {
+		return err
+	}
+	if authorization := domain.CredentialsFromContext(ctx); authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	res, err := a.client.Do(req)
+	if err != nil {
+		_ = a.logger.Log("method", "blob.infrastructure.media_access.can_read", "err", err.Error())
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound, http.StatusUnauthorized, http.StatusForbidden:
+		return exception.EntityNotFound
+	default:
+		return fmt.Errorf("media service responded with status %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobMediaAccess_CanRead(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		switch {
+		case r.URL.Path == "/v1/media/private" && r.Header.Get("Authorization") == "Bearer owner":
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/v1/media/public":
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/v1/media/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	access := &BlobMediaAccess{client: srv.Client(), mediaURL: srv.URL, logger: log.NewNopLogger()}
+	ctx := context.Background()
+
+	assert.Nil(t, access.CanRead(ctx, domain.Media, "public"))
+	assert.True(t, errors.Is(access.CanRead(ctx, domain.Media, "private"), exception.EntityNotFound))
+	assert.Nil(t, access.CanRead(domain.WithCredentials(ctx, "Bearer owner"), domain.Media, "private"))
+	// Unavailable media services never grant access
+	assert.NotNil(t, access.CanRead(ctx, domain.Media, "broken"))
+	// Only media blobs are checked
+	assert.Nil(t, access.CanRead(ctx, domain.Author, "private"))
+}
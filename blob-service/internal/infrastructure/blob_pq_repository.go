@@ -0,0 +1,188 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/lib/pq"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"sync"
+)
+
+// BlobPQRepository Blob PostgreSQL repo implementation, upload sessions keep a revision to avoid concurrent writes
+type BlobPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+	mu     *sync.Mutex
+}
+
+func NewBlobPQRepository(db *sql.DB, logger log.Logger) *BlobPQRepository {
+	return &BlobPQRepository{
+		db:     db,
+		logger: logger,
+		mu:     new(sync.Mutex),
+	}
+}
+
+func (r *BlobPQRepository) Save(ctx context.Context, blobRef domain.Blob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "blob.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
+
+	// Replaces the blob like docstore's Put does
+	statement := `INSERT INTO alexa1.blobs(id, service, name, size, blob_type, extension, url, content_type, checksum,
+					create_time, update_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+					ON CONFLICT (id) DO UPDATE SET service = EXCLUDED.service, name = EXCLUDED.name, size = EXCLUDED.size,
+					blob_type = EXCLUDED.blob_type, extension = EXCLUDED.extension, url = EXCLUDED.url,
+					content_type = EXCLUDED.content_type, checksum = EXCLUDED.checksum,
+					create_time = EXCLUDED.create_time, update_time = EXCLUDED.update_time`
+
+	_, err = conn.ExecContext(ctx, statement, blobRef.ID, blobRef.Service, blobRef.Name, blobRef.Size, blobRef.BlobType,
+		blobRef.Extension, blobRef.Url, blobRef.ContentType, blobRef.Checksum, blobRef.CreateTime, blobRef.UpdateTime)
+	return err
+}
+
+func (r *BlobPQRepository) FetchByID(ctx context.Context, id string) (*domain.Blob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "blob.infrastructure.postgres.fetch_id", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT id, service, name, size, blob_type, extension, url, content_type, checksum, create_time,
+					update_time FROM alexa1.blobs WHERE id = $1`
+
+	b := new(domain.Blob)
+	err = conn.QueryRowContext(ctx, statement, id).Scan(&b.ID, &b.Service, &b.Name, &b.Size, &b.BlobType, &b.Extension,
+		&b.Url, &b.ContentType, &b.Checksum, &b.CreateTime, &b.UpdateTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.EntityNotFound
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (r *BlobPQRepository) Remove(ctx context.Context, id string) error {
+	return r.remove(ctx, `DELETE FROM alexa1.blobs WHERE id = $1`, id)
+}
+
+func (r *BlobPQRepository) SaveUpload(ctx context.Context, upload domain.Upload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "blob.infrastructure.postgres.save_upload", "db_connection", r.db.Stats().OpenConnections)
+
+	revision, ok := upload.Revision.(int64)
+	if !ok {
+		// New sessions do not hold a revision yet
+		statement := `INSERT INTO alexa1.blob_uploads(id, root_id, service, blob_type, extension, size, "offset", checksum,
+						parts, uploader_id, create_time, update_time, expire_time)
+						VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+		_, err = conn.ExecContext(ctx, statement, upload.ID, upload.RootID, upload.Service, upload.BlobType, upload.Extension,
+			upload.Size, upload.Offset, upload.Checksum, pq.Array(upload.Parts), upload.UploaderID, upload.CreateTime,
+			upload.UpdateTime, upload.ExpireTime)
+		return err
+	}
+
+	// Fetched sessions hold a revision, sessions modified since then are not stored
+	statement := `UPDATE alexa1.blob_uploads SET "offset" = $1, parts = $2, update_time = $3, revision = revision + 1
+					WHERE id = $4 AND revision = $5`
+
+	res, err := conn.ExecContext(ctx, statement, upload.Offset, pq.Array(upload.Parts), upload.UpdateTime, upload.ID,
+		revision)
+	if err != nil {
+		return err
+	}
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return exception.NewErrorDescription(exception.EntityExists,
+			fmt.Sprintf("upload %s was modified concurrently, fetch its offset and retry", upload.ID))
+	}
+
+	return nil
+}
+
+func (r *BlobPQRepository) FetchUpload(ctx context.Context, id string) (*domain.Upload, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "blob.infrastructure.postgres.fetch_upload", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT id, root_id, service, blob_type, extension, size, "offset", checksum, parts, uploader_id,
+					create_time, update_time, expire_time, revision FROM alexa1.blob_uploads WHERE id = $1`
+
+	u := new(domain.Upload)
+	parts := pq.StringArray{}
+	var revision int64
+	err = conn.QueryRowContext(ctx, statement, id).Scan(&u.ID, &u.RootID, &u.Service, &u.BlobType, &u.Extension, &u.Size,
+		&u.Offset, &u.Checksum, &parts, &u.UploaderID, &u.CreateTime, &u.UpdateTime, &u.ExpireTime, &revision)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.EntityNotFound
+		}
+		return nil, err
+	}
+	u.Parts = parts
+	u.Revision = revision
+
+	return u, nil
+}
+
+func (r *BlobPQRepository) RemoveUpload(ctx context.Context, id string) error {
+	return r.remove(ctx, `DELETE FROM alexa1.blob_uploads WHERE id = $1`, id)
+}
+
+func (r *BlobPQRepository) remove(ctx context.Context, statement, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "blob.infrastructure.postgres.remove", "db_connection", r.db.Stats().OpenConnections)
+
+	_, err = conn.ExecContext(ctx, statement, id)
+	return err
+}
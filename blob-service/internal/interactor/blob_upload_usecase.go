@@ -0,0 +1,165 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// CreateUpload starts a resumable upload session of the given blob, sessions belong to the caller
+func (u *Blob) CreateUpload(ctx context.Context, ag *domain.UploadAggregate) (*domain.Upload, error) {
+	size, err := strconv.ParseInt(ag.Size, 10, 64)
+	if err != nil {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "size", "int64/bigint"))
+	}
+
+	identity, _ := domain.IdentityFromContext(ctx)
+	upload, err := domain.NewUpload(ag.RootID, ag.Service, ag.BlobType, ag.Extension, size, ag.Checksum,
+		identity.Subject, domain.UploadTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err = u.repository.SaveUpload(ctxR, *upload); err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// GetUpload returns the given upload session, clients resume interrupted uploads from its offset
+func (u *Blob) GetUpload(ctx context.Context, id, rootID, service string) (*domain.Upload, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return u.fetchUpload(ctxR, id, rootID, service)
+}
+
+// WriteChunk appends a chunk to the given upload session, chunks are streamed to the storage and
+// must start at the session's offset
+func (u *Blob) WriteChunk(ctx context.Context, ag *domain.ChunkAggregate) (*domain.Upload, error) {
+	defer ag.Content.Close()
+
+	offset, err := strconv.ParseInt(ag.Offset, 10, 64)
+	if err != nil {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "offset", "int64/bigint"))
+	}
+	size, err := strconv.ParseInt(ag.Size, 10, 64)
+	if err != nil {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "size", "int64/bigint"))
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	upload, err := u.fetchUpload(ctxR, ag.UploadID, ag.RootID, ag.Service)
+	if err != nil {
+		return nil, err
+	}
+	if err = upload.CanAppend(offset, size); err != nil {
+		return nil, err
+	}
+
+	key := upload.PartKey(offset)
+	err = u.storage.StorePart(ctxR, key, upload.Service, size, ag.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Concurrent requests with the same offset store their chunks under different keys,
+	// the revision check lets a single request move the offset and the losers remove their chunk
+	upload.Append(key, size)
+	if err = u.repository.SaveUpload(ctxR, *upload); err != nil {
+		if errD := u.storage.Delete(ctxR, key, upload.Service); errD != nil && errD != exception.EntityNotFound {
+			_ = u.logger.Log("method", "blob.interactor.upload", "err", errD.Error())
+		}
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// CompleteUpload composes the received chunks into the blob, the blob is stored as if it was uploaded at once
+func (u *Blob) CompleteUpload(ctx context.Context, id, rootID, service string) (*domain.Blob, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	upload, err := u.fetchUpload(ctxR, id, rootID, service)
+	if err != nil {
+		return nil, err
+	}
+	if !upload.IsComplete() {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf("upload %s received %d of %d bytes", upload.ID, upload.Offset, upload.Size))
+	}
+
+	ref := domain.NewBlob(upload.RootID, upload.Service, upload.BlobType, upload.Extension, upload.Size)
+	ref.Checksum = upload.Checksum
+	blob, err := u.store(ctx, ref,
+		func(ctxS context.Context, blob *domain.Blob) error {
+			return u.storage.Compose(ctxS, blob, upload.Parts)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	u.removeUpload(ctxR, upload)
+	return blob, nil
+}
+
+// AbortUpload removes the given upload session and its received chunks
+func (u *Blob) AbortUpload(ctx context.Context, id, rootID, service string) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	upload, err := u.fetchUpload(ctxR, id, rootID, service)
+	if err != nil {
+		return err
+	}
+
+	u.removeUpload(ctxR, upload)
+	return nil
+}
+
+// fetchUpload returns the given session if it belongs to the caller and the given blob, expired sessions are removed
+func (u *Blob) fetchUpload(ctx context.Context, id, rootID, service string) (*domain.Upload, error) {
+	upload, err := u.repository.FetchUpload(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions of other callers or blobs are never exposed
+	identity, _ := domain.IdentityFromContext(ctx)
+	if upload.UploaderID != identity.Subject || upload.RootID != rootID ||
+		upload.Service != strings.ToLower(service) {
+		return nil, exception.EntityNotFound
+	}
+	if upload.IsExpired() {
+		u.removeUpload(ctx, upload)
+		return nil, exception.EntityNotFound
+	}
+
+	return upload, nil
+}
+
+// removeUpload removes the session and its chunks, leftovers are only logged since sessions expire anyway
+func (u *Blob) removeUpload(ctx context.Context, upload *domain.Upload) {
+	for _, part := range upload.Parts {
+		if err := u.storage.Delete(ctx, part, upload.Service); err != nil && err != exception.EntityNotFound {
+			_ = u.logger.Log("method", "blob.interactor.upload", "err", err.Error())
+		}
+	}
+
+	if err := u.repository.RemoveUpload(ctx, upload.ID); err != nil {
+		_ = u.logger.Log("method", "blob.interactor.upload", "err", err.Error())
+	}
+}
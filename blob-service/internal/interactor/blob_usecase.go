@@ -16,46 +16,64 @@ type Blob struct {
 	repository domain.BlobRepository
 	storage    domain.BlobStorage
 	eventBus   domain.BlobEvent
+	access     domain.BlobAccess
 }
 
-func NewBlob(logger log.Logger, repo domain.BlobRepository, storage domain.BlobStorage, eventBus domain.BlobEvent) *Blob {
+func NewBlob(logger log.Logger, repo domain.BlobRepository, storage domain.BlobStorage, eventBus domain.BlobEvent,
+	access domain.BlobAccess) *Blob {
 	return &Blob{
 		logger:     logger,
 		repository: repo,
 		storage:    storage,
 		eventBus:   eventBus,
+		access:     access,
 	}
 }
 
 func (u *Blob) Store(ctx context.Context, ag *domain.BlobAggregate) (*domain.Blob, error) {
+	defer ag.Content.Close()
+
 	size, err := strconv.ParseInt(ag.Size, 10, 64)
 	if err != nil {
 		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
 			fmt.Sprintf(exception.InvalidFieldFormatString, "size", "int64/bigint"))
 	}
 
+	blob := domain.NewBlob(ag.RootID, ag.Service, ag.BlobType, ag.Extension, size)
+	blob.Checksum = strings.ToLower(ag.Checksum)
+	return u.store(ctx, blob,
+		func(ctxR context.Context, blob *domain.Blob) error {
+			// Content is streamed straight to the storage
+			blob.Content = ag.Content
+			return u.storage.Store(ctxR, blob)
+		})
+}
+
+// store writes the given blob's content using write, then persists the blob and propagates the upload
+func (u *Blob) store(ctx context.Context, ref *domain.Blob, write func(context.Context, *domain.Blob) error) (*domain.Blob, error) {
+	err := ref.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	rootID := strings.TrimPrefix(ref.ID, domain.GetServiceID(ref.Service))
+
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	operationKind := "update"
-	prefID := domain.GetServiceID(ag.Service) + ag.RootID
-	blob, err := u.repository.FetchByID(ctxR, prefID)
-	snapshot := blob
+	prefID := ref.ID
+	blob := ref
+	snapshot, err := u.repository.FetchByID(ctxR, prefID)
 	if err != nil {
 		// If not exists, create new blob entity
-		blob = domain.NewBlob(ag.RootID, ag.Service, ag.BlobType, ag.Extension, size)
-		err = blob.IsValid()
-		if err != nil {
-			return nil, err
-		}
 		operationKind = "create"
+	} else {
+		// Keep the original blob's creation, everything else comes from the new content
+		blob.CreateTime = snapshot.CreateTime
 	}
 
-	blob.Content = ag.Content
-	defer blob.Content.Close()
-
 	// Storage is our priority
-	err = u.storage.Store(ctxR, blob)
+	err = write(ctxR, blob)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +105,7 @@ func (u *Blob) Store(ctx context.Context, ag *domain.BlobAggregate) (*domain.Blo
 	// avoid using service_id prefix when returning to client
 	// since it's just an internal implementation.
 	// It's more semantic if we keep using root_id as default ID
-	blob.ID = ag.RootID
+	blob.ID = rootID
 
 	// Start transaction
 	errC := make(chan error)
@@ -123,6 +141,13 @@ func (u *Blob) Get(ctx context.Context, id, service string) (*domain.Blob, error
 
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
+
+	// Content URLs of hidden root entities (e.g. private media) are never handed out
+	err := u.access.CanRead(ctxR, strings.ToLower(service), id)
+	if err != nil {
+		return nil, err
+	}
+
 	blob, err := u.repository.FetchByID(ctxR, prefID)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,8 @@ package interactor
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"github.com/alexandria-oss/core/exception"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 	"github.com/maestre3d/alexandria/blob-service/internal/domain"
@@ -58,3 +60,26 @@ func (u *BlobSAGA) Failed(ctx context.Context, rootID, service string, snapshotJ
 
 	return nil
 }
+
+// Cleanup removes the blob of a permanently removed aggregate, aggregates without blob are ignored
+// hence redelivered events are no-ops
+func (u *BlobSAGA) Cleanup(ctx context.Context, rootID, service string) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	prefID := domain.GetServiceID(service) + rootID
+	blob, err := u.repo.FetchByID(ctxR, prefID)
+	if err != nil {
+		if errors.Is(err, exception.EntityNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	err = u.storage.Delete(ctxR, blob.Name, blob.Service)
+	if err != nil && !errors.Is(err, exception.EntityNotFound) {
+		return err
+	}
+
+	return u.repo.Remove(ctxR, prefID)
+}
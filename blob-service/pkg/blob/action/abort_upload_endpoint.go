@@ -0,0 +1,46 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type AbortUploadRequest struct {
+	UploadID string `json:"upload_id"`
+	RootID   string `json:"root_id"`
+	Service  string `json:"service"`
+}
+
+type AbortUploadResponse struct {
+	Err error `json:"-"`
+}
+
+func MakeAbortUploadEndpoint(svc usecase.BlobInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AbortUploadRequest)
+		err := svc.AbortUpload(ctx, req.UploadID, req.RootID, req.Service)
+		return AbortUploadResponse{Err: err}, nil
+	}
+
+	action := "abort_upload"
+	ep = middleware.WrapResiliency(ep, "blob", action)
+	return middleware.WrapInstrumentation(ep, "blob", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+var (
+	_ endpoint.Failer = AbortUploadResponse{}
+)
+
+func (r AbortUploadResponse) Failed() error { return r.Err }
@@ -0,0 +1,58 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type CompleteUploadRequest struct {
+	UploadID string `json:"upload_id"`
+	RootID   string `json:"root_id"`
+	Service  string `json:"service"`
+}
+
+type CompleteUploadResponse struct {
+	Blob *domain.Blob `json:"blob"`
+	Err  error        `json:"-"`
+}
+
+func MakeCompleteUploadEndpoint(svc usecase.BlobInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CompleteUploadRequest)
+		blob, err := svc.CompleteUpload(ctx, req.UploadID, req.RootID, req.Service)
+		if err != nil {
+			return CompleteUploadResponse{
+				Blob: nil,
+				Err:  err,
+			}, nil
+		}
+
+		return CompleteUploadResponse{
+			Blob: blob,
+			Err:  nil,
+		}, nil
+	}
+
+	action := "complete_upload"
+	ep = middleware.WrapResiliency(ep, "blob", action)
+	return middleware.WrapInstrumentation(ep, "blob", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+var (
+	_ endpoint.Failer = CompleteUploadResponse{}
+)
+
+func (r CompleteUploadResponse) Failed() error { return r.Err }
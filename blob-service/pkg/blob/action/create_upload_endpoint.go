@@ -0,0 +1,68 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type CreateUploadRequest struct {
+	RootID    string `json:"root_id"`
+	Service   string `json:"service"`
+	BlobType  string `json:"blob_type"`
+	Extension string `json:"extension"`
+	Size      string `json:"size"`
+	Checksum  string `json:"checksum"`
+}
+
+type CreateUploadResponse struct {
+	Upload *domain.Upload `json:"upload"`
+	Err    error          `json:"-"`
+}
+
+func MakeCreateUploadEndpoint(svc usecase.BlobInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateUploadRequest)
+		upload, err := svc.CreateUpload(ctx, &domain.UploadAggregate{
+			RootID:    req.RootID,
+			Service:   req.Service,
+			BlobType:  req.BlobType,
+			Extension: req.Extension,
+			Size:      req.Size,
+			Checksum:  req.Checksum,
+		})
+		if err != nil {
+			return CreateUploadResponse{
+				Upload: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return CreateUploadResponse{
+			Upload: upload,
+			Err:    nil,
+		}, nil
+	}
+
+	action := "create_upload"
+	ep = middleware.WrapResiliency(ep, "blob", action)
+	return middleware.WrapInstrumentation(ep, "blob", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+var (
+	_ endpoint.Failer = CreateUploadResponse{}
+)
+
+func (r CreateUploadResponse) Failed() error { return r.Err }
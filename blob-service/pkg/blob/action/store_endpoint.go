@@ -18,6 +18,7 @@ type StoreRequest struct {
 	BlobType  string      `json:"blob_type"`
 	Extension string      `json:"extension"`
 	Size      string      `json:"size"`
+	Checksum  string      `json:"checksum"`
 	Content   domain.File `json:"content"`
 }
 
@@ -36,6 +37,7 @@ func MakeStoreBlobEndpoint(svc usecase.BlobInteractor, logger log.Logger, durati
 			BlobType:  req.BlobType,
 			Extension: req.Extension,
 			Size:      req.Size,
+			Checksum:  req.Checksum,
 			Content:   req.Content,
 		})
 		if err != nil {
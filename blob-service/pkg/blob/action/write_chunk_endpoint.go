@@ -0,0 +1,68 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type WriteChunkRequest struct {
+	UploadID string      `json:"upload_id"`
+	RootID   string      `json:"root_id"`
+	Service  string      `json:"service"`
+	Offset   string      `json:"offset"`
+	Size     string      `json:"size"`
+	Content  domain.File `json:"content"`
+}
+
+type WriteChunkResponse struct {
+	Upload *domain.Upload `json:"upload"`
+	Err    error          `json:"-"`
+}
+
+func MakeWriteChunkEndpoint(svc usecase.BlobInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(WriteChunkRequest)
+		upload, err := svc.WriteChunk(ctx, &domain.ChunkAggregate{
+			UploadID: req.UploadID,
+			RootID:   req.RootID,
+			Service:  req.Service,
+			Offset:   req.Offset,
+			Size:     req.Size,
+			Content:  req.Content,
+		})
+		if err != nil {
+			return WriteChunkResponse{
+				Upload: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return WriteChunkResponse{
+			Upload: upload,
+			Err:    nil,
+		}, nil
+	}
+
+	// Chunks skip the rate limiter, a single upload sends many chunks per second
+	action := "write_chunk"
+	return middleware.WrapInstrumentation(ep, "blob", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+var (
+	_ endpoint.Failer = WriteChunkResponse{}
+)
+
+func (r WriteChunkResponse) Failed() error { return r.Err }
@@ -59,6 +59,82 @@ func (mw LoggingBlobMiddleware) Delete(ctx context.Context, id, service string)
 	return
 }
 
+func (mw LoggingBlobMiddleware) CreateUpload(ctx context.Context, aggregate *domain.UploadAggregate) (output *domain.Upload, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "blob.create_upload",
+			"input", fmt.Sprintf("%+v", aggregate),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.CreateUpload(ctx, aggregate)
+	return
+}
+
+func (mw LoggingBlobMiddleware) GetUpload(ctx context.Context, id, rootID, service string) (output *domain.Upload, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "blob.get_upload",
+			"input", fmt.Sprintf("upload_id: %s, root_id: %s, service: %s", id, rootID, service),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.GetUpload(ctx, id, rootID, service)
+	return
+}
+
+func (mw LoggingBlobMiddleware) WriteChunk(ctx context.Context, aggregate *domain.ChunkAggregate) (output *domain.Upload, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "blob.write_chunk",
+			"input", fmt.Sprintf("upload_id: %s, root_id: %s, service: %s, offset: %s, size: %s", aggregate.UploadID,
+				aggregate.RootID, aggregate.Service, aggregate.Offset, aggregate.Size),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.WriteChunk(ctx, aggregate)
+	return
+}
+
+func (mw LoggingBlobMiddleware) CompleteUpload(ctx context.Context, id, rootID, service string) (output *domain.Blob, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "blob.complete_upload",
+			"input", fmt.Sprintf("upload_id: %s, root_id: %s, service: %s", id, rootID, service),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.CompleteUpload(ctx, id, rootID, service)
+	return
+}
+
+func (mw LoggingBlobMiddleware) AbortUpload(ctx context.Context, id, rootID, service string) (err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "blob.abort_upload",
+			"input", fmt.Sprintf("upload_id: %s, root_id: %s, service: %s", id, rootID, service),
+			"output", "",
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.AbortUpload(ctx, id, rootID, service)
+	return
+}
+
 type LoggingBlobSagaMiddleware struct {
 	Logger log.Logger
 	Next   usecase.BlobSagaInteractor
@@ -78,3 +154,18 @@ func (mw LoggingBlobSagaMiddleware) Failed(ctx context.Context, rootID, service
 	err = mw.Next.Failed(ctx, rootID, service, snapshotJSON)
 	return
 }
+
+func (mw LoggingBlobSagaMiddleware) Cleanup(ctx context.Context, rootID, service string) (err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "blob.saga.cleanup",
+			"input", fmt.Sprintf("root_id: %s, service: %s", rootID, service),
+			"output", "",
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Cleanup(ctx, rootID, service)
+	return
+}
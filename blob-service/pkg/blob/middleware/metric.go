@@ -48,6 +48,61 @@ func (mw MetricBlobMiddleware) Delete(ctx context.Context, id, service string) (
 	return
 }
 
+func (mw MetricBlobMiddleware) CreateUpload(ctx context.Context, aggregate *domain.UploadAggregate) (output *domain.Upload, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "blob.create_upload", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.CreateUpload(ctx, aggregate)
+	return
+}
+
+func (mw MetricBlobMiddleware) GetUpload(ctx context.Context, id, rootID, service string) (output *domain.Upload, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "blob.get_upload", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.GetUpload(ctx, id, rootID, service)
+	return
+}
+
+func (mw MetricBlobMiddleware) WriteChunk(ctx context.Context, aggregate *domain.ChunkAggregate) (output *domain.Upload, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "blob.write_chunk", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.WriteChunk(ctx, aggregate)
+	return
+}
+
+func (mw MetricBlobMiddleware) CompleteUpload(ctx context.Context, id, rootID, service string) (output *domain.Blob, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "blob.complete_upload", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.CompleteUpload(ctx, id, rootID, service)
+	return
+}
+
+func (mw MetricBlobMiddleware) AbortUpload(ctx context.Context, id, rootID, service string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "blob.abort_upload", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.AbortUpload(ctx, id, rootID, service)
+	return
+}
+
 type MetricBlobSagaMiddleware struct {
 	RequestCount   metrics.Counter
 	RequestLatency metrics.Histogram
@@ -64,3 +119,14 @@ func (mw MetricBlobSagaMiddleware) Failed(ctx context.Context, rootID, service s
 	err = mw.Next.Failed(ctx, rootID, service, snapshotJSON)
 	return
 }
+
+func (mw MetricBlobSagaMiddleware) Cleanup(ctx context.Context, rootID, service string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "blob.saga.cleanup", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Cleanup(ctx, rootID, service)
+	return
+}
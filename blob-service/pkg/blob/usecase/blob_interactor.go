@@ -9,8 +9,14 @@ type BlobInteractor interface {
 	Store(ctx context.Context, ag *domain.BlobAggregate) (*domain.Blob, error)
 	Get(ctx context.Context, id, service string) (*domain.Blob, error)
 	Delete(ctx context.Context, id, service string) error
+	CreateUpload(ctx context.Context, ag *domain.UploadAggregate) (*domain.Upload, error)
+	GetUpload(ctx context.Context, id, rootID, service string) (*domain.Upload, error)
+	WriteChunk(ctx context.Context, ag *domain.ChunkAggregate) (*domain.Upload, error)
+	CompleteUpload(ctx context.Context, id, rootID, service string) (*domain.Blob, error)
+	AbortUpload(ctx context.Context, id, rootID, service string) error
 }
 
 type BlobSagaInteractor interface {
 	Failed(ctx context.Context, rootID, service string, snapshotJSON []byte) error
+	Cleanup(ctx context.Context, rootID, service string) error
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/maestre3d/alexandria/blob-service/pkg/blob"
 	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
 	"github.com/maestre3d/alexandria/blob-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/shared/auth"
 	"github.com/openzipkin/zipkin-go"
 	"github.com/openzipkin/zipkin-go/model"
 	"github.com/openzipkin/zipkin-go/reporter"
@@ -43,6 +44,7 @@ var httpProxySet = wire.NewSet(
 	config.NewKernel,
 	zipkinSet,
 	tracer.WrapZipkinOpenTracing,
+	auth.NewAuthenticator,
 	bind.NewBlobHandler,
 	provideHTTPHandlers,
 	proxy.NewHTTP,
@@ -77,9 +79,9 @@ func provideBlobSagaInteractor(log log.Logger) (usecase.BlobSagaInteractor, func
 }
 
 // Bind/Map used http handlers
-func provideHTTPHandlers(blobHandler *bind.BlobHandler) []proxy.Handler {
+func provideHTTPHandlers(blobHandler *bind.BlobHandler, authenticator *auth.Authenticator) []proxy.Handler {
 	handlers := make([]proxy.Handler, 0)
-	handlers = append(handlers, blobHandler)
+	handlers = append(handlers, blobHandler, authenticator)
 	return handlers
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/maestre3d/alexandria/blob-service/pkg/blob"
 	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
 	"github.com/maestre3d/alexandria/blob-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/shared/auth"
 	"github.com/openzipkin/zipkin-go"
 	"github.com/openzipkin/zipkin-go/model"
 	"github.com/openzipkin/zipkin-go/reporter"
@@ -48,7 +49,14 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	zipkinTracer := provideZipkinTracer(kernel, reporter, endpoint)
 	opentracingTracer := tracer.WrapZipkinOpenTracing(kernel, zipkinTracer)
 	blobHandler := bind.NewBlobHandler(blobInteractor, logLogger, opentracingTracer, zipkinTracer)
-	v2 := provideHTTPHandlers(blobHandler)
+	authenticator, err := auth.NewAuthenticator(logLogger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	v2 := provideHTTPHandlers(blobHandler, authenticator)
 	http, cleanup4 := proxy.NewHTTP(kernel, v2...)
 	blobSagaInteractor, cleanup5, err := provideBlobSagaInteractor(logLogger)
 	if err != nil {
@@ -96,7 +104,7 @@ var zipkinSet = wire.NewSet(
 )
 
 var httpProxySet = wire.NewSet(
-	interactorSet, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, bind.NewBlobHandler, provideHTTPHandlers, proxy.NewHTTP,
+	interactorSet, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, auth.NewAuthenticator, bind.NewBlobHandler, provideHTTPHandlers, proxy.NewHTTP,
 )
 
 var eventProxySet = wire.NewSet(bind.NewBlobEventConsumer, provideEventConsumers, proxy.NewEvent)
@@ -124,9 +132,9 @@ func provideBlobSagaInteractor(log2 log.Logger) (usecase.BlobSagaInteractor, fun
 }
 
 // Bind/Map used http handlers
-func provideHTTPHandlers(blobHandler *bind.BlobHandler) []proxy.Handler {
+func provideHTTPHandlers(blobHandler *bind.BlobHandler, authenticator *auth.Authenticator) []proxy.Handler {
 	handlers := make([]proxy.Handler, 0)
-	handlers = append(handlers, blobHandler)
+	handlers = append(handlers, blobHandler, authenticator)
 	return handlers
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/maestre3d/alexandria/blob-service/pkg/blob/usecase"
 	"github.com/sony/gobreaker"
 	"go.opencensus.io/trace"
+	"strings"
 	"time"
 )
 
@@ -74,7 +75,25 @@ func (c *BlobEventConsumer) SetBinders(s *eventbus.Server, ctx context.Context,
 		return err
 	}
 
+	authorC, err := c.bindHardRemoved(ctxC, service, domain.AuthorHardRemoved, domain.Author)
+	if err != nil {
+		return err
+	}
+
+	mediaC, err := c.bindHardRemoved(ctxC, service, domain.MediaHardRemoved, domain.Media)
+	if err != nil {
+		return err
+	}
+
+	userC, err := c.bindHardRemoved(ctxC, service, domain.UserHardRemoved, domain.User)
+	if err != nil {
+		return err
+	}
+
 	s.AddConsumer(failedC)
+	s.AddConsumer(authorC)
+	s.AddConsumer(mediaC)
+	s.AddConsumer(userC)
 	return nil
 }
 
@@ -98,6 +117,30 @@ func (c *BlobEventConsumer) bindBlobFailed(ctx context.Context, service string)
 	return consumer.(*eventbus.Consumer), nil
 }
 
+// bindHardRemoved cleans up the blob of the aggregate permanently removed by the given event, event's body is
+// the aggregate id
+func (c *BlobEventConsumer) bindHardRemoved(ctx context.Context, service, event, rootService string) (*eventbus.Consumer, error) {
+	consumer, err := c.defaultCircuitBreaker(strings.ToLower(event)).Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, event)
+		if err != nil {
+			return nil, err
+		}
+
+		return &eventbus.Consumer{
+			MaxHandler: 10,
+			Consumer:   sub,
+			Handler: func(r *eventbus.Request) {
+				c.onHardRemoved(r, event, rootService)
+			},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return consumer.(*eventbus.Consumer), nil
+}
+
 func (c *BlobEventConsumer) onBlobFailed(r *eventbus.Request) {
 	eC := extractContext(r)
 
@@ -135,3 +178,41 @@ func (c *BlobEventConsumer) onBlobFailed(r *eventbus.Request) {
 
 	r.Message.Ack()
 }
+
+func (c *BlobEventConsumer) onHardRemoved(r *eventbus.Request, event, rootService string) {
+	eC := extractContext(r)
+
+	// Get span context from message
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(r.Message.Metadata["tracing_context"]), &traceCtx)
+	if err != nil {
+		// If span is not valid, then create one from our current context
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	// Start a new span with the parent span
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, "blob: "+rootService+"_hard_removed", traceCtx)
+	defer span.End()
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", event))
+
+	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), eC)
+	err = c.svc.Cleanup(ctxU, string(eC.Event.Content), rootService)
+	if err != nil {
+		_ = level.Error(c.logger).Log("err", err)
+		// If internal error, do nack
+		if code := httputil.ErrorToCode(err); code == 500 {
+			if r.Message.Nackable() {
+				r.Message.Nack()
+				return
+			}
+		}
+	}
+
+	r.Message.Ack()
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/alexandria-oss/core/exception"
 	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	kitoc "github.com/go-kit/kit/tracing/opencensus"
@@ -84,14 +85,17 @@ func (h *BlobHandler) SetRoutes(public, private, _ *mux.Router) {
 
 	mediaP.Path("/{id}").Methods(http.MethodPost).Handler(h.Store())
 	mediaP.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
+	h.setUploadRoutes(mediaP)
 	mediaP.Use(mux.CORSMethodMiddleware(mediaP))
 
 	authorP.Path("/{id}").Methods(http.MethodPost).Handler(h.Store())
 	authorP.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
+	h.setUploadRoutes(authorP)
 	authorP.Use(mux.CORSMethodMiddleware(authorP))
 
 	userP.Path("/{id}").Methods(http.MethodPost).Handler(h.Store())
 	userP.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
+	h.setUploadRoutes(userP)
 	userP.Use(mux.CORSMethodMiddleware(userP))
 
 	// Public routing
@@ -112,6 +116,15 @@ func (h *BlobHandler) SetRoutes(public, private, _ *mux.Router) {
 	userR.Use(mux.CORSMethodMiddleware(userR))
 }
 
+// setUploadRoutes sets the resumable upload routes, chunks are sent using PUT with their offset
+func (h *BlobHandler) setUploadRoutes(r *mux.Router) {
+	r.Path("/{id}/upload").Methods(http.MethodPost).Handler(h.CreateUpload())
+	r.Path("/{id}/upload/{upload_id}").Methods(http.MethodGet).Handler(h.GetUpload())
+	r.Path("/{id}/upload/{upload_id}").Methods(http.MethodPut).Handler(h.WriteChunk())
+	r.Path("/{id}/upload/{upload_id}").Methods(http.MethodDelete).Handler(h.AbortUpload())
+	r.Path("/{id}/upload/{upload_id}/complete").Methods(http.MethodPost).Handler(h.CompleteUpload())
+}
+
 func (h *BlobHandler) Store() *httptransport.Server {
 	return httptransport.NewServer(
 		action.MakeStoreBlobEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
@@ -126,7 +139,8 @@ func (h *BlobHandler) Get() *httptransport.Server {
 		action.MakeGetBlobEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
 		decodeGetRequest,
 		encodeGetResponse,
-		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger)))...,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger),
+			credentialsHTTPToContext))...,
 	)
 }
 
@@ -139,42 +153,199 @@ func (h *BlobHandler) Delete() *httptransport.Server {
 	)
 }
 
+func (h *BlobHandler) CreateUpload() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeCreateUploadEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeCreateUploadRequest,
+		encodeUploadResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "CreateUpload", h.logger)))...,
+	)
+}
+
+func (h *BlobHandler) GetUpload() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeGetUploadEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeGetUploadRequest,
+		encodeUploadResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "GetUpload", h.logger)))...,
+	)
+}
+
+func (h *BlobHandler) WriteChunk() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeWriteChunkEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeWriteChunkRequest,
+		encodeUploadResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "WriteChunk", h.logger)))...,
+	)
+}
+
+func (h *BlobHandler) CompleteUpload() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeCompleteUploadEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeCompleteUploadRequest,
+		encodeUploadResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "CompleteUpload", h.logger)))...,
+	)
+}
+
+func (h *BlobHandler) AbortUpload() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeAbortUploadEndpoint(h.svc, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeAbortUploadRequest,
+		encodeUploadResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "AbortUpload", h.logger)))...,
+	)
+}
+
 /* Decoders */
 
+// maxBlobSize largest accepted blob, go's file.size is in bytes
+var maxBlobSize = 8192 * (int64(math.Pow(1024, 2)))
+
+// decodeStoreRequest accepts multipart forms (file field) and raw bodies, raw bodies are streamed straight
+// to the storage using the request's Content-Type and Content-Length
 func decodeStoreRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		return decodeStreamStoreRequest(r)
+	}
+
+	// Files larger than the form's memory are kept in temporary files by the form parser
 	f, h, err := r.FormFile("file")
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	contentSlice := strings.Split(h.Header.Get("Content-Type"), "/")
-	if len(contentSlice) <= 1 {
-		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
-			fmt.Sprintf(exception.InvalidFieldFormatString,
-				"file", "invalid file extension"))
+	blobType, extension, err := parseContentType(h.Header.Get("Content-Type"))
+	if err != nil {
+		_ = f.Close()
+		return nil, err
 	}
 
-	// go's file.size is in bytes
-	var maxSize int64
-	maxSize = 8192 * (int64(math.Pow(1024, 2)))
-
-	if h.Size > maxSize {
+	if h.Size > maxBlobSize {
+		_ = f.Close()
 		return nil, exception.NewErrorDescription(exception.InvalidFieldRange,
 			fmt.Sprintf(exception.InvalidFieldRangeString,
 				"file", "1 B", "8 GB"))
 	}
 
+	checksum, err := parseContentDigest(h.Header.Get("Content-Digest"))
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	// File is closed by the interactor once stored
 	return action.StoreRequest{
 		RootID:    mux.Vars(r)["id"],
 		Service:   getServiceFromPath(r.URL.Path),
-		BlobType:  contentSlice[0],
-		Extension: contentSlice[1],
-		Size:      strconv.Itoa(int(h.Size)),
+		BlobType:  blobType,
+		Extension: extension,
+		Size:      strconv.FormatInt(h.Size, 10),
+		Checksum:  checksum,
 		Content:   f,
 	}, nil
 }
 
+func decodeStreamStoreRequest(r *http.Request) (interface{}, error) {
+	blobType, extension, err := parseContentType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := parseContentLength(r, maxBlobSize)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := parseContentDigest(r.Header.Get("Content-Digest"))
+	if err != nil {
+		return nil, err
+	}
+
+	return action.StoreRequest{
+		RootID:    mux.Vars(r)["id"],
+		Service:   getServiceFromPath(r.URL.Path),
+		BlobType:  blobType,
+		Extension: extension,
+		Size:      size,
+		Checksum:  checksum,
+		Content:   r.Body,
+	}, nil
+}
+
+func decodeCreateUploadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	// Content type of the whole blob, chunks are sent as application/octet-stream
+	body := struct {
+		ContentType string `json:"content_type"`
+		Size        string `json:"size"`
+		// Checksum SHA-256 (hex) of the whole blob, optional
+		Checksum string `json:"checksum"`
+	}{}
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, exception.EmptyBody
+		}
+	} else {
+		body.ContentType, body.Size, body.Checksum = r.FormValue("content_type"), r.FormValue("size"),
+			r.FormValue("checksum")
+	}
+
+	blobType, extension, err := parseContentType(body.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return action.CreateUploadRequest{
+		RootID:    mux.Vars(r)["id"],
+		Service:   getServiceFromPath(r.URL.Path),
+		BlobType:  blobType,
+		Extension: extension,
+		Size:      body.Size,
+		Checksum:  body.Checksum,
+	}, nil
+}
+
+func decodeGetUploadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.GetUploadRequest{
+		UploadID: mux.Vars(r)["upload_id"],
+		RootID:   mux.Vars(r)["id"],
+		Service:  getServiceFromPath(r.URL.Path),
+	}, nil
+}
+
+func decodeWriteChunkRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	size, err := parseContentLength(r, maxBlobSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return action.WriteChunkRequest{
+		UploadID: mux.Vars(r)["upload_id"],
+		RootID:   mux.Vars(r)["id"],
+		Service:  getServiceFromPath(r.URL.Path),
+		Offset:   r.URL.Query().Get("offset"),
+		Size:     size,
+		Content:  r.Body,
+	}, nil
+}
+
+func decodeCompleteUploadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.CompleteUploadRequest{
+		UploadID: mux.Vars(r)["upload_id"],
+		RootID:   mux.Vars(r)["id"],
+		Service:  getServiceFromPath(r.URL.Path),
+	}, nil
+}
+
+func decodeAbortUploadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.AbortUploadRequest{
+		UploadID: mux.Vars(r)["upload_id"],
+		RootID:   mux.Vars(r)["id"],
+		Service:  getServiceFromPath(r.URL.Path),
+	}, nil
+}
+
 func decodeGetRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	return action.GetRequest{
 		ID:      mux.Vars(r)["id"],
@@ -229,3 +400,16 @@ func encodeDeleteResponse(ctx context.Context, w http.ResponseWriter, res interf
 
 	return json.NewEncoder(w).Encode(struct{}{})
 }
+
+func encodeUploadResponse(ctx context.Context, w http.ResponseWriter, res interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if f, ok := res.(endpoint.Failer); ok && f.Failed() != nil {
+		httputil.ResponseErrJSON(ctx, f.Failed(), w)
+		return nil
+	}
+	if _, ok := res.(action.AbortUploadResponse); ok {
+		return json.NewEncoder(w).Encode(struct{}{})
+	}
+
+	return json.NewEncoder(w).Encode(res)
+}
@@ -1,12 +1,83 @@
 package bind
 
-import "strings"
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/blob-service/internal/domain"
+	"net/http"
+	"strconv"
+	"strings"
+)
 
+// credentialsHTTPToContext propagates the caller's Authorization header, public routes never verify it
+func credentialsHTTPToContext(ctx context.Context, r *http.Request) context.Context {
+	return domain.WithCredentials(ctx, r.Header.Get("Authorization"))
+}
+
+// getServiceFromPath returns the service segment following blob (e.g. /v1/blob/media/{id}/upload)
 func getServiceFromPath(url string) string {
 	x := strings.Split(url, "/")
-	if len(x) >= 2 {
-		return x[len(x)-2]
+	for i, segment := range x {
+		if segment == "blob" && i+1 < len(x) {
+			return x[i+1]
+		}
 	}
 
 	return ""
 }
+
+// parseContentType splits an IANA MIME type (e.g. video/mp4) into blob type and extension
+func parseContentType(contentType string) (string, string, error) {
+	// Drop parameters (e.g. charset)
+	contentSlice := strings.Split(strings.TrimSpace(strings.Split(contentType, ";")[0]), "/")
+	if len(contentSlice) <= 1 || contentSlice[0] == "" || contentSlice[1] == "" {
+		return "", "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString,
+				"file", "invalid file extension"))
+	}
+
+	return contentSlice[0], contentSlice[1], nil
+}
+
+// parseContentLength returns the request's body size, streamed bodies must declare their size
+func parseContentLength(r *http.Request, maxSize int64) (string, error) {
+	if r.ContentLength <= 0 {
+		return "", exception.NewErrorDescription(exception.RequiredField,
+			fmt.Sprintf(exception.RequiredFieldString, "content_length"))
+	}
+	if r.ContentLength > maxSize {
+		return "", exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf(exception.InvalidFieldRangeString,
+				"file", "1 B", "8 GB"))
+	}
+
+	return strconv.FormatInt(r.ContentLength, 10), nil
+}
+
+// parseContentDigest returns the SHA-256 (hex) of the given Content-Digest (sha-256=:base64:) or
+// Digest (SHA-256=base64) header, empty headers are optional
+func parseContentDigest(header string) (string, error) {
+	if strings.TrimSpace(header) == "" {
+		return "", nil
+	}
+
+	for _, digest := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(digest), "=", 2)
+		if len(kv) != 2 || strings.ToLower(kv[0]) != "sha-256" {
+			continue
+		}
+
+		sum, err := base64.StdEncoding.DecodeString(strings.Trim(kv[1], ":"))
+		if err != nil || len(sum) != sha256.Size {
+			break
+		}
+		return hex.EncodeToString(sum), nil
+	}
+
+	return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+		fmt.Sprintf(exception.InvalidFieldFormatString, "content_digest", "sha-256=:base64:"))
+}
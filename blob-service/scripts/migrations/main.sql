@@ -0,0 +1,53 @@
+/******************************
+**	File:   main.sql
+**	Name:	Database migrations scripts
+**	Desc:	Blob service migrations scripts, only required if
+**			alexandria.persistence.blob.repository is postgres
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2020-06-22
+*******************************/
+
+CREATE DATABASE 'alexandria/blob';
+SET search_path TO 'alexandria/blob';
+
+CREATE SCHEMA IF NOT EXISTS alexa1;
+
+-- Blob entity, id is prefixed by its service id
+CREATE TABLE IF NOT EXISTS alexa1.blobs(
+    id              varchar(128) NOT NULL PRIMARY KEY,
+    service         varchar(16) NOT NULL,
+    name            varchar(512) NOT NULL,
+    size            bigint NOT NULL,
+    blob_type       varchar(128) NOT NULL,
+    extension       varchar(8) NOT NULL,
+    url             varchar(2048) NOT NULL DEFAULT '',
+    -- Sniffed content type and SHA-256 (hex) of the content
+    content_type    varchar(128) NOT NULL DEFAULT '',
+    checksum        varchar(64) NOT NULL DEFAULT '',
+    -- Unix timestamps
+    create_time     bigint NOT NULL,
+    update_time     bigint NOT NULL
+);
+
+-- Resumable upload sessions, revision avoids concurrent chunks overriding each other
+CREATE TABLE IF NOT EXISTS alexa1.blob_uploads(
+    id              varchar(128) NOT NULL PRIMARY KEY,
+    root_id         varchar(128) NOT NULL,
+    service         varchar(16) NOT NULL,
+    blob_type       varchar(128) NOT NULL,
+    extension       varchar(8) NOT NULL,
+    size            bigint NOT NULL,
+    "offset"        bigint NOT NULL DEFAULT 0,
+    -- Expected SHA-256 (hex) of the blob, optional
+    checksum        varchar(64) NOT NULL DEFAULT '',
+    parts           text[] NOT NULL DEFAULT '{}',
+    uploader_id     varchar(128) NOT NULL,
+    create_time     bigint NOT NULL,
+    update_time     bigint NOT NULL,
+    expire_time     bigint NOT NULL,
+    revision        bigint NOT NULL DEFAULT 0
+);
+
+-- Expired sessions are removed once accessed, leftovers may be removed periodically, e.g.
+-- DELETE FROM alexa1.blob_uploads WHERE expire_time < extract(epoch FROM now());
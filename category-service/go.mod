@@ -16,6 +16,7 @@ require (
 	github.com/google/wire v0.3.0
 	github.com/gorilla/handlers v1.4.2
 	github.com/gorilla/mux v1.7.3
+	github.com/maestre3d/alexandria/shared v0.0.0
 	github.com/matoous/go-nanoid v1.4.1
 	github.com/oklog/run v1.1.0 // indirect
 	github.com/openzipkin/zipkin-go v0.2.2
@@ -25,3 +26,5 @@ require (
 	go.uber.org/ratelimit v0.1.0
 	gocloud.dev v0.19.0
 )
+
+replace github.com/maestre3d/alexandria/shared => ../shared
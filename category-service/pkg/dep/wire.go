@@ -21,7 +21,9 @@ var httpCategorySet = wire.NewSet(
 	provideContext,
 	logger.NewZapLogger,
 	provideCategoryService,
+	provideCategoryRootService,
 	handler.NewCategoryHTTP,
+	handler.NewAuthHTTP,
 )
 
 var transportProxySet = wire.NewSet(
@@ -49,8 +51,14 @@ func provideCategoryService(ctx context.Context, logger log.Logger) (service.Cat
 	return svc, cleanup, err
 }
 
-func provideHandlers(category *handler.CategoryHTTP) []transport.Handler {
-	return []transport.Handler{category}
+func provideCategoryRootService(ctx context.Context) (service.CategoryRoot, func(), error) {
+	dependency.SetContext(ctx)
+
+	return dependency.InjectCategoryRootUseCase()
+}
+
+func provideHandlers(auth *handler.AuthHTTP, category *handler.CategoryHTTP) []transport.Handler {
+	return []transport.Handler{auth, category}
 }
 
 func InjectTransportProxy() (*transport.Proxy, func(), error) {
@@ -27,15 +27,25 @@ func InjectTransportProxy() (*transport.Proxy, func(), error) {
 		return nil, nil, err
 	}
 	logLogger := logger.NewZapLogger()
+	authHTTP, err := handler.NewAuthHTTP(logLogger)
+	if err != nil {
+		return nil, nil, err
+	}
 	category, cleanup, err := provideCategoryService(context, logLogger)
 	if err != nil {
 		return nil, nil, err
 	}
-	categoryHTTP := handler.NewCategoryHTTP(category)
-	v := provideHandlers(categoryHTTP)
+	categoryRoot, cleanup2, err := provideCategoryRootService(context)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	categoryHTTP := handler.NewCategoryHTTP(category, categoryRoot)
+	v := provideHandlers(authHTTP, categoryHTTP)
 	httpServer := transport.NewHTTPServer(kernel, logLogger, v...)
 	proxy := transport.NewProxy(httpServer)
 	return proxy, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -45,7 +55,8 @@ func InjectTransportProxy() (*transport.Proxy, func(), error) {
 var ctx = context.Background()
 
 var httpCategorySet = wire.NewSet(
-	provideContext, logger.NewZapLogger, provideCategoryService, handler.NewCategoryHTTP,
+	provideContext, logger.NewZapLogger, provideCategoryService,
+	provideCategoryRootService, handler.NewCategoryHTTP, handler.NewAuthHTTP,
 )
 
 var transportProxySet = wire.NewSet(
@@ -70,6 +81,12 @@ func provideCategoryService(ctx2 context.Context, logger2 log.Logger) (service.C
 	return svc, cleanup, err
 }
 
-func provideHandlers(category *handler.CategoryHTTP) []transport.Handler {
-	return []transport.Handler{category}
+func provideCategoryRootService(ctx2 context.Context) (service.CategoryRoot, func(), error) {
+	dependency.SetContext(ctx2)
+
+	return dependency.InjectCategoryRootUseCase()
+}
+
+func provideHandlers(auth *handler.AuthHTTP, category *handler.CategoryHTTP) []transport.Handler {
+	return []transport.Handler{auth, category}
 }
@@ -21,6 +21,6 @@ type CategoryRoot interface {
 	Add(ctx context.Context, categoryID, rootID string) error
 	GetByRoot(ctx context.Context, rootID string) (*domain.CategoryByRoot, error)
 	List(ctx context.Context, token, limit string) ([]*domain.CategoryByRoot, string, error)
-	DeleteItem(ctx context.Context, categoryID string) error
+	DeleteItem(ctx context.Context, rootID, categoryID string) error
 	DeleteList(ctx context.Context, rootID string) error
 }
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/shared/auth"
+)
+
+// AuthHTTP guards the private and admin category routes with the caller's JWT
+type AuthHTTP struct {
+	*auth.Authenticator
+}
+
+func NewAuthHTTP(logger log.Logger) (*AuthHTTP, error) {
+	authenticator, err := auth.NewAuthenticator(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthHTTP{authenticator}, nil
+}
+
+func (t AuthHTTP) GetName() string {
+	return "auth"
+}
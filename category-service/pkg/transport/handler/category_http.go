@@ -12,12 +12,14 @@ import (
 )
 
 type CategoryHTTP struct {
-	svc service.Category
+	svc  service.Category
+	root service.CategoryRoot
 }
 
-func NewCategoryHTTP(svc service.Category) *CategoryHTTP {
+func NewCategoryHTTP(svc service.Category, root service.CategoryRoot) *CategoryHTTP {
 	return &CategoryHTTP{
-		svc: svc,
+		svc:  svc,
+		root: root,
 	}
 }
 
@@ -29,6 +31,7 @@ func (t CategoryHTTP) SetRoutes(public, private, admin *mux.Router) {
 	// Using OpenCensus middleware for distributed tracing
 	public.Path("/category").Methods(http.MethodGet).Handler(observability.Trace(t.list, true))
 	public.Path("/category/{id}").Methods(http.MethodGet).Handler(observability.Trace(t.get, true))
+	public.Path("/category/root/{id}").Methods(http.MethodGet).Handler(observability.Trace(t.getByRoot, true))
 
 	private.StrictSlash(false).Path("/category").Methods(http.MethodPost).Handler(observability.Trace(t.create, false))
 	private.Path("/category/{id}").Methods(http.MethodPatch, http.MethodPut).Handler(observability.Trace(t.update, false))
@@ -70,6 +73,23 @@ func (t *CategoryHTTP) get(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// getByRoot returns the categories of the given root entity (e.g. media)
+func (t *CategoryHTTP) getByRoot(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	root, err := t.root.GetByRoot(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		httputil.ResponseErrJSON(r.Context(), err, w)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(&struct {
+		Root *domain.CategoryByRoot `json:"category_root"`
+	}{
+		Root: root,
+	})
+}
+
 func (t *CategoryHTTP) list(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 
@@ -6,6 +6,8 @@ import (
 	"github.com/maestre3d/alexandria/identity-service/pkg/dep"
 	"github.com/oklog/run"
 	"log"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -26,7 +28,34 @@ func main() {
 		cleanup()
 	}()
 
+	// Manage goroutines
 	var g run.Group
+	{
+		l, err := net.Listen("tcp", transport.HTTPProxy.Server.Addr)
+		if err != nil {
+			log.Fatalf("failed to start http server\nerror: %v", err)
+		}
+
+		g.Add(func() error {
+			log.Print("starting http service")
+			return http.Serve(l, transport.HTTPProxy.Server.Handler)
+		}, func(err error) {
+			_ = l.Close()
+		})
+	}
+	{
+		grpcListener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", transport.Config.Transport.RPCHost,
+			transport.Config.Transport.RPCPort))
+		if err != nil {
+			log.Fatalf("failed to start grpc server\nerror: %v", err)
+		}
+		g.Add(func() error {
+			log.Print("starting grpc service")
+			return transport.RPCProxy.Serve(grpcListener)
+		}, func(error) {
+			_ = grpcListener.Close()
+		})
+	}
 	{
 		g.Add(func() error {
 			log.Print("starting event service")
@@ -9,10 +9,11 @@ import (
 func main() {
 	ctx := context.Background()
 	dependency.Ctx = ctx
-	userCase, err := dependency.InjectUserUseCase()
+	userCase, cleanup, err := dependency.InjectUserUseCase()
 	if err != nil {
 		panic(err)
 	}
+	defer cleanup()
 
 	user, err := userCase.Get(ctx, "69817804-4af4-4de1-83af-4a5f660d0018")
 	if err != nil {
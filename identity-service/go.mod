@@ -7,14 +7,26 @@ require (
 	github.com/alexandria-oss/core v0.5.4-beta
 	github.com/aws/aws-sdk-go v1.31.1
 	github.com/go-kit/kit v0.10.0
+	github.com/go-redis/redis/v7 v7.2.0
+	github.com/golang/protobuf v1.4.0
+	github.com/google/uuid v1.1.1
 	github.com/google/wire v0.3.0
-	github.com/gorilla/mux v1.7.4 // indirect
+	github.com/gorilla/mux v1.7.4
+	github.com/lib/pq v1.1.1
+	github.com/maestre3d/alexandria/shared v0.0.0
 	github.com/oklog/run v1.0.0
 	github.com/opentracing/opentracing-go v1.1.0
 	github.com/openzipkin/zipkin-go v0.2.2
 	github.com/prometheus/client_golang v1.3.0
 	github.com/sony/gobreaker v0.4.1
+	github.com/spf13/viper v1.6.3
+	github.com/stretchr/testify v1.5.1
 	go.opencensus.io v0.22.3
 	go.uber.org/zap v1.14.1 // indirect
 	gocloud.dev v0.19.0
+	golang.org/x/crypto v0.0.0-20200604202706-70a84ac30bf9
+	google.golang.org/grpc v1.27.1
+	google.golang.org/protobuf v1.21.0
 )
+
+replace github.com/maestre3d/alexandria/shared => ../shared
@@ -4,12 +4,16 @@ package dependency
 
 import (
 	"context"
+	"fmt"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/go-kit/kit/log"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 	"github.com/maestre3d/alexandria/identity-service/internal/infrastructure"
 	"github.com/maestre3d/alexandria/identity-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
 )
 
 var Ctx context.Context = context.Background()
@@ -18,8 +22,7 @@ var dataSet = wire.NewSet(
 	provideContext,
 	config.NewKernel,
 	logger.NewZapLogger,
-	wire.Bind(new(domain.UserRepository), new(*infrastructure.UserCognitoRepository)),
-	infrastructure.NewUserCognitoRepository,
+	provideUserRepository,
 	// provide kafka/sqs base event bus
 )
 
@@ -27,16 +30,37 @@ func provideContext() context.Context {
 	return Ctx
 }
 
-func InjectUserUseCase() (*interactor.User, error) {
+// provideUserRepository returns the user repository of the configured identity provider,
+// the PostgreSQL pool is only opened by the local provider
+func provideUserRepository(ctx context.Context, cfg *config.Kernel, logger log.Logger) (domain.UserRepository, func(), error) {
+	switch provider := infrastructure.IdentityProvider(); provider {
+	case infrastructure.IdentityCognito:
+		return infrastructure.NewUserCognitoRepository(logger, cfg), func() {}, nil
+	case infrastructure.IdentityLocal:
+		db, cleanup, err := persistence.NewPostgresPool(ctx, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return infrastructure.NewUserPQRepository(db, logger), cleanup, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid identity provider %s, expected %s or %s", provider,
+			infrastructure.IdentityCognito, infrastructure.IdentityLocal)
+	}
+}
+
+func InjectUserUseCase() (*interactor.User, func(), error) {
 	wire.Build(
 		dataSet,
+		wire.Bind(new(domain.UserEventBus), new(*infrastructure.UserKafkaEventBus)),
+		infrastructure.NewUserKafkaEventBus,
 		interactor.NewUser,
 	)
 
-	return &interactor.User{}, nil
+	return &interactor.User{}, nil, nil
 }
 
-func InjectUserSAGAUseCase() (*interactor.UserSAGA, error) {
+func InjectUserSAGAUseCase() (*interactor.UserSAGA, func(), error) {
 	wire.Build(
 		dataSet,
 		wire.Bind(new(domain.UserEventSAGA), new(*infrastructure.UserSAGAKafkaEvent)),
@@ -44,5 +68,34 @@ func InjectUserSAGAUseCase() (*interactor.UserSAGA, error) {
 		interactor.NewUserSAGA,
 	)
 
-	return &interactor.UserSAGA{}, nil
+	return &interactor.UserSAGA{}, nil, nil
+}
+
+// InjectIdentityUseCase returns the local identity provider, PostgreSQL is used regardless the configured provider
+func InjectIdentityUseCase() (*interactor.Identity, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		logger.NewZapLogger,
+		persistence.NewPostgresPool,
+		wire.Bind(new(domain.UserCredentialRepository), new(*infrastructure.UserPQRepository)),
+		infrastructure.NewUserPQRepository,
+		wire.Bind(new(domain.TokenSigner), new(*infrastructure.RSATokenSigner)),
+		infrastructure.NewRSATokenSigner,
+		interactor.NewIdentity,
+	)
+
+	return &interactor.Identity{}, nil, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		persistence.NewRedisPool,
+		wire.Bind(new(messaging.Store), new(*messaging.RedisStore)),
+		messaging.NewRedisStore,
+	)
+
+	return &messaging.RedisStore{}, nil, nil
 }
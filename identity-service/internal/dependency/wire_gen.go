@@ -7,39 +7,93 @@ package dependency
 
 import (
 	"context"
+	"fmt"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/go-kit/kit/log"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 	"github.com/maestre3d/alexandria/identity-service/internal/infrastructure"
 	"github.com/maestre3d/alexandria/identity-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
 )
 
 // Injectors from wire.go:
 
-func InjectUserUseCase() (*interactor.User, error) {
+func InjectUserUseCase() (*interactor.User, func(), error) {
 	logLogger := logger.NewZapLogger()
 	context := provideContext()
 	kernel, err := config.NewKernel(context)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	userCognitoRepository := infrastructure.NewUserCognitoRepository(logLogger, kernel)
-	user := interactor.NewUser(logLogger, userCognitoRepository)
-	return user, nil
+	userRepository, cleanup, err := provideUserRepository(context, kernel, logLogger)
+	if err != nil {
+		return nil, nil, err
+	}
+	userKafkaEventBus := infrastructure.NewUserKafkaEventBus(kernel)
+	user := interactor.NewUser(logLogger, userRepository, userKafkaEventBus)
+	return user, func() {
+		cleanup()
+	}, nil
 }
 
-func InjectUserSAGAUseCase() (*interactor.UserSAGA, error) {
+func InjectUserSAGAUseCase() (*interactor.UserSAGA, func(), error) {
 	logLogger := logger.NewZapLogger()
 	context := provideContext()
 	kernel, err := config.NewKernel(context)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	userRepository, cleanup, err := provideUserRepository(context, kernel, logLogger)
+	if err != nil {
+		return nil, nil, err
 	}
-	userCognitoRepository := infrastructure.NewUserCognitoRepository(logLogger, kernel)
 	userSAGAKafkaEvent := infrastructure.NewUserSAGAKafkaEvent(kernel)
-	userSAGA := interactor.NewUserSAGA(logLogger, userCognitoRepository, userSAGAKafkaEvent)
-	return userSAGA, nil
+	userSAGA := interactor.NewUserSAGA(logLogger, userRepository, userSAGAKafkaEvent)
+	return userSAGA, func() {
+		cleanup()
+	}, nil
+}
+
+func InjectIdentityUseCase() (*interactor.Identity, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	userPQRepository := infrastructure.NewUserPQRepository(db, logLogger)
+	rsaTokenSigner, err := infrastructure.NewRSATokenSigner(logLogger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	identity := interactor.NewIdentity(logLogger, userPQRepository, rsaTokenSigner)
+	return identity, func() {
+		cleanup()
+	}, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisStore := messaging.NewRedisStore(client)
+	return redisStore, func() {
+		cleanup()
+	}, nil
 }
 
 // wire.go:
@@ -47,9 +101,27 @@ func InjectUserSAGAUseCase() (*interactor.UserSAGA, error) {
 var Ctx context.Context = context.Background()
 
 var dataSet = wire.NewSet(
-	provideContext, config.NewKernel, logger.NewZapLogger, wire.Bind(new(domain.UserRepository), new(*infrastructure.UserCognitoRepository)), infrastructure.NewUserCognitoRepository,
+	provideContext, config.NewKernel, logger.NewZapLogger, provideUserRepository,
 )
 
 func provideContext() context.Context {
 	return Ctx
 }
+
+// provideUserRepository returns the user repository of the configured identity provider,
+// the PostgreSQL pool is only opened by the local provider
+func provideUserRepository(ctx context.Context, cfg *config.Kernel, logger2 log.Logger) (domain.UserRepository, func(), error) {
+	switch provider := infrastructure.IdentityProvider(); provider {
+	case infrastructure.IdentityCognito:
+		return infrastructure.NewUserCognitoRepository(logger2, cfg), func() {}, nil
+	case infrastructure.IdentityLocal:
+		db, cleanup, err := persistence.NewPostgresPool(ctx, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return infrastructure.NewUserPQRepository(db, logger2), cleanup, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid identity provider %s, expected %s or %s", provider, infrastructure.IdentityCognito, infrastructure.IdentityLocal)
+	}
+}
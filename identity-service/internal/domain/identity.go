@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/shared/auth"
+)
+
+// ErrForbidden the caller is not allowed to perform the operation over the aggregate
+var ErrForbidden = errors.New("operation forbidden")
+
+// Identity authenticated caller, transports propagate it through the request context
+type Identity = auth.Identity
+
+// WithIdentity returns a copy of ctx holding the given identity
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return auth.WithIdentity(ctx, identity)
+}
+
+// IdentityFromContext returns the caller of the given context, false if the call was not authenticated
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	return auth.IdentityFromContext(ctx)
+}
+
+// AuthorizeOwner returns ErrForbidden unless the caller of ctx is one of the given owners or an administrator
+func AuthorizeOwner(ctx context.Context, owners ...string) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return exception.NewErrorDescription(ErrForbidden, "caller is not authenticated")
+	}
+	if identity.Admin {
+		return nil
+	}
+
+	for _, owner := range owners {
+		if owner != "" && owner == identity.Subject {
+			return nil
+		}
+	}
+
+	return exception.NewErrorDescription(ErrForbidden, "caller does not own the resource")
+}
+
+// AuthorizeAdmin returns ErrForbidden unless the caller of ctx is an administrator
+func AuthorizeAdmin(ctx context.Context) error {
+	if identity, ok := IdentityFromContext(ctx); !ok || !identity.Admin {
+		return exception.NewErrorDescription(ErrForbidden, "caller is not an administrator")
+	}
+
+	return nil
+}
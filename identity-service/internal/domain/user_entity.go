@@ -3,15 +3,28 @@ package domain
 import (
 	"fmt"
 	"github.com/alexandria-oss/core/exception"
+	"github.com/google/uuid"
+	"strings"
+	"time"
 )
 
+// UserConfirmed status of the users able to sign in, local users are confirmed on sign up
+const UserConfirmed = "CONFIRMED"
+
 type User struct {
-	ID string `json:"id"`
-	Email    string `json:"email,omitempty"`
-	Name string `json:"name"`
+	ID        string `json:"id"`
+	Email     string `json:"email,omitempty"`
+	Name      string `json:"name"`
 	GivenName string `json:"given_name"`
-	Username string `json:"username"`
-	Password string `json:"password,omitempty"`
+	Username  string `json:"username"`
+	Password  string `json:"password,omitempty"`
+	Picture   string `json:"picture,omitempty"`
+	// Groups user pool groups (e.g. admin), only kept by the local identity provider
+	Groups     []string  `json:"groups,omitempty"`
+	Enabled    bool      `json:"enabled"`
+	Status     string    `json:"status"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
 }
 
 func (i User) IsValid() error {
@@ -27,3 +40,85 @@ func (i User) IsValid() error {
 	return nil
 }
 
+// UserAggregate sign up attributes of a local identity provider's user
+type UserAggregate struct {
+	Username  string
+	Email     string
+	Password  string
+	Name      string
+	GivenName string
+}
+
+// NewUser returns a confirmed user, password is kept in plain text until the user gets stored
+func NewUser(ag *UserAggregate) (*User, error) {
+	user := &User{
+		ID:         uuid.New().String(),
+		Email:      strings.ToLower(strings.TrimSpace(ag.Email)),
+		Name:       strings.TrimSpace(ag.Name),
+		GivenName:  strings.TrimSpace(ag.GivenName),
+		Username:   strings.ToLower(strings.TrimSpace(ag.Username)),
+		Password:   ag.Password,
+		Groups:     make([]string, 0),
+		Enabled:    true,
+		Status:     UserConfirmed,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}
+
+	if err := user.IsValid(); err != nil {
+		return nil, err
+	}
+	switch {
+	case len(user.Username) > 128 || strings.ContainsAny(user.Username, " @"):
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "username", "up to 128 characters without spaces nor @"))
+	case len(user.Email) > 320 || strings.Count(user.Email, "@") != 1 || strings.HasPrefix(user.Email, "@") ||
+		strings.HasSuffix(user.Email, "@"):
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "email", "email address"))
+	case len(user.Password) < 8 || len(user.Password) > 72:
+		// bcrypt only uses the first 72 bytes of a password
+		return nil, exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf(exception.InvalidFieldRangeString, "password", "8", "72"))
+	case len(user.Name) > 255 || len(user.GivenName) > 255:
+		return nil, exception.NewErrorDescription(exception.InvalidFieldRange,
+			fmt.Sprintf(exception.InvalidFieldRangeString, "name", "1", "255"))
+	}
+
+	return user, nil
+}
+
+// UserUpdateAggregate editable user attributes, empty fields are kept untouched
+type UserUpdateAggregate struct {
+	ID        string
+	Name      string
+	GivenName string
+	Picture   string
+}
+
+// Apply sets the given attributes to the user, returns false if nothing changed
+func (a UserUpdateAggregate) Apply(user *User) (bool, error) {
+	changed := false
+	for _, f := range []struct {
+		field, value string
+		target       *string
+	}{
+		{"name", a.Name, &user.Name},
+		{"given_name", a.GivenName, &user.GivenName},
+		{"picture", a.Picture, &user.Picture},
+	} {
+		value := strings.TrimSpace(f.value)
+		if value == "" || value == *f.target {
+			continue
+		}
+		if len(value) > 255 {
+			return false, exception.NewErrorDescription(exception.InvalidFieldRange,
+				fmt.Sprintf(exception.InvalidFieldRangeString, f.field, "1", "255"))
+		}
+
+		*f.target = value
+		changed = true
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+const (
+	// Domain events
+	UserUpdated     = "USER_UPDATED"             // Produced
+	UserRemoved     = "USER_REMOVED"             // Produced
+	UserRestored    = "USER_RESTORED"            // Produced
+	UserHardRemoved = "USER_PERMANENTLY_REMOVED" // Produced
+)
+
+// UserEventBus spreads user lifecycle side-effects, author and media services cascade them
+// over the aggregates owned by the user
+type UserEventBus interface {
+	Updated(ctx context.Context, user User) error
+	Removed(ctx context.Context, id string) error
+	Restored(ctx context.Context, id string) error
+	HardRemoved(ctx context.Context, id string) error
+}
@@ -1,8 +1,27 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"github.com/alexandria-oss/core"
+)
 
+// UserRepository user provider, write operations use the user's username as key
 type UserRepository interface {
 	FetchByID(ctx context.Context, id string) (*User, error)
+	// Fetch returns a page of users and the token of the next page, empty if it was the last one
+	Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*User, string, error)
+	Replace(ctx context.Context, user User) error
 	ReplacePicture(ctx context.Context, id, pictureURL string) error
+	// Disable blocks the user's sign in, the user is kept
+	Disable(ctx context.Context, username string) error
+	Enable(ctx context.Context, username string) error
+	HardRemove(ctx context.Context, username string) error
+}
+
+// UserCredentialRepository users of the local identity provider, passwords are stored hashed
+type UserCredentialRepository interface {
+	// Save stores a new user, usernames and emails are unique
+	Save(ctx context.Context, user User) error
+	// FetchByUsername returns the user with its password hash
+	FetchByUsername(ctx context.Context, username string) (*User, error)
 }
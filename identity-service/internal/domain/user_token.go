@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidCredentials the given username and password do not match any enabled user
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Token credentials issued by the local identity provider, tokens are verified using the provider's key set
+type Token struct {
+	IDToken   string `json:"id_token"`
+	TokenType string `json:"token_type"`
+	// ExpiresIn token lifetime in seconds
+	ExpiresIn int64 `json:"expires_in"`
+}
+
+// TokenSigner signs the tokens of the local identity provider and publishes its verification keys
+type TokenSigner interface {
+	Sign(ctx context.Context, user User) (*Token, error)
+	// KeySet returns the JSON Web Key Set holding the public keys of the signer
+	KeySet(ctx context.Context) ([]byte, error)
+}
@@ -0,0 +1,23 @@
+package infrastructure
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.identity.provider", IdentityCognito)
+}
+
+const (
+	// IdentityCognito users are kept by AWS Cognito, tokens are issued by the user pool
+	IdentityCognito = "cognito"
+	// IdentityLocal users are kept by PostgreSQL, tokens are issued by RSATokenSigner
+	IdentityLocal = "local"
+)
+
+// IdentityProvider returns the configured identity provider, the kernel config must be loaded before
+func IdentityProvider() string {
+	return strings.ToLower(strings.TrimSpace(viper.GetString("alexandria.identity.provider")))
+}
@@ -0,0 +1,169 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/spf13/viper"
+	"io/ioutil"
+	"math/big"
+	"time"
+)
+
+func init() {
+	viper.SetDefault("alexandria.identity.local.key_file", "")
+	viper.SetDefault("alexandria.identity.local.issuer", "alexandria-identity")
+	viper.SetDefault("alexandria.identity.local.audience", "")
+	viper.SetDefault("alexandria.identity.local.token_ttl", "1h")
+}
+
+// RSATokenSigner signs RS256 ID tokens of the local identity provider, tokens carry the same claims
+// Cognito ID tokens do, hence they are accepted by every service verifying Cognito tokens
+type RSATokenSigner struct {
+	key      *rsa.PrivateKey
+	kid      string
+	issuer   string
+	audience string
+	ttl      time.Duration
+}
+
+// NewRSATokenSigner returns a signer using the PEM private key (PKCS#1 or PKCS#8) of the configured key file.
+// A key is generated if none was configured, tokens are no longer valid after a restart
+func NewRSATokenSigner(logger log.Logger) (*RSATokenSigner, error) {
+	var key *rsa.PrivateKey
+	if path := viper.GetString("alexandria.identity.local.key_file"); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if key, err = parseRSAPrivateKey(data); err != nil {
+			return nil, err
+		}
+	} else {
+		_ = logger.Log("method", "user.infrastructure.token.signer",
+			"msg", "missing alexandria.identity.local.key_file, using an ephemeral signing key")
+		var err error
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			return nil, err
+		}
+	}
+
+	return &RSATokenSigner{
+		key:      key,
+		kid:      keyID(&key.PublicKey),
+		issuer:   viper.GetString("alexandria.identity.local.issuer"),
+		audience: viper.GetString("alexandria.identity.local.audience"),
+		ttl:      viper.GetDuration("alexandria.identity.local.token_ttl"),
+	}, nil
+}
+
+type tokenClaims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  string   `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp"`
+	IssuedAt  int64    `json:"iat"`
+	NotBefore int64    `json:"nbf"`
+	Username  string   `json:"username"`
+	Groups    []string `json:"groups,omitempty"`
+	Email     string   `json:"email"`
+	TokenUse  string   `json:"token_use"`
+}
+
+func (s *RSATokenSigner) Sign(_ context.Context, user domain.User) (*domain.Token, error) {
+	now := time.Now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": s.kid})
+	if err != nil {
+		return nil, err
+	}
+	claims, err := json.Marshal(tokenClaims{
+		Subject:   user.ID,
+		Issuer:    s.issuer,
+		Audience:  s.audience,
+		ExpiresAt: now.Add(s.ttl).Unix(),
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
+		Username:  user.Username,
+		Groups:    user.Groups,
+		Email:     user.Email,
+		TokenUse:  "id",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(payload))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Token{
+		IDToken:   payload + "." + base64.RawURLEncoding.EncodeToString(signature),
+		TokenType: "Bearer",
+		ExpiresIn: int64(s.ttl.Seconds()),
+	}, nil
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (s *RSATokenSigner) KeySet(_ context.Context) ([]byte, error) {
+	return json.Marshal(struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{
+		Keys: []jsonWebKey{{
+			Kid: s.kid,
+			Kty: "RSA",
+			Alg: "RS256",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(s.key.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.PublicKey.E)).Bytes()),
+		}},
+	})
+}
+
+// parseRSAPrivateKey decodes a PEM encoded PKCS#1 or PKCS#8 RSA private key
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("signing key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// keyID derives a stable key id from the public key, rotated keys get a new id
+func keyID(key *rsa.PublicKey) string {
+	digest := sha256.Sum256(x509.MarshalPKCS1PublicKey(key))
+	return base64.RawURLEncoding.EncodeToString(digest[:16])
+}
@@ -3,9 +3,11 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"github.com/alexandria-oss/core"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/exception"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	cognito "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/go-kit/kit/log"
@@ -43,7 +45,7 @@ func (r *UserCognitoRepository) FetchByID(ctx context.Context, id string) (*doma
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	statement := fmt.Sprintf("sub = \"%s\"", id)
+	statement := fmt.Sprintf("sub = \"%s\"", sanitizeFilter(id))
 
 	i := &cognito.ListUsersInput{
 		AttributesToGet: nil,
@@ -66,28 +68,7 @@ func (r *UserCognitoRepository) FetchByID(ctx context.Context, id string) (*doma
 		return nil, exception.EntityNotFound
 	}
 
-	user := &domain.User{
-		Username: *userC.Users[0].Username,
-	}
-
-	for _, atr := range userC.Users[0].Attributes {
-		switch *atr.Name {
-		case "email":
-			user.Email = *atr.Value
-			continue
-		case "name":
-			user.Name = *atr.Value
-			continue
-		case "given_name":
-			user.GivenName = *atr.Value
-			continue
-		case "sub":
-			user.ID = *atr.Value
-			continue
-		}
-	}
-
-	return user, nil
+	return toUser(userC.Users[0]), nil
 }
 
 func (r *UserCognitoRepository) ReplacePicture(ctx context.Context, id, pictureURL string) error {
@@ -120,3 +101,181 @@ func (r *UserCognitoRepository) ReplacePicture(ctx context.Context, id, pictureU
 
 	return nil
 }
+
+// cognitoMaxLimit maximum page size accepted by Cognito's ListUsers
+const cognitoMaxLimit = 60
+
+// userFilters Cognito accepts a single filter per query, the first given filter is used
+var userFilters = []struct {
+	param, attribute, operator string
+}{
+	{"username", "username", "^="},
+	{"email", "email", "^="},
+	{"name", "name", "^="},
+	{"given_name", "given_name", "^="},
+	{"status", "status", "="},
+}
+
+func (r *UserCognitoRepository) Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*domain.User, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := &cognito.ListUsersInput{
+		Limit:      aws.Int64(int64(params.Size)),
+		UserPoolId: aws.String(r.cfg.AWS.CognitoPoolID),
+	}
+	if params.Size > cognitoMaxLimit {
+		i.Limit = aws.Int64(cognitoMaxLimit)
+	}
+	if params.Token != "" {
+		i.PaginationToken = aws.String(params.Token)
+	}
+	for _, f := range userFilters {
+		value := sanitizeFilter(filter[f.param])
+		if value == "" {
+			continue
+		}
+		if f.param == "status" {
+			// Cognito's status values are Enabled and Disabled
+			value = strings.Title(strings.ToLower(value))
+		}
+
+		i.Filter = aws.String(fmt.Sprintf("%s %s \"%s\"", f.attribute, f.operator, value))
+		break
+	}
+
+	// Add OpenCensus to AWS_SDK action
+	ctxT, span := trace.StartSpan(ctx, "aws_sdk/cognito.list_users")
+	defer span.End()
+
+	res, err := r.client.ListUsersWithContext(ctxT, i)
+	if err != nil {
+		return nil, "", cognitoError(err, "page_token")
+	}
+
+	users := make([]*domain.User, 0, len(res.Users))
+	for _, u := range res.Users {
+		users = append(users, toUser(u))
+	}
+
+	return users, aws.StringValue(res.PaginationToken), nil
+}
+
+func (r *UserCognitoRepository) Replace(ctx context.Context, user domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := &cognito.AdminUpdateUserAttributesInput{
+		UserAttributes: []*cognito.AttributeType{
+			{Name: aws.String("name"), Value: aws.String(user.Name)},
+			{Name: aws.String("given_name"), Value: aws.String(user.GivenName)},
+			{Name: aws.String("picture"), Value: aws.String(user.Picture)},
+		},
+		UserPoolId: aws.String(r.cfg.AWS.CognitoPoolID),
+		Username:   aws.String(user.Username),
+	}
+
+	// Add OpenCensus to AWS_SDK action
+	ctxT, span := trace.StartSpan(ctx, "aws_sdk/cognito.update_user_attributes")
+	defer span.End()
+
+	_, err := r.client.AdminUpdateUserAttributesWithContext(ctxT, i)
+	return cognitoError(err, "user")
+}
+
+func (r *UserCognitoRepository) Disable(ctx context.Context, username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Add OpenCensus to AWS_SDK action
+	ctxT, span := trace.StartSpan(ctx, "aws_sdk/cognito.disable_user")
+	defer span.End()
+
+	_, err := r.client.AdminDisableUserWithContext(ctxT, &cognito.AdminDisableUserInput{
+		UserPoolId: aws.String(r.cfg.AWS.CognitoPoolID),
+		Username:   aws.String(username),
+	})
+	return cognitoError(err, "username")
+}
+
+func (r *UserCognitoRepository) Enable(ctx context.Context, username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Add OpenCensus to AWS_SDK action
+	ctxT, span := trace.StartSpan(ctx, "aws_sdk/cognito.enable_user")
+	defer span.End()
+
+	_, err := r.client.AdminEnableUserWithContext(ctxT, &cognito.AdminEnableUserInput{
+		UserPoolId: aws.String(r.cfg.AWS.CognitoPoolID),
+		Username:   aws.String(username),
+	})
+	return cognitoError(err, "username")
+}
+
+func (r *UserCognitoRepository) HardRemove(ctx context.Context, username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Add OpenCensus to AWS_SDK action
+	ctxT, span := trace.StartSpan(ctx, "aws_sdk/cognito.delete_user")
+	defer span.End()
+
+	_, err := r.client.AdminDeleteUserWithContext(ctxT, &cognito.AdminDeleteUserInput{
+		UserPoolId: aws.String(r.cfg.AWS.CognitoPoolID),
+		Username:   aws.String(username),
+	})
+	return cognitoError(err, "username")
+}
+
+// toUser maps a Cognito user to a domain user
+func toUser(u *cognito.UserType) *domain.User {
+	user := &domain.User{
+		Username:   aws.StringValue(u.Username),
+		Enabled:    aws.BoolValue(u.Enabled),
+		Status:     aws.StringValue(u.UserStatus),
+		CreateTime: aws.TimeValue(u.UserCreateDate),
+		UpdateTime: aws.TimeValue(u.UserLastModifiedDate),
+	}
+
+	for _, atr := range u.Attributes {
+		switch aws.StringValue(atr.Name) {
+		case "email":
+			user.Email = aws.StringValue(atr.Value)
+		case "name":
+			user.Name = aws.StringValue(atr.Value)
+		case "given_name":
+			user.GivenName = aws.StringValue(atr.Value)
+		case "picture":
+			user.Picture = aws.StringValue(atr.Value)
+		case "sub":
+			user.ID = aws.StringValue(atr.Value)
+		}
+	}
+
+	return user
+}
+
+// cognitoError maps Cognito's errors to exceptions, field is the request field blamed for invalid parameters
+func cognitoError(err error, field string) error {
+	if err == nil {
+		return nil
+	}
+
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case cognito.ErrCodeUserNotFoundException:
+			return exception.EntityNotFound
+		case cognito.ErrCodeInvalidParameterException:
+			return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+				field, awsErr.Message()))
+		}
+	}
+
+	return err
+}
+
+// sanitizeFilter removes the characters able to break out of a Cognito filter value
+func sanitizeFilter(value string) string {
+	return strings.NewReplacer(`"`, "", `\\`, "").Replace(strings.TrimSpace(value))
+}
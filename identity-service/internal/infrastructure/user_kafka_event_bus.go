@@ -0,0 +1,126 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/sony/gobreaker"
+	"go.opencensus.io/trace"
+	"gocloud.dev/pubsub"
+	"sync"
+	"time"
+)
+
+type UserKafkaEventBus struct {
+	cfg *config.Kernel
+	mu  *sync.Mutex
+}
+
+func NewUserKafkaEventBus(cfg *config.Kernel) *UserKafkaEventBus {
+	return &UserKafkaEventBus{cfg, new(sync.Mutex)}
+}
+
+func (b UserKafkaEventBus) defaultCircuitBreaker(action string) *gobreaker.CircuitBreaker {
+	st := gobreaker.Settings{
+		Name:        "user_kafka_" + action,
+		MaxRequests: 1,
+		Interval:    0,
+		Timeout:     15 * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= 3 && failureRatio >= 0.6
+		},
+		OnStateChange: nil,
+	}
+
+	return gobreaker.NewCircuitBreaker(st)
+}
+
+func (b *UserKafkaEventBus) Updated(ctx context.Context, user domain.User) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Never spread credentials
+	user.Password = ""
+	userJSON, err := json.Marshal(user)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"user", "user entity"))
+	}
+
+	return b.send(ctx, "user: update", domain.UserUpdated, eventbus.PriorityLow, userJSON, "updated")
+}
+
+func (b *UserKafkaEventBus) Removed(ctx context.Context, id string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.send(ctx, "user: removed", domain.UserRemoved, eventbus.PriorityMid, []byte(id), "removed")
+}
+
+func (b *UserKafkaEventBus) Restored(ctx context.Context, id string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.send(ctx, "user: restored", domain.UserRestored, eventbus.PriorityMid, []byte(id), "restored")
+}
+
+func (b *UserKafkaEventBus) HardRemoved(ctx context.Context, id string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Owned aggregates must be removed too, hence the high priority
+	return b.send(ctx, "user: hard_removed", domain.UserHardRemoved, eventbus.PriorityHigh, []byte(id), "hard_removed")
+}
+
+// send publishes a domain event to the given topic, spreads side-effects to all required services
+func (b *UserKafkaEventBus) send(ctx context.Context, spanName, eventName, priority string, body []byte, action string) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, spanName)
+	defer span.End()
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", eventName))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	topic, err := eventbus.NewKafkaProducer(ctxT, eventName)
+	if err != nil {
+		return err
+	}
+	defer topic.Shutdown(ctxT)
+
+	e := eventbus.NewEvent(b.cfg.Service, eventbus.EventDomain, priority, eventbus.ProviderKafka, body)
+	e.TracingContext = string(spanJSON)
+	m := &pubsub.Message{
+		Body: e.Content,
+		Metadata: map[string]string{
+			"tracing_context": e.TracingContext,
+			"service":         e.ServiceName,
+			"event_id":        e.ID,
+			"event_type":      e.EventType,
+			"priority":        e.Priority,
+			"provider":        e.Provider,
+			"dispatch_time":   e.DispatchTime,
+		},
+		BeforeSend: nil,
+	}
+
+	// Safe-call with circuit breaker pattern
+	_, err = b.defaultCircuitBreaker(action).Execute(func() (interface{}, error) {
+		return nil, topic.Send(ctxT, m)
+	})
+
+	return err
+}
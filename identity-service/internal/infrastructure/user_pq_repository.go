@@ -0,0 +1,338 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/lib/pq"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UserPQRepository User provider's local PostgreSQL repo implementation, passwords are stored hashed
+type UserPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+	mu     *sync.Mutex
+}
+
+func NewUserPQRepository(db *sql.DB, logger log.Logger) *UserPQRepository {
+	return &UserPQRepository{
+		db:     db,
+		logger: logger,
+		mu:     new(sync.Mutex),
+	}
+}
+
+// userColumns every user column but the password, the internal id is only used as page token
+const userColumns = `id, external_id, username, email, name, given_name, picture, groups, enabled, status, create_time, update_time`
+
+func (r *UserPQRepository) Save(ctx context.Context, user domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `INSERT INTO alexa1.users(external_id, username, email, password, name, given_name, picture, groups, enabled, status,
+					create_time, update_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	_, err = conn.ExecContext(ctx, statement, user.ID, user.Username, user.Email, user.Password, user.Name, user.GivenName,
+		user.Picture, pq.Array(user.Groups), user.Enabled, user.Status, user.CreateTime, user.UpdateTime)
+	if err != nil {
+		// Usernames and emails are unique
+		if customErr, ok := err.(*pq.Error); ok {
+			if customErr.Code == "23505" {
+				return exception.EntityExists
+			}
+		}
+	}
+
+	return err
+}
+
+func (r *UserPQRepository) FetchByID(ctx context.Context, id string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.fetch_id", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT ` + userColumns + ` FROM alexa1.users WHERE external_id = $1`
+	user, _, err := scanUser(conn.QueryRowContext(ctx, statement, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *UserPQRepository) FetchByUsername(ctx context.Context, username string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.fetch_username", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT ` + userColumns + `, password FROM alexa1.users WHERE username = $1`
+
+	var id int64
+	user := new(domain.User)
+	groups := pq.StringArray{}
+	err = conn.QueryRowContext(ctx, statement, strings.ToLower(username)).Scan(&id, &user.ID, &user.Username, &user.Email,
+		&user.Name, &user.GivenName, &user.Picture, &groups, &user.Enabled, &user.Status, &user.CreateTime, &user.UpdateTime,
+		&user.Password)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.EntityNotFound
+		}
+		return nil, err
+	}
+	user.Groups = groups
+
+	return user, nil
+}
+
+// userFilterColumns prefix filters accepted by Fetch, status is matched exactly
+var userFilterColumns = []struct {
+	param, column string
+}{
+	{"username", "username"},
+	{"email", "email"},
+	{"name", "name"},
+	{"given_name", "given_name"},
+}
+
+// Fetch returns a page of users ordered by creation, page tokens are the internal id of the last user of the previous page
+func (r *UserPQRepository) Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*domain.User, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.fetch", "db_connection", r.db.Stats().OpenConnections)
+
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	if params.Token != "" {
+		lastID, err := strconv.ParseInt(params.Token, 10, 64)
+		if err != nil {
+			return nil, "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf(exception.InvalidFieldFormatString, "page_token", "page token"))
+		}
+		args = append(args, lastID)
+		conditions = append(conditions, fmt.Sprintf("id > $%d", len(args)))
+	}
+	for _, f := range userFilterColumns {
+		if value := strings.TrimSpace(filter[f.param]); value != "" {
+			args = append(args, escapeLike(value)+"%")
+			conditions = append(conditions, fmt.Sprintf("%s ILIKE $%d", f.column, len(args)))
+		}
+	}
+	// Keeps Cognito's status values, Enabled and Disabled
+	switch status := strings.ToLower(strings.TrimSpace(filter["status"])); status {
+	case "":
+	case "enabled", "disabled":
+		args = append(args, status == "enabled")
+		conditions = append(conditions, fmt.Sprintf("enabled = $%d", len(args)))
+	default:
+		args = append(args, strings.ToUpper(status))
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	statement := `SELECT ` + userColumns + ` FROM alexa1.users`
+	if len(conditions) > 0 {
+		statement += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	// Fetch an extra row to know if there is a next page
+	args = append(args, params.Size+1)
+	statement += fmt.Sprintf(` ORDER BY id ASC LIMIT $%d`, len(args))
+
+	rows, err := conn.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, "", err
+	} else if rows.Err() != nil {
+		return nil, "", rows.Err()
+	}
+	defer func() {
+		err = rows.Close()
+	}()
+
+	users := make([]*domain.User, 0)
+	nextToken := ""
+	for rows.Next() {
+		if len(users) == params.Size {
+			// Extra row, the page continues after the last returned user
+			break
+		}
+		user, id, err := scanUser(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		users = append(users, user)
+		nextToken = strconv.FormatInt(id, 10)
+	}
+	if len(users) < params.Size {
+		nextToken = ""
+	}
+
+	return users, nextToken, nil
+}
+
+func (r *UserPQRepository) Replace(ctx context.Context, user domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.replace", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `UPDATE alexa1.users SET name = $1, given_name = $2, picture = $3, update_time = $4 WHERE username = $5`
+
+	return r.affectOne(conn.ExecContext(ctx, statement, user.Name, user.GivenName, user.Picture, time.Now(), user.Username))
+}
+
+func (r *UserPQRepository) ReplacePicture(ctx context.Context, id, pictureURL string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.replace_picture", "db_connection", r.db.Stats().OpenConnections)
+
+	// Keyed by username like Cognito's attribute updates
+	statement := `UPDATE alexa1.users SET picture = $1, update_time = $2 WHERE username = $3`
+
+	return r.affectOne(conn.ExecContext(ctx, statement, pictureURL, time.Now(), id))
+}
+
+func (r *UserPQRepository) Disable(ctx context.Context, username string) error {
+	return r.setEnabled(ctx, username, false)
+}
+
+func (r *UserPQRepository) Enable(ctx context.Context, username string) error {
+	return r.setEnabled(ctx, username, true)
+}
+
+func (r *UserPQRepository) HardRemove(ctx context.Context, username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.hard_remove", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `DELETE FROM alexa1.users WHERE username = $1`
+
+	return r.affectOne(conn.ExecContext(ctx, statement, username))
+}
+
+func (r *UserPQRepository) setEnabled(ctx context.Context, username string, enabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = conn.Close()
+	}()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "user.infrastructure.postgres.set_enabled", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `UPDATE alexa1.users SET enabled = $1, update_time = $2 WHERE username = $3`
+
+	return r.affectOne(conn.ExecContext(ctx, statement, enabled, time.Now(), username))
+}
+
+// affectOne maps statements without affected rows to a not found user
+func (r *UserPQRepository) affectOne(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return exception.EntityNotFound
+	}
+
+	return nil
+}
+
+type userScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUser reads a row of userColumns, returns the user and its internal id
+func scanUser(row userScanner) (*domain.User, int64, error) {
+	var id int64
+	user := new(domain.User)
+	groups := pq.StringArray{}
+	err := row.Scan(&id, &user.ID, &user.Username, &user.Email, &user.Name, &user.GivenName, &user.Picture, &groups,
+		&user.Enabled, &user.Status, &user.CreateTime, &user.UpdateTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, 0, exception.EntityNotFound
+		}
+		return nil, 0, err
+	}
+	user.Groups = groups
+
+	return user, id, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
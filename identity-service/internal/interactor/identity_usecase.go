@@ -0,0 +1,87 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Identity local identity provider, signs up users and issues their tokens without AWS Cognito
+type Identity struct {
+	logger     log.Logger
+	repository domain.UserCredentialRepository
+	signer     domain.TokenSigner
+}
+
+func NewIdentity(logger log.Logger, repo domain.UserCredentialRepository, signer domain.TokenSigner) *Identity {
+	return &Identity{
+		logger:     logger,
+		repository: repo,
+		signer:     signer,
+	}
+}
+
+// SignUp stores a new confirmed user, usernames and emails are unique
+func (u *Identity) SignUp(ctx context.Context, ag *domain.UserAggregate) (*domain.User, error) {
+	user, err := domain.NewUser(ag)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = string(hash)
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err = u.repository.Save(ctxR, *user); err != nil {
+		if err == exception.EntityExists {
+			return nil, exception.NewErrorDescription(exception.EntityExists,
+				fmt.Sprintf("user %s or email %s already exists", user.Username, user.Email))
+		}
+		return nil, err
+	}
+
+	_ = u.logger.Log("method", "user.interactor.sign_up", "msg", fmt.Sprintf("user %s signed up", user.ID))
+	user.Password = ""
+	return user, nil
+}
+
+// SignIn returns a token of the given user, unknown users, wrong passwords and disabled users
+// are reported alike to avoid user enumeration
+func (u *Identity) SignIn(ctx context.Context, username, password string) (*domain.Token, error) {
+	if username == "" || password == "" {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	user, err := u.repository.FetchByUsername(ctxR, strings.ToLower(strings.TrimSpace(username)))
+	if err != nil {
+		if err == exception.EntityNotFound {
+			return nil, domain.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil || !user.Enabled {
+		return nil, domain.ErrInvalidCredentials
+	}
+	user.Password = ""
+
+	return u.signer.Sign(ctxR, *user)
+}
+
+// KeySet returns the JSON Web Key Set verifying the tokens issued by SignIn
+func (u *Identity) KeySet(ctx context.Context) ([]byte, error) {
+	return u.signer.KeySet(ctx)
+}
@@ -0,0 +1,84 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// credentialMemRepository in-memory local user pool, usernames and emails are unique
+type credentialMemRepository struct {
+	userMemRepository
+}
+
+func (r *credentialMemRepository) Save(_ context.Context, user domain.User) error {
+	for _, u := range r.users {
+		if u.Username == user.Username || u.Email == user.Email {
+			return exception.EntityExists
+		}
+	}
+
+	r.users[user.ID] = &user
+	return nil
+}
+
+func (r *credentialMemRepository) FetchByUsername(_ context.Context, username string) (*domain.User, error) {
+	user, err := r.byUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	u := *user
+	return &u, nil
+}
+
+// tokenSignerStub issues the subject as token
+type tokenSignerStub struct{}
+
+func (s tokenSignerStub) Sign(_ context.Context, user domain.User) (*domain.Token, error) {
+	return &domain.Token{IDToken: user.ID, TokenType: "Bearer", ExpiresIn: 3600}, nil
+}
+
+func (s tokenSignerStub) KeySet(_ context.Context) ([]byte, error) {
+	return []byte(`{"keys":[]}`), nil
+}
+
+func TestIdentity_SignIn(t *testing.T) {
+	repo := &credentialMemRepository{userMemRepository{users: map[string]*domain.User{}}}
+	svc := NewIdentity(log.NewNopLogger(), repo, tokenSignerStub{})
+
+	_, err := svc.SignUp(context.Background(), &domain.UserAggregate{Username: "aruiz", Email: "a@example.com",
+		Password: "short"})
+	assert.True(t, errors.Is(err, exception.InvalidFieldRange))
+
+	user, err := svc.SignUp(context.Background(), &domain.UserAggregate{Username: "ARuiz", Email: "a@example.com",
+		Password: "correct horse", Name: "Alonso"})
+	assert.Nil(t, err)
+	assert.Equal(t, "aruiz", user.Username)
+	assert.Empty(t, user.Password)
+	// Passwords are never stored in plain text
+	assert.NotEqual(t, "correct horse", repo.users[user.ID].Password)
+
+	_, err = svc.SignUp(context.Background(), &domain.UserAggregate{Username: "bruiz", Email: "a@example.com",
+		Password: "correct horse"})
+	assert.True(t, errors.Is(err, exception.EntityExists))
+
+	token, err := svc.SignIn(context.Background(), "ARuiz", "correct horse")
+	assert.Nil(t, err)
+	assert.Equal(t, user.ID, token.IDToken)
+
+	_, err = svc.SignIn(context.Background(), "aruiz", "wrong horse")
+	assert.Equal(t, domain.ErrInvalidCredentials, err)
+	_, err = svc.SignIn(context.Background(), "bruiz", "correct horse")
+	assert.Equal(t, domain.ErrInvalidCredentials, err)
+
+	// Disabled users cannot sign in
+	assert.Nil(t, repo.Disable(context.Background(), "aruiz"))
+	_, err = svc.SignIn(context.Background(), "aruiz", "correct horse")
+	assert.Equal(t, domain.ErrInvalidCredentials, err)
+}
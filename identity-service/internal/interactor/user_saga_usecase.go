@@ -120,7 +120,11 @@ func (u *UserSAGA) Verify(ctx context.Context, service string, usersJSON []byte)
 	}
 
 	for _, id := range owners {
-		_, err := u.repository.FetchByID(ctxR, id)
+		user, err := u.repository.FetchByID(ctxR, id)
+		if err == nil && !user.Enabled {
+			// Removed users cannot own aggregates
+			err = exception.NewErrorDescription(exception.EntityNotFound, fmt.Sprintf("user %s is disabled", id))
+		}
 		if code := httputil.ErrorToCode(err); err != nil && code != 500 {
 			// Rollback if user (e.g. HTTP 404/409/400) error
 			errE := u.event.Failed(ctxR, service, err.Error())
@@ -2,6 +2,7 @@ package interactor
 
 import (
 	"context"
+	"github.com/alexandria-oss/core"
 	"github.com/go-kit/kit/log"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 )
@@ -9,26 +10,193 @@ import (
 type User struct {
 	logger     log.Logger
 	repository domain.UserRepository
+	event      domain.UserEventBus
 }
 
-func NewUser(logger log.Logger, repo domain.UserRepository) *User {
+func NewUser(logger log.Logger, repo domain.UserRepository, bus domain.UserEventBus) *User {
 	return &User{
 		logger:     logger,
 		repository: repo,
+		event:      bus,
 	}
 }
 
-// TODO: Add the following use cases
-// Read
-// - List
-// Write
-// - Update
-// - Delete (deactivate/soft-delete)
-// - Restore (activate)
-// - HardDelete (hard-delete)
-
 func (u *User) Get(ctx context.Context, id string) (*domain.User, error) {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
-	return u.repository.FetchByID(ctxR, id)
+
+	user, err := u.repository.FetchByID(ctxR, id)
+	if err != nil {
+		return nil, err
+	}
+	// Email is only visible to the user itself and administrators
+	if domain.AuthorizeOwner(ctx, user.ID) != nil {
+		user.Email = ""
+	}
+
+	return user, nil
+}
+
+// List returns a page of users, only administrators may list the user pool
+func (u *User) List(ctx context.Context, pageToken, pageSize string, filter core.FilterParams) ([]*domain.User, string, error) {
+	if err := domain.AuthorizeAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return u.repository.Fetch(ctxR, *core.NewPaginationParams(pageToken, pageSize), filter)
+}
+
+func (u *User) Update(ctx context.Context, ag *domain.UserUpdateAggregate) (*domain.User, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	user, err := u.repository.FetchByID(ctxR, ag.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = domain.AuthorizeOwner(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	// Store backup for event rollbacks
+	userBackup := *user
+
+	changed, err := ag.Apply(user)
+	if err != nil {
+		return nil, err
+	} else if !changed {
+		return user, nil
+	}
+
+	err = u.repository.Replace(ctxR, *user)
+	if err != nil {
+		return nil, err
+	}
+
+	// Spread side-effects, rollback if event failed
+	err = u.event.Updated(ctxR, *user)
+	if err != nil {
+		_ = u.logger.Log("method", "user.interactor.update", "err", err.Error())
+		if errR := u.repository.Replace(context.Background(), userBackup); errR != nil {
+			_ = u.logger.Log("method", "user.interactor.update", "err", errR.Error())
+		}
+
+		return nil, err
+	}
+
+	_ = u.logger.Log("method", "user.interactor.update", "msg", domain.UserUpdated+" event published")
+	return user, nil
+}
+
+// Delete disables the user, users may delete their own account
+func (u *User) Delete(ctx context.Context, id string) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	user, err := u.repository.FetchByID(ctxR, id)
+	if err != nil {
+		return err
+	}
+	if err = domain.AuthorizeOwner(ctx, user.ID); err != nil {
+		return err
+	}
+
+	err = u.repository.Disable(ctxR, user.Username)
+	if err != nil {
+		return err
+	}
+
+	// Spread side-effects, rollback if event failed
+	err = u.event.Removed(ctxR, user.ID)
+	if err != nil {
+		_ = u.logger.Log("method", "user.interactor.delete", "err", err.Error())
+		if errR := u.repository.Enable(context.Background(), user.Username); errR != nil {
+			_ = u.logger.Log("method", "user.interactor.delete", "err", errR.Error())
+		}
+
+		return err
+	}
+
+	_ = u.logger.Log("method", "user.interactor.delete", "msg", domain.UserRemoved+" event published")
+	return nil
+}
+
+// Restore enables a disabled user, only administrators may restore users
+func (u *User) Restore(ctx context.Context, id string) error {
+	if err := domain.AuthorizeAdmin(ctx); err != nil {
+		return err
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	user, err := u.repository.FetchByID(ctxR, id)
+	if err != nil {
+		return err
+	}
+
+	err = u.repository.Enable(ctxR, user.Username)
+	if err != nil {
+		return err
+	}
+
+	// Spread side-effects, rollback if event failed
+	err = u.event.Restored(ctxR, user.ID)
+	if err != nil {
+		_ = u.logger.Log("method", "user.interactor.restore", "err", err.Error())
+		if errR := u.repository.Disable(context.Background(), user.Username); errR != nil {
+			_ = u.logger.Log("method", "user.interactor.restore", "err", errR.Error())
+		}
+
+		return err
+	}
+
+	_ = u.logger.Log("method", "user.interactor.restore", "msg", domain.UserRestored+" event published")
+	return nil
+}
+
+// HardDelete removes the user permanently, only administrators may hard-delete users.
+// A removed Cognito user cannot be restored, hence the user gets disabled until the event is published
+func (u *User) HardDelete(ctx context.Context, id string) error {
+	if err := domain.AuthorizeAdmin(ctx); err != nil {
+		return err
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	user, err := u.repository.FetchByID(ctxR, id)
+	if err != nil {
+		return err
+	}
+
+	if user.Enabled {
+		err = u.repository.Disable(ctxR, user.Username)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Spread side-effects, rollback if event failed
+	err = u.event.HardRemoved(ctxR, user.ID)
+	if err != nil {
+		_ = u.logger.Log("method", "user.interactor.hard_delete", "err", err.Error())
+		if user.Enabled {
+			if errR := u.repository.Enable(context.Background(), user.Username); errR != nil {
+				_ = u.logger.Log("method", "user.interactor.hard_delete", "err", errR.Error())
+			}
+		}
+
+		return err
+	}
+
+	err = u.repository.HardRemove(ctxR, user.Username)
+	if err != nil {
+		return err
+	}
+
+	_ = u.logger.Log("method", "user.interactor.hard_delete", "msg", domain.UserHardRemoved+" event published")
+	return nil
 }
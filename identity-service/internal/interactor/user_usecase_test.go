@@ -0,0 +1,187 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// userMemRepository in-memory user pool indexed by id
+type userMemRepository struct {
+	users map[string]*domain.User
+}
+
+func (r *userMemRepository) byUsername(username string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+
+	return nil, exception.EntityNotFound
+}
+
+func (r *userMemRepository) FetchByID(_ context.Context, id string) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, exception.EntityNotFound
+	}
+
+	u := *user
+	return &u, nil
+}
+
+func (r *userMemRepository) Fetch(_ context.Context, _ core.PaginationParams, _ core.FilterParams) ([]*domain.User, string, error) {
+	users := make([]*domain.User, 0)
+	for _, user := range r.users {
+		users = append(users, user)
+	}
+
+	return users, "", nil
+}
+
+func (r *userMemRepository) Replace(_ context.Context, user domain.User) error {
+	r.users[user.ID] = &user
+	return nil
+}
+
+func (r *userMemRepository) ReplacePicture(_ context.Context, id, pictureURL string) error {
+	r.users[id].Picture = pictureURL
+	return nil
+}
+
+func (r *userMemRepository) Disable(_ context.Context, username string) error {
+	user, err := r.byUsername(username)
+	if err == nil {
+		user.Enabled = false
+	}
+	return err
+}
+
+func (r *userMemRepository) Enable(_ context.Context, username string) error {
+	user, err := r.byUsername(username)
+	if err == nil {
+		user.Enabled = true
+	}
+	return err
+}
+
+func (r *userMemRepository) HardRemove(_ context.Context, username string) error {
+	user, err := r.byUsername(username)
+	if err == nil {
+		delete(r.users, user.ID)
+	}
+	return err
+}
+
+// userEventBusStub records published events, fails every publish if err is set
+type userEventBusStub struct {
+	err    error
+	events []string
+}
+
+func (b *userEventBusStub) publish(event string) error {
+	if b.err != nil {
+		return b.err
+	}
+
+	b.events = append(b.events, event)
+	return nil
+}
+
+func (b *userEventBusStub) Updated(_ context.Context, _ domain.User) error {
+	return b.publish(domain.UserUpdated)
+}
+
+func (b *userEventBusStub) Removed(_ context.Context, _ string) error {
+	return b.publish(domain.UserRemoved)
+}
+
+func (b *userEventBusStub) Restored(_ context.Context, _ string) error {
+	return b.publish(domain.UserRestored)
+}
+
+func (b *userEventBusStub) HardRemoved(_ context.Context, _ string) error {
+	return b.publish(domain.UserHardRemoved)
+}
+
+func newUserStub() (*User, *userMemRepository, *userEventBusStub) {
+	repo := &userMemRepository{users: map[string]*domain.User{
+		"user-1": {ID: "user-1", Username: "aruiz", Name: "Alonso", Email: "a@example.com", Enabled: true},
+	}}
+	bus := new(userEventBusStub)
+
+	return NewUser(log.NewNopLogger(), repo, bus), repo, bus
+}
+
+func TestUser_Update(t *testing.T) {
+	svc, repo, bus := newUserStub()
+	owner := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-1"})
+	stranger := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-2"})
+
+	_, err := svc.Update(stranger, &domain.UserUpdateAggregate{ID: "user-1", Name: "Bruno"})
+	assert.True(t, errors.Is(err, domain.ErrForbidden))
+
+	user, err := svc.Update(owner, &domain.UserUpdateAggregate{ID: "user-1", Name: " Bruno "})
+	assert.Nil(t, err)
+	assert.Equal(t, "Bruno", user.Name)
+	assert.Equal(t, []string{domain.UserUpdated}, bus.events)
+
+	// Failed events rollback the update
+	bus.err = errors.New("kafka unavailable")
+	_, err = svc.Update(owner, &domain.UserUpdateAggregate{ID: "user-1", Name: "Carlos"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "Bruno", repo.users["user-1"].Name)
+}
+
+func TestUser_Lifecycle(t *testing.T) {
+	svc, repo, bus := newUserStub()
+	owner := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-1"})
+	admin := domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-3", Admin: true})
+
+	// Failed events rollback the deactivation
+	bus.err = errors.New("kafka unavailable")
+	assert.NotNil(t, svc.Delete(owner, "user-1"))
+	assert.True(t, repo.users["user-1"].Enabled)
+
+	bus.err = nil
+	assert.Nil(t, svc.Delete(owner, "user-1"))
+	assert.False(t, repo.users["user-1"].Enabled)
+
+	// Only administrators may restore, list and hard-delete users
+	assert.True(t, errors.Is(svc.Restore(owner, "user-1"), domain.ErrForbidden))
+	_, _, err := svc.List(owner, "", "", core.FilterParams{})
+	assert.True(t, errors.Is(err, domain.ErrForbidden))
+	assert.True(t, errors.Is(svc.HardDelete(owner, "user-1"), domain.ErrForbidden))
+
+	assert.Nil(t, svc.Restore(admin, "user-1"))
+	assert.True(t, repo.users["user-1"].Enabled)
+
+	// Users are kept if the hard-delete event could not be published
+	bus.err = errors.New("kafka unavailable")
+	assert.NotNil(t, svc.HardDelete(admin, "user-1"))
+	assert.True(t, repo.users["user-1"].Enabled)
+
+	bus.err = nil
+	assert.Nil(t, svc.HardDelete(admin, "user-1"))
+	assert.Empty(t, repo.users)
+	assert.Equal(t, []string{domain.UserRemoved, domain.UserRestored, domain.UserHardRemoved}, bus.events)
+}
+
+func TestUser_Get(t *testing.T) {
+	svc, _, _ := newUserStub()
+
+	user, err := svc.Get(context.Background(), "user-1")
+	assert.Nil(t, err)
+	assert.Empty(t, user.Email)
+
+	user, err = svc.Get(domain.WithIdentity(context.Background(), domain.Identity{Subject: "user-1"}), "user-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "a@example.com", user.Email)
+}
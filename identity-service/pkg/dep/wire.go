@@ -4,28 +4,71 @@ package dep
 
 import (
 	"context"
+	oczipkin "contrib.go.opencensus.io/exporter/zipkin"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/tracer"
+	"github.com/alexandria-oss/core/transport"
 	"github.com/alexandria-oss/core/transport/proxy"
 	"github.com/go-kit/kit/log"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/identity-service/internal/dependency"
-	"github.com/maestre3d/alexandria/identity-service/pkg/service"
+	"github.com/maestre3d/alexandria/identity-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity"
+	identityusecase "github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
 	"github.com/maestre3d/alexandria/identity-service/pkg/transport/bind"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	"github.com/openzipkin/zipkin-go"
+	"github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/reporter"
+	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 var Ctx context.Context = context.Background()
 
-var userSAGAInteractorSet = wire.NewSet(
+var interactorSet = wire.NewSet(
+	provideContext,
 	logger.NewZapLogger,
-	provideUserSAGAInteractor,
+	provideUserInteractor,
+	provideIdentityInteractor,
 )
-var eventProxySet = wire.NewSet(
-	userSAGAInteractorSet,
-	provideContext,
+
+var zipkinSet = wire.NewSet(
+	provideZipkinReporter,
+	provideZipkinEndpoint,
+	provideZipkinTracer,
+)
+
+var httpProxySet = wire.NewSet(
+	interactorSet,
 	config.NewKernel,
+	zipkinSet,
+	tracer.WrapZipkinOpenTracing,
+	auth.NewAuthenticator,
+	bind.NewUserHTTP,
+	provideIdentityHTTP,
+	provideHTTPHandlers,
+	proxy.NewHTTP,
+)
+
+var rpcProxySet = wire.NewSet(
+	bind.NewUserRPC,
+	bind.NewHealthRPC,
+	provideRPCServers,
+	provideRPCPolicy,
+	provideRPCProxy,
+)
+
+var eventProxySet = wire.NewSet(
+	provideMessageStore,
+	provideUserSAGAInteractor,
 	bind.NewUserEventConsumer,
 	provideEventConsumers,
 	proxy.NewEvent,
@@ -35,23 +78,166 @@ func provideContext() context.Context {
 	return Ctx
 }
 
-func provideUserSAGAInteractor(logger log.Logger) (usecase.UserSAGAInteractor, error) {
-	dependency.Ctx = Ctx
-	userUseCase, err := dependency.InjectUserSAGAUseCase()
+func provideUserInteractor(ctx context.Context, logger log.Logger) (usecase.UserInteractor, func(), error) {
+	dependency.Ctx = ctx
+	userUseCase, cleanup, err := dependency.InjectUserUseCase()
+
+	userService := user.WrapUserInstrumentation(userUseCase, logger)
+
+	return userService, cleanup, err
+}
+
+func provideUserSAGAInteractor(ctx context.Context, logger log.Logger) (usecase.UserSAGAInteractor, func(), error) {
+	dependency.Ctx = ctx
+	userUseCase, cleanup, err := dependency.InjectUserSAGAUseCase()
 
 	userService := user.WrapUserSAGAInstrumentation(userUseCase, logger)
 
-	return userService, err
+	return userService, cleanup, err
+}
+
+// provideIdentityInteractor returns nil if the local identity provider is not used, Cognito signs users in by itself
+func provideIdentityInteractor(ctx context.Context, _ *config.Kernel, logger log.Logger) (identityusecase.IdentityInteractor, func(), error) {
+	// Kernel is required to read the configured provider
+	if infrastructure.IdentityProvider() != infrastructure.IdentityLocal {
+		return nil, func() {}, nil
+	}
+
+	dependency.Ctx = ctx
+	identityUseCase, cleanup, err := dependency.InjectIdentityUseCase()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identityService := identity.WrapIdentityInstrumentation(identityUseCase, logger)
+
+	return identityService, cleanup, nil
+}
+
+func provideIdentityHTTP(svc identityusecase.IdentityInteractor, logger log.Logger, tracer stdopentracing.Tracer,
+	zipkinTracer *zipkin.Tracer) *bind.IdentityHandler {
+	if svc == nil {
+		return nil
+	}
+
+	return bind.NewIdentityHTTP(svc, logger, tracer, zipkinTracer)
+}
+
+func provideMessageStore(ctx context.Context) (messaging.Store, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMessageStore()
+}
+
+// Bind/Map used http handlers
+func provideHTTPHandlers(userHandler *bind.UserHandler, identityHandler *bind.IdentityHandler,
+	authenticator *auth.Authenticator) []proxy.Handler {
+	handlers := make([]proxy.Handler, 0)
+	handlers = append(handlers, userHandler, authenticator)
+	// Only the local identity provider signs users in
+	if identityHandler != nil {
+		handlers = append(handlers, identityHandler)
+	}
+	return handlers
 }
 
+// Bind/Map used rpc servers
+func provideRPCServers(userServer *bind.UserRPCServer, healthServer *bind.HealthRPCServer) []proxy.RPCServer {
+	servers := make([]proxy.RPCServer, 0)
+	servers = append(servers, userServer, healthServer)
+	return servers
+}
+
+// Bind/Map access level of every rpc method
+func provideRPCPolicy() auth.Policy {
+	policy := auth.Policy{}
+	for _, p := range []auth.Policy{bind.UserRPCPolicy, bind.HealthRPCPolicy} {
+		for method, access := range p {
+			policy[method] = access
+		}
+	}
+	return policy
+}
+
+// provideRPCProxy replaces proxy.NewRPC to guard rpc methods, grpc-go v1.27 accepts a single
+// unary interceptor so callers are authenticated before go-kit's interceptor runs
+func provideRPCProxy(servers []proxy.RPCServer, authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	authorize := authenticator.UnaryServerInterceptor(policy)
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return authorize(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return kitgrpc.Interceptor(ctx, req, info, handler)
+		})
+	}))
+	for _, srv := range servers {
+		srv.SetRoutes(rpcServer)
+	}
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+// Bind/Map used event consumers
 func provideEventConsumers(userConsumer *bind.UserEventConsumer) []proxy.Consumer {
 	consumers := make([]proxy.Consumer, 0)
 	consumers = append(consumers, userConsumer)
 	return consumers
 }
 
-func InjectTransportService() (*service.Transport, func(), error) {
-	wire.Build(eventProxySet, service.NewTransport)
+/* ZIPKIN PROVIDERS */
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinReporter(cfg *config.Kernel) reporter.Reporter {
+	if cfg.Tracing.ZipkinHost != "" {
+		zipkinReporter := zipkinhttp.NewReporter(cfg.Tracing.ZipkinHost)
+
+		return zipkinReporter
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinEndpoint(cfg *config.Kernel) *model.Endpoint {
+	if cfg.Tracing.ZipkinEndpoint != "" {
+		zipkinEndpoint, err := zipkin.NewEndpoint(cfg.Service, cfg.Tracing.ZipkinEndpoint)
+		if err != nil {
+			return nil
+		}
+
+		return zipkinEndpoint
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinTracer(r reporter.Reporter, ep *model.Endpoint) (*zipkin.Tracer, func()) {
+	if r != nil && ep != nil {
+		// Start OpenCensus
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+		// Add Zipkin exporter
+		trace.RegisterExporter(oczipkin.NewExporter(r, ep))
+
+		zipkinTrace, err := zipkin.NewTracer(r, zipkin.WithLocalEndpoint(ep))
+		if err != nil {
+			return nil, nil
+		}
+		cleanup := func() {
+			_ = r.Close()
+		}
+
+		return zipkinTrace, cleanup
+	}
+
+	return nil, nil
+}
+
+func InjectTransportService() (*transport.Transport, func(), error) {
+	wire.Build(httpProxySet, rpcProxySet, eventProxySet, transport.NewTransport)
 
-	return &service.Transport{}, nil, nil
+	return &transport.Transport{}, nil, nil
 }
@@ -7,39 +7,114 @@ package dep
 
 import (
 	"context"
+	zipkin2 "contrib.go.opencensus.io/exporter/zipkin"
 	"github.com/alexandria-oss/core/config"
 	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/tracer"
+	"github.com/alexandria-oss/core/transport"
 	"github.com/alexandria-oss/core/transport/proxy"
 	"github.com/go-kit/kit/log"
+	grpc2 "github.com/go-kit/kit/transport/grpc"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/identity-service/internal/dependency"
-	"github.com/maestre3d/alexandria/identity-service/pkg/service"
+	"github.com/maestre3d/alexandria/identity-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity"
+	usecase2 "github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
 	"github.com/maestre3d/alexandria/identity-service/pkg/transport/bind"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/opentracing/opentracing-go"
+	"github.com/openzipkin/zipkin-go"
+	"github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/reporter"
+	"github.com/openzipkin/zipkin-go/reporter/http"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 // Injectors from wire.go:
 
-func InjectTransportService() (*service.Transport, func(), error) {
+func InjectTransportService() (*transport.Transport, func(), error) {
 	context := provideContext()
+	logLogger := logger.NewZapLogger()
+	userInteractor, cleanup, err := provideUserInteractor(context, logLogger)
+	if err != nil {
+		return nil, nil, err
+	}
 	kernel, err := config.NewKernel(context)
 	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	reporter := provideZipkinReporter(kernel)
+	endpoint := provideZipkinEndpoint(kernel)
+	zipkinTracer, cleanup2 := provideZipkinTracer(reporter, endpoint)
+	opentracingTracer := tracer.WrapZipkinOpenTracing(kernel, zipkinTracer)
+	userRPCServer := bind.NewUserRPC(userInteractor, logLogger, opentracingTracer, zipkinTracer)
+	healthRPCServer := bind.NewHealthRPC()
+	v := provideRPCServers(userRPCServer, healthRPCServer)
+	authenticator, err := auth.NewAuthenticator(logLogger)
+	if err != nil {
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	logLogger := logger.NewZapLogger()
-	userSAGAInteractor, err := provideUserSAGAInteractor(logLogger)
+	policy := provideRPCPolicy()
+	server, cleanup3 := provideRPCProxy(v, authenticator, policy)
+	userHandler := bind.NewUserHTTP(userInteractor, logLogger, opentracingTracer, zipkinTracer)
+	identityInteractor, cleanup4, err := provideIdentityInteractor(context, kernel, logLogger)
 	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	identityHandler := provideIdentityHTTP(identityInteractor, logLogger, opentracingTracer, zipkinTracer)
+	v2 := provideHTTPHandlers(userHandler, identityHandler, authenticator)
+	http, cleanup5 := proxy.NewHTTP(kernel, v2...)
+	userSAGAInteractor, cleanup6, err := provideUserSAGAInteractor(context, logLogger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	userEventConsumer := bind.NewUserEventConsumer(userSAGAInteractor, logLogger, kernel)
-	v := provideEventConsumers(userEventConsumer)
-	event, cleanup, err := proxy.NewEvent(context, kernel, v...)
+	store, cleanup7, err := provideMessageStore(context)
 	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userEventConsumer := bind.NewUserEventConsumer(userSAGAInteractor, logLogger, kernel, store)
+	v3 := provideEventConsumers(userEventConsumer)
+	event, cleanup8, err := proxy.NewEvent(context, kernel, v3...)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
-	transport := service.NewTransport(event, kernel)
-	return transport, func() {
+	transportTransport := transport.NewTransport(server, http, event, kernel)
+	return transportTransport, func() {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -48,28 +123,185 @@ func InjectTransportService() (*service.Transport, func(), error) {
 
 var Ctx context.Context = context.Background()
 
-var userSAGAInteractorSet = wire.NewSet(logger.NewZapLogger, provideUserSAGAInteractor)
+var interactorSet = wire.NewSet(
+	provideContext, logger.NewZapLogger, provideUserInteractor,
+	provideIdentityInteractor,
+)
+
+var zipkinSet = wire.NewSet(
+	provideZipkinReporter,
+	provideZipkinEndpoint,
+	provideZipkinTracer,
+)
+
+var httpProxySet = wire.NewSet(
+	interactorSet, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, auth.NewAuthenticator, bind.NewUserHTTP, provideIdentityHTTP,
+	provideHTTPHandlers, proxy.NewHTTP,
+)
+
+var rpcProxySet = wire.NewSet(bind.NewUserRPC, bind.NewHealthRPC, provideRPCServers,
+	provideRPCPolicy,
+	provideRPCProxy,
+)
 
 var eventProxySet = wire.NewSet(
-	userSAGAInteractorSet,
-	provideContext, config.NewKernel, bind.NewUserEventConsumer, provideEventConsumers, proxy.NewEvent,
+	provideMessageStore,
+	provideUserSAGAInteractor, bind.NewUserEventConsumer, provideEventConsumers, proxy.NewEvent,
 )
 
 func provideContext() context.Context {
 	return Ctx
 }
 
-func provideUserSAGAInteractor(logger2 log.Logger) (usecase.UserSAGAInteractor, error) {
-	dependency.Ctx = Ctx
-	userUseCase, err := dependency.InjectUserSAGAUseCase()
+func provideUserInteractor(ctx context.Context, logger2 log.Logger) (usecase.UserInteractor, func(), error) {
+	dependency.Ctx = ctx
+	userUseCase, cleanup, err := dependency.InjectUserUseCase()
+
+	userService := user.WrapUserInstrumentation(userUseCase, logger2)
+
+	return userService, cleanup, err
+}
+
+func provideUserSAGAInteractor(ctx context.Context, logger2 log.Logger) (usecase.UserSAGAInteractor, func(), error) {
+	dependency.Ctx = ctx
+	userUseCase, cleanup, err := dependency.InjectUserSAGAUseCase()
 
 	userService := user.WrapUserSAGAInstrumentation(userUseCase, logger2)
 
-	return userService, err
+	return userService, cleanup, err
+}
+
+// provideIdentityInteractor returns nil if the local identity provider is not used, Cognito signs users in by itself
+func provideIdentityInteractor(ctx context.Context, _ *config.Kernel, logger2 log.Logger) (usecase2.IdentityInteractor, func(), error) {
+
+	if infrastructure.IdentityProvider() != infrastructure.IdentityLocal {
+		return nil, func() {}, nil
+	}
+	dependency.Ctx = ctx
+	identityUseCase, cleanup, err := dependency.InjectIdentityUseCase()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identityService := identity.WrapIdentityInstrumentation(identityUseCase, logger2)
+
+	return identityService, cleanup, nil
+}
+
+func provideIdentityHTTP(svc usecase2.IdentityInteractor, logger2 log.Logger, tracer2 opentracing.Tracer,
+	zipkinTracer *zipkin.Tracer) *bind.IdentityHandler {
+	if svc == nil {
+		return nil
+	}
+
+	return bind.NewIdentityHTTP(svc, logger2, tracer2, zipkinTracer)
+}
+
+func provideMessageStore(ctx context.Context) (messaging.Store, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMessageStore()
+}
+
+// Bind/Map used http handlers
+func provideHTTPHandlers(userHandler *bind.UserHandler, identityHandler *bind.IdentityHandler,
+	authenticator *auth.Authenticator) []proxy.Handler {
+	handlers := make([]proxy.Handler, 0)
+	handlers = append(handlers, userHandler, authenticator)
+
+	if identityHandler != nil {
+		handlers = append(handlers, identityHandler)
+	}
+	return handlers
+}
+
+// Bind/Map used rpc servers
+func provideRPCServers(userServer *bind.UserRPCServer, healthServer *bind.HealthRPCServer) []proxy.RPCServer {
+	servers := make([]proxy.RPCServer, 0)
+	servers = append(servers, userServer, healthServer)
+	return servers
+}
+
+// Bind/Map access level of every rpc method
+func provideRPCPolicy() auth.Policy {
+	policy := auth.Policy{}
+	for _, p := range []auth.Policy{bind.UserRPCPolicy, bind.HealthRPCPolicy} {
+		for method, access := range p {
+			policy[method] = access
+		}
+	}
+	return policy
 }
 
+// provideRPCProxy replaces proxy.NewRPC to guard rpc methods, grpc-go v1.27 accepts a single
+// unary interceptor so callers are authenticated before go-kit's interceptor runs
+func provideRPCProxy(servers []proxy.RPCServer, authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	authorize := authenticator.UnaryServerInterceptor(policy)
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return authorize(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return grpc2.Interceptor(ctx, req, info, handler)
+		})
+	}))
+	for _, srv := range servers {
+		srv.SetRoutes(rpcServer)
+	}
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+// Bind/Map used event consumers
 func provideEventConsumers(userConsumer *bind.UserEventConsumer) []proxy.Consumer {
 	consumers := make([]proxy.Consumer, 0)
 	consumers = append(consumers, userConsumer)
 	return consumers
 }
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinReporter(cfg *config.Kernel) reporter.Reporter {
+	if cfg.Tracing.ZipkinHost != "" {
+		zipkinReporter := http.NewReporter(cfg.Tracing.ZipkinHost)
+
+		return zipkinReporter
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinEndpoint(cfg *config.Kernel) *model.Endpoint {
+	if cfg.Tracing.ZipkinEndpoint != "" {
+		zipkinEndpoint, err := zipkin.NewEndpoint(cfg.Service, cfg.Tracing.ZipkinEndpoint)
+		if err != nil {
+			return nil
+		}
+
+		return zipkinEndpoint
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinTracer(r reporter.Reporter, ep *model.Endpoint) (*zipkin.Tracer, func()) {
+	if r != nil && ep != nil {
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+		trace.RegisterExporter(zipkin2.NewExporter(r, ep))
+
+		zipkinTrace, err := zipkin.NewTracer(r, zipkin.WithLocalEndpoint(ep))
+		if err != nil {
+			return nil, nil
+		}
+		cleanup := func() {
+			_ = r.Close()
+		}
+
+		return zipkinTrace, cleanup
+	}
+
+	return nil, nil
+}
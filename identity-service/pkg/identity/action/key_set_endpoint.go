@@ -0,0 +1,56 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type KeySetRequest struct{}
+
+// KeySetResponse JSON Web Key Set document, encoded as is
+type KeySetResponse struct {
+	KeySet json.RawMessage `json:"-"`
+	Err    error           `json:"-"`
+}
+
+func MakeKeySetEndpoint(svc usecase.IdentityInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		keySet, err := svc.KeySet(ctx)
+		if err != nil {
+			return KeySetResponse{
+				KeySet: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return KeySetResponse{
+			KeySet: keySet,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "key_set"
+	ep = middleware.WrapResiliency(ep, "identity", action)
+	return middleware.WrapInstrumentation(ep, "identity", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = KeySetResponse{}
+)
+
+func (r KeySetResponse) Failed() error { return r.Err }
@@ -0,0 +1,59 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type SignInRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type SignInResponse struct {
+	Token *domain.Token `json:"token"`
+	Err   error         `json:"-"`
+}
+
+func MakeSignInEndpoint(svc usecase.IdentityInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(SignInRequest)
+		token, err := svc.SignIn(ctx, req.Username, req.Password)
+		if err != nil {
+			return SignInResponse{
+				Token: nil,
+				Err:   err,
+			}, nil
+		}
+
+		return SignInResponse{
+			Token: token,
+			Err:   nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "sign_in"
+	ep = middleware.WrapResiliency(ep, "identity", action)
+	return middleware.WrapInstrumentation(ep, "identity", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = SignInResponse{}
+)
+
+func (r SignInResponse) Failed() error { return r.Err }
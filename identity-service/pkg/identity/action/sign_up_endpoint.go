@@ -0,0 +1,68 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type SignUpRequest struct {
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	Name      string `json:"name"`
+	GivenName string `json:"given_name"`
+}
+
+type SignUpResponse struct {
+	User *domain.User `json:"user"`
+	Err  error        `json:"-"`
+}
+
+func MakeSignUpEndpoint(svc usecase.IdentityInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(SignUpRequest)
+		user, err := svc.SignUp(ctx, &domain.UserAggregate{
+			Username:  req.Username,
+			Email:     req.Email,
+			Password:  req.Password,
+			Name:      req.Name,
+			GivenName: req.GivenName,
+		})
+		if err != nil {
+			return SignUpResponse{
+				User: nil,
+				Err:  err,
+			}, nil
+		}
+
+		return SignUpResponse{
+			User: user,
+			Err:  nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "sign_up"
+	ep = middleware.WrapResiliency(ep, "identity", action)
+	return middleware.WrapInstrumentation(ep, "identity", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = SignUpResponse{}
+)
+
+func (r SignUpResponse) Failed() error { return r.Err }
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	"time"
+)
+
+// LoggingIdentityMiddleware never logs passwords nor issued tokens
+type LoggingIdentityMiddleware struct {
+	Logger log.Logger
+	Next   usecase.IdentityInteractor
+}
+
+func (mw LoggingIdentityMiddleware) SignUp(ctx context.Context, aggregate *domain.UserAggregate) (output *domain.User, err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "identity.sign_up",
+			"input", fmt.Sprintf("username: %s, email: %s, name: %s, given_name: %s", aggregate.Username,
+				aggregate.Email, aggregate.Name, aggregate.GivenName),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.SignUp(ctx, aggregate)
+	return
+}
+
+func (mw LoggingIdentityMiddleware) SignIn(ctx context.Context, username, password string) (output *domain.Token, err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "identity.sign_in",
+			"input", fmt.Sprintf("username: %s", username),
+			"output", fmt.Sprintf("issued: %t", output != nil),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.SignIn(ctx, username, password)
+	return
+}
+
+func (mw LoggingIdentityMiddleware) KeySet(ctx context.Context) (output []byte, err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "identity.key_set",
+			"input", "",
+			"output", string(output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.KeySet(ctx)
+	return
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	"time"
+)
+
+type MetricIdentityMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.IdentityInteractor
+}
+
+func (mw MetricIdentityMiddleware) SignUp(ctx context.Context, aggregate *domain.UserAggregate) (output *domain.User, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "identity.sign_up", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.SignUp(ctx, aggregate)
+	return
+}
+
+func (mw MetricIdentityMiddleware) SignIn(ctx context.Context, username, password string) (output *domain.Token, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "identity.sign_in", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.SignIn(ctx, username, password)
+	return
+}
+
+func (mw MetricIdentityMiddleware) KeySet(ctx context.Context) (output []byte, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "identity.key_set", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.KeySet(ctx)
+	return
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+)
+
+// IdentityInteractor local identity provider, only available if alexandria.identity.provider is local
+type IdentityInteractor interface {
+	SignUp(ctx context.Context, aggregate *domain.UserAggregate) (*domain.User, error)
+	SignIn(ctx context.Context, username, password string) (*domain.Token, error)
+	KeySet(ctx context.Context) ([]byte, error)
+}
@@ -0,0 +1,42 @@
+package identity
+
+import (
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/middleware"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// WrapIdentityInstrumentation Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapIdentityInstrumentation(identityUseCase usecase.IdentityInteractor, logger log.Logger) usecase.IdentityInteractor {
+	// Inject prometheus and OpenCensus metrics
+	// Inject logger
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "identity_service",
+		Name:        "identity_request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "identity_service",
+		Name:        "identity_request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.IdentityInteractor
+	svc = identityUseCase
+	svc = middleware.LoggingIdentityMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricIdentityMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
@@ -0,0 +1,32 @@
+package bind
+
+import (
+	"context"
+	"github.com/maestre3d/alexandria/identity-service/pb"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"google.golang.org/grpc"
+)
+
+// HealthRPCPolicy health checks are public
+var HealthRPCPolicy = auth.Policy{
+	"/pb.Health/Check": auth.Public,
+}
+
+type HealthRPCServer struct {
+	srv pb.HealthServer
+}
+
+// Compile-time RPC implementation
+type healthRPCImp struct{}
+
+func NewHealthRPC() *HealthRPCServer {
+	return &HealthRPCServer{healthRPCImp{}}
+}
+
+func (a HealthRPCServer) SetRoutes(srv *grpc.Server) {
+	pb.RegisterHealthServer(srv, a.srv)
+}
+
+func (a healthRPCImp) Check(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
+	return &pb.HealthCheckResponse{Status: pb.HealthCheckResponse_SERVING}, nil
+}
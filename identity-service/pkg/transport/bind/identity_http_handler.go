@@ -0,0 +1,178 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/action"
+	"github.com/maestre3d/alexandria/identity-service/pkg/identity/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strings"
+)
+
+// IdentityHandler exposes the local identity provider, every route is public
+type IdentityHandler struct {
+	service      usecase.IdentityInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewIdentityHTTP(svc usecase.IdentityInteractor, logger log.Logger, tracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer) *IdentityHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "identity_service",
+		Name:        "identity_request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("identity_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &IdentityHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (h *IdentityHandler) SetRoutes(public, _, _ *mux.Router) {
+	r := public.PathPrefix("/identity").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("/sign-up").Methods(http.MethodPost).Handler(h.SignUp())
+	r.Path("/sign-in").Methods(http.MethodPost).Handler(h.SignIn())
+	r.Path("/.well-known/jwks.json").Methods(http.MethodGet).Handler(h.KeySet())
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+func (h *IdentityHandler) SignUp() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeSignUpEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeSignUpRequest,
+		encodeSignUpResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "SignUp", h.logger)))...,
+	)
+}
+
+func (h *IdentityHandler) SignIn() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeSignInEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeSignInRequest,
+		encodeSignInResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "SignIn", h.logger)))...,
+	)
+}
+
+func (h *IdentityHandler) KeySet() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeKeySetEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeKeySetRequest,
+		encodeKeySetResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "KeySet", h.logger)))...,
+	)
+}
+
+/* Decode HTTP Request */
+
+func decodeSignUpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		var bodyJSON action.SignUpRequest
+		if err := json.NewDecoder(r.Body).Decode(&bodyJSON); err == nil {
+			return bodyJSON, nil
+		}
+	}
+
+	return action.SignUpRequest{
+		Username:  r.FormValue("username"),
+		Email:     r.FormValue("email"),
+		Password:  r.FormValue("password"),
+		Name:      r.FormValue("name"),
+		GivenName: r.FormValue("given_name"),
+	}, nil
+}
+
+func decodeSignInRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		var bodyJSON action.SignInRequest
+		if err := json.NewDecoder(r.Body).Decode(&bodyJSON); err == nil {
+			return bodyJSON, nil
+		}
+	}
+
+	// Credentials are never read from the query string
+	return action.SignInRequest{
+		Username: r.PostFormValue("username"),
+		Password: r.PostFormValue("password"),
+	}, nil
+}
+
+func decodeKeySetRequest(_ context.Context, _ *http.Request) (interface{}, error) {
+	return action.KeySetRequest{}, nil
+}
+
+/* Encode HTTP Response */
+
+func encodeSignUpResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.SignUpResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeSignInResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	r, ok := response.(action.SignInResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeKeySetResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.KeySetResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	_, err := w.Write(r.KeySet)
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/go-kit/kit/log/level"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	openzipkin "github.com/openzipkin/zipkin-go"
 	zipkinHTTP "github.com/openzipkin/zipkin-go/reporter/http"
 	"github.com/sony/gobreaker"
@@ -22,9 +23,10 @@ type UserEventConsumer struct {
 	svc    usecase.UserSAGAInteractor
 	logger log.Logger
 	cfg    *config.Kernel
+	guard  *messaging.Guard
 }
 
-func NewUserEventConsumer(svc usecase.UserSAGAInteractor, logger log.Logger, cfg *config.Kernel) *UserEventConsumer {
+func NewUserEventConsumer(svc usecase.UserSAGAInteractor, logger log.Logger, cfg *config.Kernel, store messaging.Store) *UserEventConsumer {
 	// Set up trace exporters, this is meant to be done inside the transport injection, but currently
 	// this service contains just event consumers, this is an exception and a custom implementation.
 	// We avoid more injections inside this factory method because the trace exporter is used by just this transport
@@ -45,6 +47,7 @@ func NewUserEventConsumer(svc usecase.UserSAGAInteractor, logger log.Logger, cfg
 		svc:    svc,
 		logger: logger,
 		cfg:    cfg,
+		guard:  messaging.NewGuard(store, "identity", logger),
 	}
 }
 
@@ -173,6 +176,9 @@ func (c *UserEventConsumer) bindBlobRemoved(ctx context.Context, service string)
 func (c *UserEventConsumer) onOwnerVerify(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_owner_verify") {
+		return
+	}
 	// Get span context from message
 	var traceCtx trace.SpanContext
 	err := json.Unmarshal([]byte(r.Message.Metadata["tracing_context"]), &traceCtx)
@@ -199,17 +205,21 @@ func (c *UserEventConsumer) onOwnerVerify(r *eventbus.Request) {
 		// If internal error, do nack
 		if code := httputil.ErrorToCode(err); code == 500 {
 			if r.Message.Nackable() {
+				c.guard.Release(r.Context, eC)
 				r.Message.Nack()
 				return
 			}
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
 
 func (c *UserEventConsumer) onBlobUploaded(r *eventbus.Request) {
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_blob_uploaded") {
+		return
+	}
 	// Get span context from message
 	var traceCtx trace.SpanContext
 	err := json.Unmarshal([]byte(r.Message.Metadata["tracing_context"]), &traceCtx)
@@ -236,17 +246,21 @@ func (c *UserEventConsumer) onBlobUploaded(r *eventbus.Request) {
 		// If internal error, do nack
 		if code := httputil.ErrorToCode(err); code == 500 {
 			if r.Message.Nackable() {
+				c.guard.Release(r.Context, eC)
 				r.Message.Nack()
 				return
 			}
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
 
 func (c *UserEventConsumer) onBlobRemoved(r *eventbus.Request) {
 	eC := extractContext(r)
+	if !c.guard.Acquire(r, eC, "on_blob_removed") {
+		return
+	}
 	// Get span context from message
 	var traceCtx trace.SpanContext
 	err := json.Unmarshal([]byte(r.Message.Metadata["tracing_context"]), &traceCtx)
@@ -273,11 +287,12 @@ func (c *UserEventConsumer) onBlobRemoved(r *eventbus.Request) {
 		// If internal error, do nack
 		if code := httputil.ErrorToCode(err); code == 500 {
 			if r.Message.Nackable() {
+				c.guard.Release(r.Context, eC)
 				r.Message.Nack()
 				return
 			}
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, eC)
 }
@@ -0,0 +1,287 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/identity-service/pkg/user/action"
+	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strings"
+)
+
+type UserHandler struct {
+	service      usecase.UserInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewUserHTTP(svc usecase.UserInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *UserHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "identity_service",
+		Name:        "request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		// Zipkin HTTP Server Trace can either be instantiated per endpoint with a
+		// provided operation name or a global tracing usecase can be instantiated
+		// without an operation name and fed to each Go kit endpoint as ServerOption.
+		// In the latter case, the operation name will be the endpoint's http method.
+		// We demonstrate a global tracing usecase here.
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("identity_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &UserHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (h *UserHandler) SetRoutes(public, private, admin *mux.Router) {
+	// Admin routing
+	arouter := admin.PathPrefix("/user").Subrouter()
+	arouter.Methods(http.MethodOptions)
+	arouter.Path("").Methods(http.MethodGet).Handler(h.List())
+	arouter.Path("/").Methods(http.MethodGet).Handler(h.List())
+
+	arouter.Path("/{id}").Methods(http.MethodDelete).Handler(h.HardDelete())
+	arouter.Path("/{id}/restore").Methods(http.MethodPatch).Handler(h.Restore())
+	arouter.Use(mux.CORSMethodMiddleware(arouter))
+
+	// Private routing
+	pRouter := private.PathPrefix("/user").Subrouter()
+	pRouter.Methods(http.MethodOptions)
+	pRouter.Path("/{id}").Methods(http.MethodPatch, http.MethodPut).Handler(h.Update())
+	pRouter.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
+	pRouter.Use(mux.CORSMethodMiddleware(pRouter))
+
+	// Public routing
+	r := public.PathPrefix("/user").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("/{id}").Methods(http.MethodGet).Handler(h.Get())
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+func (h *UserHandler) List() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeListUserEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeListRequest,
+		encodeListResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "List", h.logger)))...,
+	)
+}
+
+func (h *UserHandler) Get() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeGetUserEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeGetRequest,
+		encodeGetResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger)))...,
+	)
+}
+
+func (h *UserHandler) Update() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeUpdateUserEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeUpdateRequest,
+		encodeUpdateResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Update", h.logger)))...,
+	)
+}
+
+func (h *UserHandler) Delete() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeDeleteUserEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeDeleteRequest,
+		encodeDeleteResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Delete", h.logger)))...,
+	)
+}
+
+func (h *UserHandler) Restore() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeRestoreUserEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeRestoreRequest,
+		encodeRestoreResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Restore", h.logger)))...,
+	)
+}
+
+func (h *UserHandler) HardDelete() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeHardDeleteUserEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeHardDeleteRequest,
+		encodeHardDeleteResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Hard_Delete", h.logger)))...,
+	)
+}
+
+/* Decode HTTP Request */
+
+func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.ListRequest{
+		PageToken: r.URL.Query().Get("page_token"),
+		PageSize:  r.URL.Query().Get("page_size"),
+		FilterParams: core.FilterParams{
+			"username":   r.URL.Query().Get("username"),
+			"email":      r.URL.Query().Get("email"),
+			"name":       r.URL.Query().Get("name"),
+			"given_name": r.URL.Query().Get("given_name"),
+			"status":     r.URL.Query().Get("status"),
+		},
+	}, nil
+}
+
+func decodeGetRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.GetRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
+func decodeUpdateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		var bodyJSON action.UpdateRequest
+		err := json.NewDecoder(r.Body).Decode(&bodyJSON)
+		if err == nil {
+			bodyJSON.ID = mux.Vars(r)["id"]
+			return bodyJSON, nil
+		}
+	}
+
+	return action.UpdateRequest{
+		ID:        mux.Vars(r)["id"],
+		Name:      r.FormValue("name"),
+		GivenName: r.FormValue("given_name"),
+		Picture:   r.FormValue("picture"),
+	}, nil
+}
+
+func decodeDeleteRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.DeleteRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
+func decodeRestoreRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.RestoreRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
+func decodeHardDeleteRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.HardDeleteRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
+/* Encode HTTP Response */
+
+func encodeListResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.ListResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		} else if r.Err == nil && len(r.Users) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntitiesNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.GetResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		} else if r.Err == nil && r.User == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntityNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeUpdateResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.UpdateResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeDeleteResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.DeleteResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeRestoreResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.RestoreResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeHardDeleteResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.HardDeleteResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
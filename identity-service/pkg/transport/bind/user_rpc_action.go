@@ -0,0 +1,299 @@
+package bind
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/maestre3d/alexandria/identity-service/pb"
+	"github.com/maestre3d/alexandria/identity-service/pkg/user/action"
+	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	"github.com/maestre3d/alexandria/shared/auth"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserRPCPolicy access level of each User method, mirrors the HTTP public, private and admin routes
+var UserRPCPolicy = auth.Policy{
+	"/pb.User/List":       auth.Admin,
+	"/pb.User/Get":        auth.Public,
+	"/pb.User/Update":     auth.Private,
+	"/pb.User/Delete":     auth.Private,
+	"/pb.User/Restore":    auth.Admin,
+	"/pb.User/HardDelete": auth.Admin,
+}
+
+type UserRPCServer struct {
+	srv pb.UserServer
+}
+
+// Compile-time RPC implementation
+type userRPCImp struct {
+	list       grpctransport.Handler
+	get        grpctransport.Handler
+	update     grpctransport.Handler
+	delete     grpctransport.Handler
+	restore    grpctransport.Handler
+	hardDelete grpctransport.Handler
+}
+
+func NewUserRPC(svc usecase.UserInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *UserRPCServer {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "rpc_identity_service",
+		Name:        "request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		// Zipkin HTTP Server Trace can either be instantiated per endpoint with a
+		// provided operation name or a global tracing usecase can be instantiated
+		// without an operation name and fed to each Go kit endpoint as ServerOption.
+		// In the latter case, the operation name will be the endpoint's http method.
+		// We demonstrate a global tracing usecase here.
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("identity_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	srv := userRPCImp{
+		list: grpctransport.NewServer(
+			action.MakeListUserEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCListRequest,
+			encodeRPCListResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "List", logger)))...,
+		),
+		get: grpctransport.NewServer(
+			action.MakeGetUserEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCGetRequest,
+			encodeRPCGetResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Get", logger)))...,
+		),
+		update: grpctransport.NewServer(
+			action.MakeUpdateUserEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCUpdateRequest,
+			encodeRPCUpdateResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Update", logger)))...,
+		),
+		delete: grpctransport.NewServer(
+			action.MakeDeleteUserEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCDeleteRequest,
+			encodeRPCDeleteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Delete", logger)))...,
+		),
+		restore: grpctransport.NewServer(
+			action.MakeRestoreUserEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCRestoreRequest,
+			encodeRPCRestoreResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Restore", logger)))...,
+		),
+		hardDelete: grpctransport.NewServer(
+			action.MakeHardDeleteUserEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCHardDeleteRequest,
+			encodeRPCHardDeleteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "HardDelete", logger)))...,
+		),
+	}
+
+	return &UserRPCServer{srv}
+}
+
+func (a UserRPCServer) SetRoutes(srv *grpc.Server) {
+	pb.RegisterUserServer(srv, a.srv)
+}
+
+/* RPC Action Binding/Implementations */
+
+func (a userRPCImp) List(ctx context.Context, req *pb.ListRequest) (*pb.UserListResponse, error) {
+	_, rep, err := a.list.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.UserListResponse), nil
+}
+
+func (a userRPCImp) Get(ctx context.Context, req *pb.IDRequest) (*pb.UserMessage, error) {
+	_, rep, err := a.get.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.UserMessage), nil
+}
+
+func (a userRPCImp) Update(ctx context.Context, req *pb.UserUpdateRequest) (*pb.UserMessage, error) {
+	_, rep, err := a.update.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.UserMessage), nil
+}
+
+func (a userRPCImp) Delete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
+	_, rep, err := a.delete.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.Empty), nil
+}
+
+func (a userRPCImp) Restore(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
+	_, rep, err := a.restore.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.Empty), nil
+}
+
+func (a userRPCImp) HardDelete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
+	_, rep, err := a.hardDelete.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.Empty), nil
+}
+
+/* Decoders */
+
+func decodeRPCListRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.ListRequest)
+	return action.ListRequest{
+		PageToken:    req.PageToken,
+		PageSize:     req.PageSize,
+		FilterParams: req.Filter,
+	}, nil
+}
+
+func decodeRPCGetRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.GetRequest{ID: req.Id}, nil
+}
+
+func decodeRPCUpdateRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.UserUpdateRequest)
+	return action.UpdateRequest{
+		ID:        req.Id,
+		Name:      req.Name,
+		GivenName: req.GivenName,
+		Picture:   req.Picture,
+	}, nil
+}
+
+func decodeRPCDeleteRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.DeleteRequest{ID: req.Id}, nil
+}
+
+func decodeRPCRestoreRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.RestoreRequest{ID: req.Id}, nil
+}
+
+func decodeRPCHardDeleteRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.HardDeleteRequest{ID: req.Id}, nil
+}
+
+/* Encoders */
+
+func encodeRPCListResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.ListResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if len(res.Users) == 0 {
+		return nil, status.Error(codes.NotFound, exception.EntitiesNotFound.Error())
+	}
+
+	usersRPC := make([]*pb.UserMessage, 0)
+	for _, user := range res.Users {
+		usersRPC = append(usersRPC, marshalUserRPC(user))
+	}
+
+	return &pb.UserListResponse{
+		Users:         usersRPC,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func encodeRPCGetResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.GetResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.User == nil {
+		return nil, status.Error(codes.NotFound, exception.EntityNotFound.Error())
+	}
+
+	return marshalUserRPC(res.User), nil
+}
+
+func encodeRPCUpdateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.UpdateResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.User == nil {
+		return nil, exception.EmptyBody
+	}
+
+	return marshalUserRPC(res.User), nil
+}
+
+func encodeRPCDeleteResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.DeleteResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &pb.Empty{}, nil
+}
+
+func encodeRPCRestoreResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.RestoreResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &pb.Empty{}, nil
+}
+
+func encodeRPCHardDeleteResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.HardDeleteResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	return &pb.Empty{}, nil
+}
+
+// marshalUserRPC maps a user to its RPC message, email is never exposed
+func marshalUserRPC(user *domain.User) *pb.UserMessage {
+	return &pb.UserMessage{
+		Id:         user.ID,
+		Username:   user.Username,
+		Name:       user.Name,
+		GivenName:  user.GivenName,
+		Picture:    user.Picture,
+		Enabled:    user.Enabled,
+		Status:     user.Status,
+		CreateTime: user.CreateTime.String(),
+		UpdateTime: user.UpdateTime.String(),
+	}
+}
@@ -0,0 +1,50 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type DeleteRequest struct {
+	ID string `json:"id"`
+}
+
+type DeleteResponse struct {
+	Err error `json:"-"`
+}
+
+func MakeDeleteUserEndpoint(svc usecase.UserInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(DeleteRequest)
+		err = svc.Delete(ctx, req.ID)
+		if err != nil {
+			return DeleteResponse{err}, nil
+		}
+
+		return DeleteResponse{nil}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "delete"
+	ep = middleware.WrapResiliency(ep, "user", action)
+	return middleware.WrapInstrumentation(ep, "user", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = DeleteResponse{}
+)
+
+func (r DeleteResponse) Failed() error { return r.Err }
@@ -18,7 +18,7 @@ type GetRequest struct {
 
 type GetResponse struct {
 	User *domain.User `json:"user"`
-	Err    error          `json:"-"`
+	Err  error        `json:"-"`
 }
 
 func MakeGetUserEndpoint(svc usecase.UserInteractor, logger log.Logger, duration metrics.Histogram,
@@ -29,13 +29,13 @@ func MakeGetUserEndpoint(svc usecase.UserInteractor, logger log.Logger, duration
 		if err != nil {
 			return GetResponse{
 				User: nil,
-				Err:    err,
+				Err:  err,
 			}, nil
 		}
 
 		return GetResponse{
 			User: user,
-			Err:    nil,
+			Err:  nil,
 		}, nil
 	}
 
@@ -43,10 +43,10 @@ func MakeGetUserEndpoint(svc usecase.UserInteractor, logger log.Logger, duration
 	action := "get"
 	ep = middleware.WrapResiliency(ep, "user", action)
 	return middleware.WrapInstrumentation(ep, "user", action, &middleware.WrapInstrumentParams{
-		logger,
-		duration,
-		tracer,
-		zipkinTracer,
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
 	})
 }
 
@@ -0,0 +1,66 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/identity-service/internal/domain"
+	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type UpdateRequest struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	GivenName string `json:"given_name"`
+	Picture   string `json:"picture"`
+}
+
+type UpdateResponse struct {
+	User *domain.User `json:"user"`
+	Err  error        `json:"-"`
+}
+
+func MakeUpdateUserEndpoint(svc usecase.UserInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UpdateRequest)
+		user, err := svc.Update(ctx, &domain.UserUpdateAggregate{
+			ID:        req.ID,
+			Name:      req.Name,
+			GivenName: req.GivenName,
+			Picture:   req.Picture,
+		})
+		if err != nil {
+			return UpdateResponse{
+				User: nil,
+				Err:  err,
+			}, nil
+		}
+
+		return UpdateResponse{
+			User: user,
+			Err:  nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "update"
+	ep = middleware.WrapResiliency(ep, "user", action)
+	return middleware.WrapInstrumentation(ep, "user", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = UpdateResponse{}
+)
+
+func (r UpdateResponse) Failed() error { return r.Err }
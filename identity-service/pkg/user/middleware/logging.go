@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"github.com/alexandria-oss/core"
 	"github.com/go-kit/kit/log"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
@@ -29,6 +30,82 @@ func (mw LoggingUserMiddleware) Get(ctx context.Context, id string) (output *dom
 	return
 }
 
+func (mw LoggingUserMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.User, nextToken string, err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "user.list",
+			"input", fmt.Sprintf("page_token: %s, page_size: %s, filter: %s", pageToken, pageSize, filterParams),
+			"output", fmt.Sprintf("%+v", output),
+			"next_token", nextToken,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	return
+}
+
+func (mw LoggingUserMiddleware) Update(ctx context.Context, aggregate *domain.UserUpdateAggregate) (output *domain.User, err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "user.update",
+			"input", fmt.Sprintf("%+v", aggregate),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Update(ctx, aggregate)
+	return
+}
+
+func (mw LoggingUserMiddleware) Delete(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "user.delete",
+			"input", fmt.Sprintf("id: %s", id),
+			"output", "",
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Delete(ctx, id)
+	return
+}
+
+func (mw LoggingUserMiddleware) Restore(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "user.restore",
+			"input", fmt.Sprintf("id: %s", id),
+			"output", "",
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Restore(ctx, id)
+	return
+}
+
+func (mw LoggingUserMiddleware) HardDelete(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "user.hard_delete",
+			"input", fmt.Sprintf("id: %s", id),
+			"output", "",
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.HardDelete(ctx, id)
+	return
+}
+
 type LoggingUserSAGAMiddleware struct {
 	Logger log.Logger
 	Next   usecase.UserSAGAInteractor
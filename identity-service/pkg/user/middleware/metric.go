@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"github.com/alexandria-oss/core"
 	"github.com/go-kit/kit/metrics"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 	"github.com/maestre3d/alexandria/identity-service/pkg/user/usecase"
@@ -26,6 +27,61 @@ func (mw MetricUserMiddleware) Get(ctx context.Context, id string) (output *doma
 	return
 }
 
+func (mw MetricUserMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.User, nextToken string, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "user.list", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	return
+}
+
+func (mw MetricUserMiddleware) Update(ctx context.Context, aggregate *domain.UserUpdateAggregate) (output *domain.User, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "user.update", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Update(ctx, aggregate)
+	return
+}
+
+func (mw MetricUserMiddleware) Delete(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "user.delete", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Delete(ctx, id)
+	return
+}
+
+func (mw MetricUserMiddleware) Restore(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "user.restore", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Restore(ctx, id)
+	return
+}
+
+func (mw MetricUserMiddleware) HardDelete(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "user.hard_delete", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.HardDelete(ctx, id)
+	return
+}
+
 type MetricUserSAGAMiddleware struct {
 	RequestCount   metrics.Counter
 	RequestLatency metrics.Histogram
@@ -2,11 +2,17 @@ package usecase
 
 import (
 	"context"
+	"github.com/alexandria-oss/core"
 	"github.com/maestre3d/alexandria/identity-service/internal/domain"
 )
 
 type UserInteractor interface {
 	Get(ctx context.Context, id string) (*domain.User, error)
+	List(ctx context.Context, pageToken, pageSize string, filter core.FilterParams) ([]*domain.User, string, error)
+	Update(ctx context.Context, aggregate *domain.UserUpdateAggregate) (*domain.User, error)
+	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	HardDelete(ctx context.Context, id string) error
 }
 
 type UserSAGAInteractor interface {
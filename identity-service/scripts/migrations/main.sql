@@ -0,0 +1,36 @@
+/******************************
+**	File:   main.sql
+**	Name:	Database migrations scripts
+**	Desc:	Local identity provider migrations scripts, only required if
+**			alexandria.identity.provider is local
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2020-06-20
+*******************************/
+
+CREATE DATABASE 'alexandria/identity';
+SET search_path TO 'alexandria/identity';
+
+CREATE SCHEMA IF NOT EXISTS alexa1;
+
+-- User entity, internal id is used as page token, external id is the user's subject (sub claim)
+CREATE TABLE IF NOT EXISTS alexa1.users(
+    id              bigserial NOT NULL UNIQUE,
+    external_id     varchar(128) NOT NULL UNIQUE,
+    username        varchar(128) NOT NULL UNIQUE,
+    email           varchar(320) NOT NULL UNIQUE,
+    -- bcrypt hash
+    password        text NOT NULL,
+    name            varchar(255) NOT NULL DEFAULT '',
+    given_name      varchar(255) NOT NULL DEFAULT '',
+    picture         text NOT NULL DEFAULT '',
+    groups          text[] NOT NULL DEFAULT '{}',
+    enabled         boolean NOT NULL DEFAULT TRUE,
+    status          varchar(32) NOT NULL DEFAULT 'CONFIRMED',
+    create_time     timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_time     timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id, external_id)
+);
+
+-- Administrators are promoted manually, e.g.
+-- UPDATE alexa1.users SET groups = array_append(groups, 'admin') WHERE username = 'aruiz';
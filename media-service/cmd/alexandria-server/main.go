@@ -28,6 +28,18 @@ func main() {
 		cleanup()
 	}()
 
+	relay, cleanupRelay, err := dep.InjectOutboxRelay()
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupRelay()
+
+	watchdog, cleanupWatchdog, err := dep.InjectSAGAWatchdog()
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupWatchdog()
+
 	// Manage goroutines
 	var g run.Group
 	{
@@ -67,6 +79,24 @@ func main() {
 			transport.EventProxy.Server.Close()
 		})
 	}
+	{
+		ctxR, cancelR := context.WithCancel(ctx)
+		g.Add(func() error {
+			log.Print("starting outbox relay")
+			return relay.Serve(ctxR)
+		}, func(error) {
+			cancelR()
+		})
+	}
+	{
+		ctxW, cancelW := context.WithCancel(ctx)
+		g.Add(func() error {
+			log.Print("starting saga watchdog")
+			return watchdog.Serve(ctxW)
+		}, func(error) {
+			cancelW()
+		})
+	}
 	{
 		// Set up signal bind
 		var (
@@ -22,7 +22,7 @@ func main() {
 		Description:  "By George Orwell, 1984 is a novel about ... ",
 		LanguageCode: "en",
 		PublisherID:  "123",
-		AuthorID:     "987",
+		Authors:      []*domain.Credit{domain.NewCredit("987", domain.RoleAuthor)},
 		PublishDate:  "1954-01-31",
 		MediaType:    "book",
 	})
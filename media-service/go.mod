@@ -13,15 +13,20 @@ require (
 	github.com/google/wire v0.4.0
 	github.com/gorilla/mux v1.7.3
 	github.com/lib/pq v1.1.1
+	github.com/maestre3d/alexandria/shared v0.0.0
 	github.com/matoous/go-nanoid v1.4.1
 	github.com/oklog/run v1.1.0
 	github.com/opentracing/opentracing-go v1.1.0
 	github.com/openzipkin/zipkin-go v0.2.2
 	github.com/prometheus/client_golang v1.5.1
 	github.com/sony/gobreaker v0.4.1
+	github.com/spf13/viper v1.6.3
+	github.com/stretchr/testify v1.5.1
 	go.opencensus.io v0.22.3
 	go.uber.org/zap v1.14.1 // indirect
 	gocloud.dev v0.19.0
 	google.golang.org/grpc v1.27.1
 	google.golang.org/protobuf v1.21.0
 )
+
+replace github.com/maestre3d/alexandria/shared => ../shared
@@ -11,6 +11,8 @@ import (
 	"github.com/maestre3d/alexandria/media-service/internal/domain"
 	"github.com/maestre3d/alexandria/media-service/internal/infrastructure"
 	"github.com/maestre3d/alexandria/media-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
 var Ctx = context.Background()
@@ -23,11 +25,23 @@ var dataSet = wire.NewSet(
 	logger.NewZapLogger,
 	wire.Bind(new(domain.MediaRepository), new(*infrastructure.MediaPQRepository)),
 	infrastructure.NewMediaPQRepository,
+	wire.Bind(new(domain.SeriesRepository), new(*infrastructure.SeriesPQRepository)),
+	infrastructure.NewSeriesPQRepository,
+	wire.Bind(new(domain.PodcastFeedRepository), new(*infrastructure.PodcastRedisRepository)),
+	infrastructure.NewPodcastRedisRepository,
+	wire.Bind(new(domain.UnitOfWork), new(*pqutil.UnitOfWork)),
+	pqutil.NewUnitOfWork,
+	wire.Bind(new(domain.OutboxRepository), new(*infrastructure.MediaOutboxPQRepository)),
+	infrastructure.NewMediaOutboxPQRepository,
+	wire.Bind(new(domain.PendingTransactionRepository), new(*infrastructure.MediaPendingPQRepository)),
+	infrastructure.NewMediaPendingPQRepository,
+	wire.Bind(new(domain.SAGATransactionRepository), new(*infrastructure.MediaSAGAPQRepository)),
+	infrastructure.NewMediaSAGAPQRepository,
 )
 
 var eventSet = wire.NewSet(
-	wire.Bind(new(domain.MediaEvent), new(*infrastructure.MediaKafkaEvent)),
-	infrastructure.NewMediaKafakaEvent,
+	wire.Bind(new(domain.MediaEvent), new(*infrastructure.MediaOutboxEvent)),
+	infrastructure.NewMediaOutboxEvent,
 )
 
 func provideContext() context.Context {
@@ -35,17 +49,119 @@ func provideContext() context.Context {
 }
 
 func InjectMediaUseCase() (*interactor.Media, func(), error) {
-	wire.Build(dataSet, eventSet, interactor.NewMedia)
+	wire.Build(
+		dataSet,
+		eventSet,
+		wire.Bind(new(domain.CursorCodec), new(*infrastructure.CursorHMACCodec)),
+		infrastructure.NewCursorHMACCodec,
+		wire.Bind(new(domain.MediaViewEvent), new(*infrastructure.MediaViewKafkaEvent)),
+		infrastructure.NewKafkaTopicOpener,
+		infrastructure.NewMediaViewKafkaEvent,
+		interactor.NewMedia,
+	)
 	return &interactor.Media{}, nil, nil
 }
 
+func InjectSeriesUseCase() (*interactor.Series, func(), error) {
+	wire.Build(
+		dataSet,
+		wire.Bind(new(domain.SeriesEvent), new(*infrastructure.SeriesOutboxEvent)),
+		infrastructure.NewSeriesOutboxEvent,
+		interactor.NewSeries,
+	)
+	return &interactor.Series{}, nil, nil
+}
+
+func InjectPodcastUseCase() (*interactor.Podcast, func(), error) {
+	wire.Build(
+		dataSet,
+		interactor.NewPodcast,
+	)
+	return &interactor.Podcast{}, nil, nil
+}
+
+func InjectMediaCascadeUseCase() (*interactor.MediaCascade, func(), error) {
+	wire.Build(
+		dataSet,
+		eventSet,
+		wire.Bind(new(domain.CascadeReportRepository), new(*infrastructure.CascadeRedisRepository)),
+		infrastructure.NewCascadeRedisRepository,
+		infrastructure.NewCascadePolicy,
+		interactor.NewMediaCascade,
+	)
+	return &interactor.MediaCascade{}, nil, nil
+}
+
+func InjectMediaViewAggregator() (*interactor.MediaViewAggregator, func(), error) {
+	wire.Build(
+		dataSet,
+		infrastructure.NewViewPolicy,
+		interactor.NewMediaViewAggregator,
+	)
+	return &interactor.MediaViewAggregator{}, nil, nil
+}
+
 func InjectMediaSAGAUseCase() (*interactor.MediaSAGA, func(), error) {
 	wire.Build(
 		dataSet,
 		eventSet,
-		wire.Bind(new(domain.MediaEventSAGA), new(*infrastructure.MediaSAGAKafkaEvent)),
-		infrastructure.NewMediaSAGAKafkaEvent,
+		wire.Bind(new(domain.MediaEventSAGA), new(*infrastructure.MediaSAGAOutboxEvent)),
+		infrastructure.NewMediaSAGAOutboxEvent,
 		interactor.NewMediaSAGA,
 	)
 	return &interactor.MediaSAGA{}, nil, nil
 }
+
+func InjectMediaSAGAWatchdog() (*interactor.MediaSAGAWatchdog, func(), error) {
+	wire.Build(
+		dataSet,
+		eventSet,
+		wire.Bind(new(domain.MediaEventSAGA), new(*infrastructure.MediaSAGAOutboxEvent)),
+		infrastructure.NewMediaSAGAOutboxEvent,
+		interactor.NewMediaSAGA,
+		infrastructure.NewSAGAPolicy,
+		interactor.NewMediaSAGAWatchdog,
+	)
+	return &interactor.MediaSAGAWatchdog{}, nil, nil
+}
+
+func InjectMediaOutboxRelay() (*infrastructure.MediaOutboxRelay, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		persistence.NewPostgresPool,
+		logger.NewZapLogger,
+		wire.Bind(new(domain.OutboxRepository), new(*infrastructure.MediaOutboxPQRepository)),
+		infrastructure.NewMediaOutboxPQRepository,
+		wire.Bind(new(domain.UnitOfWork), new(*pqutil.UnitOfWork)),
+		pqutil.NewUnitOfWork,
+		infrastructure.NewKafkaTopicOpener,
+		infrastructure.NewMediaOutboxRelay,
+	)
+	return &infrastructure.MediaOutboxRelay{}, nil, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		persistence.NewRedisPool,
+		wire.Bind(new(messaging.Store), new(*messaging.RedisStore)),
+		messaging.NewRedisStore,
+	)
+
+	return &messaging.RedisStore{}, nil, nil
+}
+
+func InjectSAGATransactionRepository() (domain.SAGATransactionRepository, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		persistence.NewPostgresPool,
+		logger.NewZapLogger,
+		wire.Bind(new(domain.SAGATransactionRepository), new(*infrastructure.MediaSAGAPQRepository)),
+		infrastructure.NewMediaSAGAPQRepository,
+	)
+
+	return &infrastructure.MediaSAGAPQRepository{}, nil, nil
+}
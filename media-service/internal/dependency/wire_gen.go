@@ -14,6 +14,8 @@ import (
 	"github.com/maestre3d/alexandria/media-service/internal/domain"
 	"github.com/maestre3d/alexandria/media-service/internal/infrastructure"
 	"github.com/maestre3d/alexandria/media-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
 // Injectors from wire.go:
@@ -35,9 +37,138 @@ func InjectMediaUseCase() (*interactor.Media, func(), error) {
 		return nil, nil, err
 	}
 	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
-	mediaKafkaEvent := infrastructure.NewMediaKafakaEvent(kernel)
-	media := interactor.NewMedia(logLogger, mediaPQRepository, mediaKafkaEvent)
+	seriesPQRepository := infrastructure.NewSeriesPQRepository(db, logLogger)
+	cursorHMACCodec, err := infrastructure.NewCursorHMACCodec()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaOutboxPQRepository := infrastructure.NewMediaOutboxPQRepository(db, logLogger)
+	mediaSAGAPQRepository := infrastructure.NewMediaSAGAPQRepository(db, logLogger)
+	mediaOutboxEvent := infrastructure.NewMediaOutboxEvent(kernel, mediaOutboxPQRepository, mediaSAGAPQRepository)
+	topicOpener := infrastructure.NewKafkaTopicOpener()
+	mediaViewKafkaEvent, cleanup3 := infrastructure.NewMediaViewKafkaEvent(kernel, topicOpener)
+	mediaPendingPQRepository := infrastructure.NewMediaPendingPQRepository(db, logLogger)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	media := interactor.NewMedia(logLogger, mediaPQRepository, seriesPQRepository, cursorHMACCodec, mediaOutboxEvent, mediaViewKafkaEvent, mediaPendingPQRepository, mediaSAGAPQRepository, unitOfWork)
 	return media, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectSeriesUseCase() (*interactor.Series, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	seriesPQRepository := infrastructure.NewSeriesPQRepository(db, logLogger)
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
+	mediaOutboxPQRepository := infrastructure.NewMediaOutboxPQRepository(db, logLogger)
+	seriesOutboxEvent := infrastructure.NewSeriesOutboxEvent(kernel, mediaOutboxPQRepository)
+	podcastRedisRepository := infrastructure.NewPodcastRedisRepository(client)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	series := interactor.NewSeries(logLogger, seriesPQRepository, mediaPQRepository, seriesOutboxEvent, podcastRedisRepository, unitOfWork)
+	return series, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectPodcastUseCase() (*interactor.Podcast, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
+	seriesPQRepository := infrastructure.NewSeriesPQRepository(db, logLogger)
+	podcastRedisRepository := infrastructure.NewPodcastRedisRepository(client)
+	podcast := interactor.NewPodcast(logLogger, mediaPQRepository, seriesPQRepository, podcastRedisRepository)
+	return podcast, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectMediaCascadeUseCase() (*interactor.MediaCascade, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
+	mediaOutboxPQRepository := infrastructure.NewMediaOutboxPQRepository(db, logLogger)
+	mediaSAGAPQRepository := infrastructure.NewMediaSAGAPQRepository(db, logLogger)
+	mediaOutboxEvent := infrastructure.NewMediaOutboxEvent(kernel, mediaOutboxPQRepository, mediaSAGAPQRepository)
+	cascadeRedisRepository := infrastructure.NewCascadeRedisRepository(client)
+	cascadePolicy, err := infrastructure.NewCascadePolicy()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	mediaCascade := interactor.NewMediaCascade(logLogger, mediaPQRepository, mediaOutboxEvent, cascadeRedisRepository, cascadePolicy, unitOfWork)
+	return mediaCascade, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectMediaViewAggregator() (*interactor.MediaViewAggregator, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	logLogger := logger.NewZapLogger()
+	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
+	viewPolicy := infrastructure.NewViewPolicy()
+	mediaViewAggregator := interactor.NewMediaViewAggregator(mediaPQRepository, viewPolicy, logLogger)
+	return mediaViewAggregator, func() {
 		cleanup2()
 		cleanup()
 	}, nil
@@ -60,24 +191,114 @@ func InjectMediaSAGAUseCase() (*interactor.MediaSAGA, func(), error) {
 	}
 	logLogger := logger.NewZapLogger()
 	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
-	mediaKafkaEvent := infrastructure.NewMediaKafakaEvent(kernel)
-	mediaSAGAKafkaEvent := infrastructure.NewMediaSAGAKafkaEvent(kernel)
-	mediaSAGA := interactor.NewMediaSAGA(mediaPQRepository, mediaKafkaEvent, mediaSAGAKafkaEvent, logLogger)
+	mediaOutboxPQRepository := infrastructure.NewMediaOutboxPQRepository(db, logLogger)
+	mediaSAGAPQRepository := infrastructure.NewMediaSAGAPQRepository(db, logLogger)
+	mediaOutboxEvent := infrastructure.NewMediaOutboxEvent(kernel, mediaOutboxPQRepository, mediaSAGAPQRepository)
+	mediaSAGAOutboxEvent := infrastructure.NewMediaSAGAOutboxEvent(kernel, mediaOutboxPQRepository, mediaSAGAPQRepository)
+	mediaPendingPQRepository := infrastructure.NewMediaPendingPQRepository(db, logLogger)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	mediaSAGA := interactor.NewMediaSAGA(mediaPQRepository, mediaOutboxEvent, mediaSAGAOutboxEvent, mediaPendingPQRepository, mediaSAGAPQRepository, unitOfWork, logLogger)
 	return mediaSAGA, func() {
 		cleanup2()
 		cleanup()
 	}, nil
 }
 
+func InjectMediaSAGAWatchdog() (*interactor.MediaSAGAWatchdog, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup2, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	logLogger := logger.NewZapLogger()
+	mediaPQRepository := infrastructure.NewMediaPQRepository(db, client, logLogger)
+	mediaOutboxPQRepository := infrastructure.NewMediaOutboxPQRepository(db, logLogger)
+	mediaSAGAPQRepository := infrastructure.NewMediaSAGAPQRepository(db, logLogger)
+	mediaOutboxEvent := infrastructure.NewMediaOutboxEvent(kernel, mediaOutboxPQRepository, mediaSAGAPQRepository)
+	mediaSAGAOutboxEvent := infrastructure.NewMediaSAGAOutboxEvent(kernel, mediaOutboxPQRepository, mediaSAGAPQRepository)
+	mediaPendingPQRepository := infrastructure.NewMediaPendingPQRepository(db, logLogger)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	mediaSAGA := interactor.NewMediaSAGA(mediaPQRepository, mediaOutboxEvent, mediaSAGAOutboxEvent, mediaPendingPQRepository, mediaSAGAPQRepository, unitOfWork, logLogger)
+	sagaPolicy := infrastructure.NewSAGAPolicy()
+	mediaSAGAWatchdog := interactor.NewMediaSAGAWatchdog(mediaSAGA, mediaPendingPQRepository, sagaPolicy, logLogger)
+	return mediaSAGAWatchdog, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectMediaOutboxRelay() (*infrastructure.MediaOutboxRelay, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	logLogger := logger.NewZapLogger()
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	mediaOutboxPQRepository := infrastructure.NewMediaOutboxPQRepository(db, logLogger)
+	unitOfWork := pqutil.NewUnitOfWork(db)
+	topicOpener := infrastructure.NewKafkaTopicOpener()
+	mediaOutboxRelay, cleanup2 := infrastructure.NewMediaOutboxRelay(kernel, logLogger, mediaOutboxPQRepository, unitOfWork, topicOpener)
+	return mediaOutboxRelay, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisStore := messaging.NewRedisStore(client)
+	return redisStore, func() {
+		cleanup()
+	}, nil
+}
+
+func InjectSAGATransactionRepository() (domain.SAGATransactionRepository, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	logLogger := logger.NewZapLogger()
+	mediaSAGAPQRepository := infrastructure.NewMediaSAGAPQRepository(db, logLogger)
+	return mediaSAGAPQRepository, func() {
+		cleanup()
+	}, nil
+}
+
 // wire.go:
 
 var Ctx = context.Background()
 
 var dataSet = wire.NewSet(
-	provideContext, config.NewKernel, persistence.NewPostgresPool, persistence.NewRedisPool, logger.NewZapLogger, wire.Bind(new(domain.MediaRepository), new(*infrastructure.MediaPQRepository)), infrastructure.NewMediaPQRepository,
+	provideContext, config.NewKernel, persistence.NewPostgresPool, persistence.NewRedisPool, logger.NewZapLogger, wire.Bind(new(domain.MediaRepository), new(*infrastructure.MediaPQRepository)), infrastructure.NewMediaPQRepository, wire.Bind(new(domain.SeriesRepository), new(*infrastructure.SeriesPQRepository)), infrastructure.NewSeriesPQRepository, wire.Bind(new(domain.PodcastFeedRepository), new(*infrastructure.PodcastRedisRepository)), infrastructure.NewPodcastRedisRepository, wire.Bind(new(domain.UnitOfWork), new(*pqutil.UnitOfWork)), pqutil.NewUnitOfWork, wire.Bind(new(domain.OutboxRepository), new(*infrastructure.MediaOutboxPQRepository)), infrastructure.NewMediaOutboxPQRepository, wire.Bind(new(domain.PendingTransactionRepository), new(*infrastructure.MediaPendingPQRepository)), infrastructure.NewMediaPendingPQRepository, wire.Bind(new(domain.SAGATransactionRepository), new(*infrastructure.MediaSAGAPQRepository)), infrastructure.NewMediaSAGAPQRepository,
 )
 
-var eventSet = wire.NewSet(wire.Bind(new(domain.MediaEvent), new(*infrastructure.MediaKafkaEvent)), infrastructure.NewMediaKafakaEvent)
+var eventSet = wire.NewSet(wire.Bind(new(domain.MediaEvent), new(*infrastructure.MediaOutboxEvent)), infrastructure.NewMediaOutboxEvent)
 
 func provideContext() context.Context {
 	return Ctx
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizeOwner(t *testing.T) {
+	ctx := context.Background()
+	assert.True(t, errors.Is(AuthorizeOwner(ctx, "user-1"), ErrForbidden))
+
+	owner := WithIdentity(ctx, Identity{Subject: "user-1"})
+	assert.Nil(t, AuthorizeOwner(owner, "user-1"))
+	assert.Nil(t, AuthorizeOwner(owner, "user-2", "user-1"))
+	assert.True(t, errors.Is(AuthorizeAdmin(owner), ErrForbidden))
+
+	stranger := WithIdentity(ctx, Identity{Subject: "user-2"})
+	assert.True(t, errors.Is(AuthorizeOwner(stranger, "user-1"), ErrForbidden))
+	// Empty owners never match
+	assert.True(t, errors.Is(AuthorizeOwner(WithIdentity(ctx, Identity{}), ""), ErrForbidden))
+
+	admin := WithIdentity(ctx, Identity{Subject: "user-3", Admin: true})
+	assert.Nil(t, AuthorizeOwner(admin, "user-1"))
+	assert.Nil(t, AuthorizeAdmin(admin))
+}
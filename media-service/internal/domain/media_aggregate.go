@@ -1,14 +1,18 @@
 package domain
 
 type MediaAggregate struct {
-	Title        string `json:"title"`
-	DisplayName  string `json:"display_name"`
-	Description  string `json:"description"`
-	LanguageCode string `json:"language_code"`
-	PublisherID  string `json:"publisher_id"`
-	AuthorID     string `json:"author_id"`
-	PublishDate  string `json:"publish_date"`
-	MediaType    string `json:"media_type"`
+	Title        string    `json:"title"`
+	DisplayName  string    `json:"display_name"`
+	Description  string    `json:"description"`
+	LanguageCode string    `json:"language_code"`
+	PublisherID  string    `json:"publisher_id"`
+	Authors      []*Credit `json:"authors"`
+	PublishDate  string    `json:"publish_date"`
+	MediaType    string    `json:"media_type"`
+	Visibility   string    `json:"visibility"`
+	// Duration in seconds or as [hh:]mm:ss
+	Duration string `json:"duration"`
+	Explicit string `json:"explicit"`
 }
 
 type MediaUpdateAggregate struct {
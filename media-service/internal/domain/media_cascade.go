@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+const (
+	// Foreign side-effect events, users leaving the platform cascade over their media
+	UserRemoved     = "USER_REMOVED"             // Consumed
+	UserHardRemoved = "USER_PERMANENTLY_REMOVED" // Consumed
+)
+
+const (
+	// CascadeSoftDelete media of removed users is soft-deleted
+	CascadeSoftDelete = "soft_delete"
+	// CascadeTransfer media of removed users is transferred to the community
+	CascadeTransfer = "transfer"
+	// CascadeHardDelete media of removed users is permanently removed, blobs included
+	CascadeHardDelete = "hard_delete"
+
+	CascadeRunning = "CASCADE_RUNNING"
+	CascadeDone    = "CASCADE_DONE"
+	CascadeFailed  = "CASCADE_FAILED"
+
+	CascadeItemPlanned = "ITEM_PLANNED"
+	CascadeItemDone    = "ITEM_DONE"
+	CascadeItemSkipped = "ITEM_SKIPPED"
+	CascadeItemFailed  = "ITEM_FAILED"
+)
+
+// CascadePolicy how media of removed users is handled
+type CascadePolicy struct {
+	// Action applied to the media published by the removed user
+	Action string
+	// CommunityOwner publisher of transferred media
+	CommunityOwner string
+	// DryRun every cascade only reports the affected media
+	DryRun bool
+}
+
+// ParseCascadeAction returns a valid cascade action, only soft_delete, transfer and hard_delete are accepted
+func ParseCascadeAction(action string) (string, error) {
+	switch action = strings.ToLower(strings.TrimSpace(action)); action {
+	case CascadeSoftDelete, CascadeTransfer, CascadeHardDelete:
+		return action, nil
+	default:
+		return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "cascade_action",
+				"["+strings.Join([]string{CascadeSoftDelete, CascadeTransfer, CascadeHardDelete}, " ")+"]"))
+	}
+}
+
+// CascadeItem action applied, or planned on dry-runs, to a single media
+type CascadeItem struct {
+	ID      string `json:"media_id"`
+	Action  string `json:"action"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// CascadeReport progress of the cascade of a removed user over its media
+type CascadeReport struct {
+	UserID     string         `json:"user_id"`
+	Action     string         `json:"action"`
+	DryRun     bool           `json:"dry_run"`
+	Status     string         `json:"status"`
+	Total      int            `json:"total"`
+	Processed  int            `json:"processed"`
+	Failed     int            `json:"failed"`
+	Items      []*CascadeItem `json:"items"`
+	StartTime  time.Time      `json:"start_time"`
+	UpdateTime time.Time      `json:"update_time"`
+}
+
+// NewCascadeReport returns a running cascade report of the given user
+func NewCascadeReport(userID, action string, dryRun bool) *CascadeReport {
+	return &CascadeReport{
+		UserID:     userID,
+		Action:     action,
+		DryRun:     dryRun,
+		Status:     CascadeRunning,
+		Items:      make([]*CascadeItem, 0),
+		StartTime:  time.Now(),
+		UpdateTime: time.Now(),
+	}
+}
+
+// Plan adds the given media to the cascade
+func (r *CascadeReport) Plan(id, action string) *CascadeItem {
+	item := &CascadeItem{
+		ID:     id,
+		Action: action,
+		Status: CascadeItemPlanned,
+	}
+	r.Items = append(r.Items, item)
+	r.Total++
+	return item
+}
+
+// Resolve sets the result of the given item, skipped items were already cascaded
+func (r *CascadeReport) Resolve(item *CascadeItem, status string, err error) {
+	item.Status = status
+	if err != nil {
+		item.Status = CascadeItemFailed
+		item.Message = err.Error()
+		r.Failed++
+	}
+	r.Processed++
+	r.UpdateTime = time.Now()
+}
+
+// Finish closes the report, reports with failed items may be resumed by running the cascade again
+func (r *CascadeReport) Finish() {
+	r.Status = CascadeDone
+	if r.Failed > 0 {
+		r.Status = CascadeFailed
+	}
+	r.UpdateTime = time.Now()
+}
+
+// CascadeReportRepository keeps the latest cascade report of each user
+type CascadeReportRepository interface {
+	Save(ctx context.Context, report CascadeReport) error
+	FetchByID(ctx context.Context, userID string) (*CascadeReport, error)
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+const (
+	RoleAuthor     = "author"
+	RoleEditor     = "editor"
+	RoleTranslator = "translator"
+	RoleNarrator   = "narrator"
+	RoleHost       = "host"
+
+	// maxCredits credited authors a media may have
+	maxCredits = 32
+)
+
+// Credit author credited by a media with the given role, credits keep the order they were given in
+type Credit struct {
+	AuthorID string `json:"author_id" validate:"required,max=128"`
+	Role     string `json:"role" validate:"required,oneof=author editor translator narrator host"`
+}
+
+// NewCredit Create a new credit, empty roles fall back to author
+func NewCredit(authorID, role string) *Credit {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role == "" {
+		role = RoleAuthor
+	}
+
+	return &Credit{
+		AuthorID: strings.TrimSpace(authorID),
+		Role:     role,
+	}
+}
+
+// NewCredits returns a copy of the given credits, empty credits are dropped
+func NewCredits(credits []*Credit) []*Credit {
+	authors := make([]*Credit, 0, len(credits))
+	for _, credit := range credits {
+		if credit == nil || strings.TrimSpace(credit.AuthorID) == "" {
+			continue
+		}
+		authors = append(authors, NewCredit(credit.AuthorID, credit.Role))
+	}
+
+	return authors
+}
+
+// AuthorPool returns the id of every credited author in order, authors credited with many roles are
+// returned once
+func (m Media) AuthorPool() []string {
+	pool := make([]string, 0, len(m.Authors))
+	seen := make(map[string]bool, len(m.Authors))
+	for _, credit := range m.Authors {
+		if credit == nil || seen[credit.AuthorID] {
+			continue
+		}
+		seen[credit.AuthorID] = true
+		pool = append(pool, credit.AuthorID)
+	}
+
+	return pool
+}
+
+// validateCredits rejects authors credited twice with the same role
+func (m Media) validateCredits() error {
+	seen := make(map[Credit]bool, len(m.Authors))
+	for _, credit := range m.Authors {
+		if seen[*credit] {
+			return exception.NewErrorDescription(exception.EntityExists,
+				fmt.Sprintf("author %s is already credited as %s", credit.AuthorID, credit.Role))
+		}
+		seen[*credit] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedia_Credits(t *testing.T) {
+	media, err := NewMedia(&MediaAggregate{
+		Title:        "Dune",
+		LanguageCode: "en",
+		PublishDate:  "1965-08-01",
+		MediaType:    "book",
+		Authors: []*Credit{
+			{AuthorID: "frank", Role: "Author"},
+			{AuthorID: ""},
+			{AuthorID: "scott", Role: RoleNarrator},
+			{AuthorID: "frank", Role: RoleEditor},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, media.IsValid())
+	// Empty credits are dropped, order is kept
+	assert.Equal(t, []*Credit{NewCredit("frank", RoleAuthor), NewCredit("scott", RoleNarrator),
+		NewCredit("frank", RoleEditor)}, media.Authors)
+	assert.Equal(t, []string{"frank", "scott"}, media.AuthorPool())
+
+	media.Authors = append(media.Authors, NewCredit("scott", RoleNarrator))
+	assert.True(t, errors.Is(media.IsValid(), exception.EntityExists))
+
+	media.Authors = []*Credit{NewCredit("frank", "director")}
+	assert.True(t, errors.Is(media.IsValid(), exception.InvalidFieldFormat))
+
+	media.Authors = nil
+	assert.True(t, errors.Is(media.IsValid(), exception.InvalidFieldRange))
+}
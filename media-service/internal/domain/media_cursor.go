@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/alexandria-oss/core"
+)
+
+// Supported listing sort keys
+const (
+	SortCreateTime = "create_time"
+	SortUpdateTime = "update_time"
+	SortTotalViews = "total_views"
+	SortTitle      = "title"
+	// SortRank full-text search relevance, only available with search_mode=fulltext
+	SortRank = "rank"
+	// SortPosition series order, only available with the series filter
+	SortPosition = "position"
+)
+
+// Sorting directions
+const (
+	SortAscending  = "ASC"
+	SortDescending = "DESC"
+)
+
+// Cursor keyset pagination position, points to the boundary row of a page using its sort key value and external id
+// as tie-breaker. A cursor without ID points to the first page
+type Cursor struct {
+	Sort      string `json:"s"`
+	Direction string `json:"d"`
+	Value     string `json:"v,omitempty"`
+	ID        string `json:"i,omitempty"`
+	// Backward fetches the page before the boundary row
+	Backward bool `json:"b,omitempty"`
+}
+
+// CursorCodec turns cursors into opaque page tokens
+type CursorCodec interface {
+	Encode(cursor Cursor) (string, error)
+	Decode(token string) (*Cursor, error)
+}
+
+// NewCursor returns a first page cursor using the given filter params sorting,
+// filter_by sets the sort key and sort its direction
+func NewCursor(filter core.FilterParams) Cursor {
+	c := Cursor{}
+	switch strings.ToLower(filter["filter_by"]) {
+	case "id", SortCreateTime:
+		c.Sort, c.Direction = SortCreateTime, SortAscending
+	case "timestamp", SortUpdateTime:
+		c.Sort, c.Direction = SortUpdateTime, SortDescending
+	case SortTitle:
+		c.Sort, c.Direction = SortTitle, SortAscending
+	default:
+		c.Sort, c.Direction = SortTotalViews, SortDescending
+	}
+
+	if strings.ToLower(filter["search_mode"]) == "fulltext" && strings.IndexFunc(filter["query"], isWordRune) >= 0 {
+		c.Sort, c.Direction = SortRank, SortDescending
+	}
+
+	// Series are always listed in order
+	if filter["series"] != "" {
+		c.Sort, c.Direction = SortPosition, SortAscending
+	}
+
+	switch strings.ToUpper(filter["sort"]) {
+	case SortAscending:
+		c.Direction = SortAscending
+	case SortDescending:
+		c.Direction = SortDescending
+	}
+
+	return c
+}
+
+// NewMediaCursor returns a cursor pointing to the given media using the sorting of c
+func NewMediaCursor(c Cursor, media *Media, backward bool) Cursor {
+	c.ID = media.ExternalID
+	c.Backward = backward
+
+	switch c.Sort {
+	case SortCreateTime:
+		c.Value = media.CreateTime.UTC().Format(time.RFC3339Nano)
+	case SortUpdateTime:
+		c.Value = media.UpdateTime.UTC().Format(time.RFC3339Nano)
+	case SortTitle:
+		c.Value = media.Title
+	case SortRank:
+		c.Value = strconv.FormatFloat(float64(media.Rank), 'g', -1, 32)
+	case SortPosition:
+		if media.Episode != nil {
+			c.Value = strconv.Itoa(media.Episode.Position)
+		}
+	default:
+		c.Value = strconv.FormatInt(media.TotalViews, 10)
+	}
+
+	return c
+}
+
+// IsValid returns true if the cursor sort key and direction are supported
+func (c Cursor) IsValid() bool {
+	switch c.Sort {
+	case SortCreateTime, SortUpdateTime, SortTotalViews, SortTitle, SortRank, SortPosition:
+	default:
+		return false
+	}
+
+	return c.Direction == SortAscending || c.Direction == SortDescending
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -17,6 +17,13 @@ const (
 	Podcast       = "MEDIA_PODCAST"
 	Doc           = "MEDIA_DOC"
 	Video         = "MEDIA_VIDEO"
+
+	// VisibilityPublic media is listed for everyone
+	VisibilityPublic = "public"
+	// VisibilityPrivate media is only available for its publisher
+	VisibilityPrivate = "private"
+	// VisibilityUnlisted media is available for everyone with its id but never listed
+	VisibilityUnlisted = "unlisted"
 )
 
 type Media struct {
@@ -29,8 +36,8 @@ type Media struct {
 	LanguageCode string `json:"language_code" validate:"alphaunicode"`
 	// User ID, Required SAGA transaction
 	PublisherID string `json:"publisher_id"`
-	// Required SAGA transaction
-	AuthorID string `json:"author_id"`
+	// Credited authors in order, required SAGA transaction
+	Authors []*Credit `json:"authors" validate:"min=1,max=32,dive,required"`
 	// Content's publish date
 	PublishDate time.Time  `json:"publish_date"`
 	MediaType   string     `json:"media_type" validate:"required,oneof=MEDIA_BOOK MEDIA_VIDEO MEDIA_DOC MEDIA_PODCAST"`
@@ -41,6 +48,20 @@ type Media struct {
 	ContentURL  *string    `json:"content_url"`
 	TotalViews  int64      `json:"total_views"`
 	Status      string     `json:"status" validate:"required,oneof=STATUS_DONE STATUS_PENDING"`
+	Visibility  string     `json:"visibility" validate:"required,oneof=public private unlisted"`
+	// Content MIME type and size in bytes, sent by the blob service along with the content URL
+	ContentType   string `json:"content_type"`
+	ContentLength int64  `json:"content_length"`
+	// Playback duration in seconds (e.g. podcasts and videos), 0 if unknown
+	Duration int `json:"duration"`
+	// Explicit content flag, podcast feeds mark explicit episodes
+	Explicit bool `json:"explicit"`
+	// Highlighted full-text search matches, only set by search queries
+	Snippet *string `json:"snippet,omitempty"`
+	// Full-text search relevance, only set by search queries
+	Rank float32 `json:"-"`
+	// Series position, only set when listing by series
+	Episode *Episode `json:"episode,omitempty"`
 }
 
 func NewMedia(ag *MediaAggregate) (*Media, error) {
@@ -54,10 +75,21 @@ func NewMedia(ag *MediaAggregate) (*Media, error) {
 	}
 
 	ag.MediaType = ParseMediaType(ag.MediaType)
+	if ag.Visibility == "" {
+		ag.Visibility = VisibilityPublic
+	}
 	publishDate, err := ParseDate(ag.PublishDate)
 	if err != nil {
 		return nil, err
 	}
+	duration, err := ParseDuration(ag.Duration)
+	if err != nil {
+		return nil, err
+	}
+	explicit, err := ParseExplicit(ag.Explicit)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Media{
 		ID:           0,
@@ -67,7 +99,7 @@ func NewMedia(ag *MediaAggregate) (*Media, error) {
 		Description:  ag.Description,
 		LanguageCode: strings.ToLower(ag.LanguageCode),
 		PublisherID:  ag.PublisherID,
-		AuthorID:     ag.AuthorID,
+		Authors:      NewCredits(ag.Authors),
 		PublishDate:  publishDate,
 		MediaType:    ag.MediaType,
 		CreateTime:   time.Now(),
@@ -77,6 +109,9 @@ func NewMedia(ag *MediaAggregate) (*Media, error) {
 		ContentURL:   nil,
 		TotalViews:   0,
 		Status:       StatusPending,
+		Visibility:   strings.ToLower(ag.Visibility),
+		Duration:     duration,
+		Explicit:     explicit,
 	}, nil
 }
 
@@ -111,6 +146,16 @@ func ParseMediaType(media string) string {
 	return media
 }
 
+// IsVisibleTo returns true if the given caller may read the media, private media is only
+// visible to its publisher and administrators
+func (m Media) IsVisibleTo(identity Identity) bool {
+	if m.Visibility != VisibilityPrivate {
+		return true
+	}
+
+	return identity.Admin || (identity.Subject != "" && identity.Subject == m.PublisherID)
+}
+
 func (m *Media) IsValid() error {
 	// Struct validation
 	validate := validator.New()
@@ -139,6 +184,12 @@ func (m *Media) IsValid() error {
 				case "description":
 					maxLength = "1024"
 					break
+				case "authors":
+					maxLength = fmt.Sprint(maxCredits)
+					break
+				case "authorid":
+					maxLength = "128"
+					break
 				}
 
 				return exception.NewErrorDescription(exception.InvalidFieldRange,
@@ -153,5 +204,5 @@ func (m *Media) IsValid() error {
 		return err
 	}
 
-	return nil
+	return m.validateCredits()
 }
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedia_IsVisibleTo(t *testing.T) {
+	media := Media{PublisherID: "user-1", Visibility: VisibilityPrivate}
+	assert.False(t, media.IsVisibleTo(Identity{}))
+	assert.False(t, media.IsVisibleTo(Identity{Subject: "user-2"}))
+	assert.True(t, media.IsVisibleTo(Identity{Subject: "user-1"}))
+	assert.True(t, media.IsVisibleTo(Identity{Subject: "user-3", Admin: true}))
+
+	// Unlisted media is readable by anyone holding its id
+	media.Visibility = VisibilityUnlisted
+	assert.True(t, media.IsVisibleTo(Identity{}))
+	media.Visibility = VisibilityPublic
+	assert.True(t, media.IsVisibleTo(Identity{}))
+}
+
+func TestNewMedia_Visibility(t *testing.T) {
+	media, err := NewMedia(&MediaAggregate{PublishDate: "2020-04-06", Visibility: "Private"})
+	assert.Nil(t, err)
+	assert.Equal(t, VisibilityPrivate, media.Visibility)
+
+	media, err = NewMedia(&MediaAggregate{PublishDate: "2020-04-06"})
+	assert.Nil(t, err)
+	assert.Equal(t, VisibilityPublic, media.Visibility)
+}
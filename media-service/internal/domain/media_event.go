@@ -10,8 +10,9 @@ const (
 )
 
 type MediaEvent interface {
-	StartCreate(ctx context.Context, media Media) error
-	StartUpdate(ctx context.Context, media Media, snapshot Media) error
+	// StartCreate and StartUpdate start the SAGA transaction with the given ID, see PendingTransaction
+	StartCreate(ctx context.Context, transactionID string, media Media) error
+	StartUpdate(ctx context.Context, transactionID string, media Media, snapshot Media) error
 	Updated(ctx context.Context, media Media) error
 	Removed(ctx context.Context, id string) error
 	Restored(ctx context.Context, id string) error
@@ -24,4 +24,5 @@ type MediaEventSAGA interface {
 	VerifyAuthor(ctx context.Context, authors []string) error
 	Created(ctx context.Context, media Media) error
 	BlobFailed(ctx context.Context, msg string) error
+	TimedOut(ctx context.Context, tx PendingTransaction) error
 }
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OutboxMessage event stored inside the transactional outbox, it keeps the exact
+// broker message (body and metadata) to be relayed to the given topic
+type OutboxMessage struct {
+	ID           int64             `json:"-"`
+	EventID      string            `json:"event_id"`
+	Topic        string            `json:"topic"`
+	RootID       string            `json:"root_id"`
+	Body         []byte            `json:"body"`
+	Metadata     map[string]string `json:"metadata"`
+	Attempts     int               `json:"attempts"`
+	LastError    *string           `json:"last_error"`
+	CreateTime   time.Time         `json:"create_time"`
+	DispatchTime *time.Time        `json:"dispatch_time"`
+	// ParkTime message exhausted its attempts, parked messages are never relayed again
+	ParkTime *time.Time `json:"park_time"`
+}
+
+// NewOutboxMessage returns a pending outbox message
+func NewOutboxMessage(topic, rootID string, body []byte, metadata map[string]string) *OutboxMessage {
+	return &OutboxMessage{
+		ID:           0,
+		EventID:      metadata["event_id"],
+		Topic:        topic,
+		RootID:       rootID,
+		Body:         body,
+		Metadata:     metadata,
+		Attempts:     0,
+		LastError:    nil,
+		CreateTime:   time.Now(),
+		DispatchTime: nil,
+		ParkTime:     nil,
+	}
+}
+
+// OutboxRepository transactional outbox, Save must use the running unit of work (if any)
+// to be committed along with the entity change
+type OutboxRepository interface {
+	Save(ctx context.Context, message OutboxMessage) error
+	// Lease returns true if the caller is the only relay draining the outbox until the running unit of work ends
+	Lease(ctx context.Context) (bool, error)
+	// FetchPending returns pending messages in insertion order, rows are locked until the running unit of work ends
+	FetchPending(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	MarkDispatched(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, msg string) error
+	// Park sets the given message aside after its last failed attempt
+	Park(ctx context.Context, id int64, msg string) error
+}
+
+// UnitOfWork executes the given function inside a single atomic transaction,
+// every repository called with the given context shares the transaction
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
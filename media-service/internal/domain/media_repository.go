@@ -8,11 +8,16 @@ import (
 type MediaRepository interface {
 	Save(ctx context.Context, media Media) error
 	SaveRaw(ctx context.Context, media Media) error
-	Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*Media, error)
+	// Fetch returns up to size media after the cursor boundary, rows are returned in reverse order on backward cursors
+	Fetch(ctx context.Context, cursor Cursor, size int, filter core.FilterParams) ([]*Media, error)
 	FetchByID(ctx context.Context, id string, showDisabled bool) (*Media, error)
+	// FetchByPublisher returns every media published by the given user, disabled media included
+	FetchByPublisher(ctx context.Context, publisherID string) ([]*Media, error)
 	Replace(ctx context.Context, media Media) error
 	Remove(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	HardRemove(ctx context.Context, id string) error
 	ChangeState(ctx context.Context, id, state string) error
+	// IncrementViews atomically adds the given view totals (external_id -> views) to total_views
+	IncrementViews(ctx context.Context, views map[string]int64) error
 }
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SAGA transaction states
+const (
+	TransactionPending  = "TRANSACTION_PENDING"
+	TransactionDone     = "TRANSACTION_DONE"
+	TransactionFailed   = "TRANSACTION_FAILED"
+	TransactionTimedOut = "TRANSACTION_TIMED_OUT"
+)
+
+// SAGATransaction choreography-SAGA transaction state, every reached step is recorded
+// to know where a transaction is at and why it failed
+type SAGATransaction struct {
+	ID             string      `json:"transaction_id"`
+	RootID         string      `json:"root_id"`
+	Operation      string      `json:"operation"`
+	Step           string      `json:"step"`
+	Status         string      `json:"status"`
+	Snapshot       string      `json:"snapshot,omitempty"`
+	FailureMessage *string     `json:"failure_message"`
+	Steps          []*SAGAStep `json:"steps"`
+	CreateTime     time.Time   `json:"create_time"`
+	UpdateTime     time.Time   `json:"update_time"`
+}
+
+// SAGAStep a single reached SAGA transaction step, most likely an event name
+type SAGAStep struct {
+	Name    string    `json:"step"`
+	Status  string    `json:"status"`
+	Message *string   `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// NewSAGATransaction returns a transaction at the given step, use Fail or Finish to change its status
+func NewSAGATransaction(id, rootID, operation, snapshot, step string) *SAGATransaction {
+	tx := &SAGATransaction{
+		ID:             id,
+		RootID:         rootID,
+		Operation:      operation,
+		Step:           step,
+		Status:         TransactionPending,
+		Snapshot:       snapshot,
+		FailureMessage: nil,
+		Steps:          make([]*SAGAStep, 0),
+		CreateTime:     time.Now(),
+		UpdateTime:     time.Now(),
+	}
+	tx.Steps = append(tx.Steps, &SAGAStep{
+		Name:    step,
+		Status:  TransactionPending,
+		Message: nil,
+		Time:    tx.UpdateTime,
+	})
+
+	return tx
+}
+
+// Finish sets the transaction current step as done
+func (t *SAGATransaction) Finish() {
+	t.setStatus(TransactionDone, nil)
+}
+
+// Fail sets the transaction current step as failed with the given reason
+func (t *SAGATransaction) Fail(status, msg string) {
+	t.FailureMessage = &msg
+	t.setStatus(status, &msg)
+}
+
+func (t *SAGATransaction) setStatus(status string, msg *string) {
+	t.Status = status
+	for _, s := range t.Steps {
+		if s.Name == t.Step {
+			s.Status = status
+			s.Message = msg
+		}
+	}
+}
+
+// SAGATransactionRepository SAGA transaction store, Save must use the running unit of work (if any)
+// to be committed along with the entity change
+type SAGATransactionRepository interface {
+	// Save stores the given transaction, if it already exists its steps are appended and its
+	// current step and status are replaced
+	Save(ctx context.Context, tx SAGATransaction) error
+	// FetchByRoot returns every transaction of the given entity, newest first
+	FetchByRoot(ctx context.Context, rootID string) ([]*SAGATransaction, error)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSAGATransaction_Fail(t *testing.T) {
+	tx := NewSAGATransaction("tx-1", "media-1", MediaCreated, "", OwnerFailed)
+	assert.Equal(t, TransactionPending, tx.Status)
+	assert.Equal(t, 1, len(tx.Steps))
+
+	tx.Fail(TransactionFailed, "owner not found")
+	assert.Equal(t, TransactionFailed, tx.Status)
+	assert.Equal(t, "owner not found", *tx.FailureMessage)
+	assert.Equal(t, TransactionFailed, tx.Steps[0].Status)
+	assert.Equal(t, tx.FailureMessage, tx.Steps[0].Message)
+}
+
+func TestSAGATransaction_Finish(t *testing.T) {
+	tx := NewSAGATransaction("tx-1", "media-1", MediaUpdated, "{}", AuthorVerified)
+	tx.Finish()
+	assert.Equal(t, TransactionDone, tx.Status)
+	assert.Nil(t, tx.FailureMessage)
+	assert.Equal(t, AuthorVerified, tx.Steps[0].Name)
+	assert.Equal(t, TransactionDone, tx.Steps[0].Status)
+}
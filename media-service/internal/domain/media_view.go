@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// MediaViewed domain event, lightweight view notification consumed by total_views aggregation and trending services
+const MediaViewed = "MEDIA_VIEWED"
+
+// Viewer user reading an aggregate, transports propagate it through the request context
+type Viewer struct {
+	UserID  string
+	Country string
+}
+
+type viewerContextKey struct{}
+
+// WithViewer returns a copy of ctx holding the given viewer
+func WithViewer(ctx context.Context, viewer Viewer) context.Context {
+	return context.WithValue(ctx, viewerContextKey{}, viewer)
+}
+
+// ViewerFromContext returns the viewer of the given context, anonymous if not set
+func ViewerFromContext(ctx context.Context) Viewer {
+	viewer, _ := ctx.Value(viewerContextKey{}).(Viewer)
+	return viewer
+}
+
+// MediaView MEDIA_VIEWED event body
+type MediaView struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id,omitempty"`
+	Country   string    `json:"country,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewMediaView returns a view of the given media done by viewer
+func NewMediaView(id string, viewer Viewer) *MediaView {
+	return &MediaView{
+		ID:        id,
+		UserID:    viewer.UserID,
+		Country:   strings.ToUpper(viewer.Country),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// MediaViewEvent publishes media views, reads must not be blocked by it
+type MediaViewEvent interface {
+	Viewed(ctx context.Context, view MediaView) error
+}
+
+// ViewPolicy total_views aggregation policy
+type ViewPolicy struct {
+	// Interval maximum time a view waits before its batch gets stored
+	Interval time.Duration
+	// BatchSize total of views stored at once, a full batch is stored right away
+	BatchSize int
+}
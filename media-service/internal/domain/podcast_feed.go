@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+const (
+	// PodcastByAuthor feed of the podcast episodes crediting an author, newest first
+	PodcastByAuthor = "author"
+	// PodcastBySeries feed of the podcast episodes of a series, in series order
+	PodcastBySeries = "series"
+)
+
+// BlobMetadata content metadata sent by the blob service along with MEDIA_BLOB_UPLOADED events
+type BlobMetadata struct {
+	ContentType string
+	Size        int64
+}
+
+// PodcastFeed podcast episodes of an author or a series, episodes without content are never listed
+type PodcastFeed struct {
+	Kind        string  `json:"kind"`
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	ImageURL    *string `json:"image_url"`
+	Language    string  `json:"language"`
+	// Explicit if any episode is explicit
+	Explicit bool     `json:"explicit"`
+	Episodes []*Media `json:"episodes"`
+	// ETag changes whenever an episode or the series changes, LastModified is their latest update
+	ETag         string    `json:"etag"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// NewPodcastFeed returns the feed of the given episodes, series is required by series feeds
+func NewPodcastFeed(kind, id string, episodes []*Media, series *Series) *PodcastFeed {
+	feed := &PodcastFeed{
+		Kind:     kind,
+		ID:       id,
+		Title:    id,
+		Episodes: make([]*Media, 0, len(episodes)),
+	}
+
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%s:%s", kind, id)
+	if series != nil {
+		feed.Title = series.Title
+		feed.Description = series.Description
+		feed.ImageURL = series.CoverURL
+		feed.LastModified = series.UpdateTime
+		_, _ = fmt.Fprintf(hash, ":%d", series.UpdateTime.UnixNano())
+	}
+
+	for _, episode := range episodes {
+		if episode.ContentURL == nil || *episode.ContentURL == "" {
+			continue
+		}
+
+		if feed.Language == "" {
+			feed.Language = episode.LanguageCode
+		}
+		feed.Explicit = feed.Explicit || episode.Explicit
+		if episode.UpdateTime.After(feed.LastModified) {
+			feed.LastModified = episode.UpdateTime
+		}
+		_, _ = fmt.Fprintf(hash, ":%s:%d:%s:%d", episode.ExternalID, episode.UpdateTime.UnixNano(), *episode.ContentURL,
+			episode.ContentLength)
+		feed.Episodes = append(feed.Episodes, episode)
+	}
+
+	// HTTP dates have a second precision
+	feed.LastModified = feed.LastModified.UTC().Truncate(time.Second)
+	feed.ETag = `"` + hex.EncodeToString(hash.Sum(nil))[:32] + `"`
+	return feed
+}
+
+// PodcastFeedKey returns the key of a feed
+func PodcastFeedKey(kind, id string) string {
+	return kind + ":" + id
+}
+
+// ParsePodcastKind returns a valid feed kind, only author and series are accepted
+func ParsePodcastKind(kind string) (string, error) {
+	switch kind = strings.ToLower(strings.TrimSpace(kind)); kind {
+	case PodcastByAuthor, PodcastBySeries:
+		return kind, nil
+	default:
+		return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "kind", "["+PodcastByAuthor+" "+PodcastBySeries+"]"))
+	}
+}
+
+// ParseDuration returns the seconds of a duration given in seconds or as [hh:]mm:ss, empty durations are 0
+func ParseDuration(duration string) (int, error) {
+	duration = strings.TrimSpace(duration)
+	if duration == "" {
+		return 0, nil
+	}
+
+	parts := strings.Split(duration, ":")
+	if len(parts) > 3 {
+		return 0, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "duration", "seconds or [hh:]mm:ss"))
+	}
+
+	seconds := 0
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 0 || (i > 0 && v >= 60) {
+			return 0, exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf(exception.InvalidFieldFormatString, "duration", "seconds or [hh:]mm:ss"))
+		}
+		seconds = seconds*60 + v
+	}
+
+	return seconds, nil
+}
+
+// ParseExplicit returns the explicit flag of the given boolean, empty flags are false
+func ParseExplicit(explicit string) (bool, error) {
+	if explicit = strings.TrimSpace(explicit); explicit == "" {
+		return false, nil
+	}
+
+	flag, err := strconv.ParseBool(explicit)
+	if err != nil {
+		return false, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "explicit", "boolean"))
+	}
+
+	return flag, nil
+}
+
+// PodcastFeedRepository podcast feed cache, feeds are built again once removed or expired
+type PodcastFeedRepository interface {
+	Save(ctx context.Context, feed PodcastFeed) error
+	// FetchByID returns exception.EntityNotFound if the feed is not stored
+	FetchByID(ctx context.Context, kind, id string) (*PodcastFeed, error)
+	// Remove the feeds of the given keys (see PodcastFeedKey)
+	Remove(ctx context.Context, keys ...string) error
+}
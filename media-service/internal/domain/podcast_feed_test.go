@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	for duration, seconds := range map[string]int{"": 0, "90": 90, "01:30": 90, "1:02:03": 3723} {
+		s, err := ParseDuration(duration)
+		assert.Nil(t, err)
+		assert.Equal(t, seconds, s, duration)
+	}
+
+	for _, duration := range []string{"-1", "1:60", "a:b", "1:2:3:4"} {
+		_, err := ParseDuration(duration)
+		assert.True(t, errors.Is(err, exception.InvalidFieldFormat), duration)
+	}
+}
+
+func TestNewPodcastFeed(t *testing.T) {
+	url := "https://cdn.example.com/media/ep1.mp3"
+	updated := time.Date(2026, 10, 18, 12, 0, 0, 500, time.UTC)
+	episodes := []*Media{
+		{ExternalID: "ep1", LanguageCode: "eng", ContentURL: &url, Explicit: true, UpdateTime: updated},
+		{ExternalID: "ep2", UpdateTime: updated.Add(time.Hour)},
+	}
+	series := &Series{ExternalID: "show", Title: "The Show", UpdateTime: updated.Add(-time.Hour)}
+
+	feed := NewPodcastFeed(PodcastBySeries, "show", episodes, series)
+	assert.Equal(t, "The Show", feed.Title)
+	assert.Equal(t, "eng", feed.Language)
+	assert.True(t, feed.Explicit)
+	// Episodes without content are never listed
+	assert.Len(t, feed.Episodes, 1)
+	assert.Equal(t, updated.Truncate(time.Second), feed.LastModified)
+	assert.Equal(t, feed.ETag, NewPodcastFeed(PodcastBySeries, "show", episodes, series).ETag)
+
+	episodes[0].UpdateTime = updated.Add(time.Minute)
+	assert.NotEqual(t, feed.ETag, NewPodcastFeed(PodcastBySeries, "show", episodes, series).ETag)
+}
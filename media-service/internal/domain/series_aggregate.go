@@ -0,0 +1,14 @@
+package domain
+
+type SeriesAggregate struct {
+	OwnerID     string        `json:"owner_id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	CoverURL    string        `json:"cover_url"`
+	Items       []*SeriesItem `json:"items"`
+}
+
+type SeriesUpdateAggregate struct {
+	Root *SeriesAggregate
+	ID   string `json:"id"`
+}
@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-playground/validator/v10"
+	gonanoid "github.com/matoous/go-nanoid"
+)
+
+// maxSeriesItems media a series may group
+const maxSeriesItems = 512
+
+// Series ordered group of media (e.g. podcast seasons, multi-volume books), items are kept sorted by
+// season and number
+type Series struct {
+	ID          int64         `json:"-"`
+	ExternalID  string        `json:"id" validate:"required"`
+	OwnerID     string        `json:"owner_id" validate:"required,max=128"`
+	Title       string        `json:"title" validate:"required,min=1,max=255"`
+	Description string        `json:"description" validate:"max=1024"`
+	CoverURL    *string       `json:"cover_url" validate:"omitempty,url"`
+	Items       []*SeriesItem `json:"items" validate:"max=512,dive,required"`
+	CreateTime  time.Time     `json:"create_time"`
+	UpdateTime  time.Time     `json:"update_time"`
+}
+
+// SeriesItem media inside a series, season zero is used by series without seasons (e.g. book volumes)
+type SeriesItem struct {
+	MediaID string `json:"media_id" validate:"required,max=128"`
+	Season  int    `json:"season" validate:"min=0"`
+	// Episode or volume number
+	Number int `json:"number" validate:"min=1"`
+}
+
+// Episode position of a media inside a series, previous and next point to the adjacent series items
+type Episode struct {
+	SeriesID   string  `json:"series_id"`
+	Season     int     `json:"season"`
+	Number     int     `json:"number"`
+	Position   int     `json:"position"`
+	PreviousID *string `json:"previous_id"`
+	NextID     *string `json:"next_id"`
+}
+
+func NewSeries(ag *SeriesAggregate) (*Series, error) {
+	id, err := gonanoid.ID(16)
+	if err != nil {
+		return nil, err
+	}
+
+	var cover *string
+	if ag.CoverURL != "" {
+		cover = &ag.CoverURL
+	}
+
+	return &Series{
+		ID:          0,
+		ExternalID:  id,
+		OwnerID:     ag.OwnerID,
+		Title:       ag.Title,
+		Description: ag.Description,
+		CoverURL:    cover,
+		Items:       NewSeriesItems(ag.Items),
+		CreateTime:  time.Now(),
+		UpdateTime:  time.Now(),
+	}, nil
+}
+
+// NewSeriesItems returns a sorted copy of the given items, items without media are dropped.
+// Items without number follow the previous item of the same season in the given order
+func NewSeriesItems(items []*SeriesItem) []*SeriesItem {
+	series := make([]*SeriesItem, 0, len(items))
+	last := make(map[int]int)
+	for _, item := range items {
+		if item == nil || strings.TrimSpace(item.MediaID) == "" {
+			continue
+		}
+
+		number := item.Number
+		if number <= 0 {
+			number = last[item.Season] + 1
+		}
+		last[item.Season] = number
+
+		series = append(series, &SeriesItem{
+			MediaID: strings.TrimSpace(item.MediaID),
+			Season:  item.Season,
+			Number:  number,
+		})
+	}
+
+	sort.SliceStable(series, func(i, j int) bool {
+		if series[i].Season != series[j].Season {
+			return series[i].Season < series[j].Season
+		}
+		return series[i].Number < series[j].Number
+	})
+
+	return series
+}
+
+// Contains returns true if the given media is a series item
+func (s Series) Contains(mediaID string) bool {
+	return s.Episode(mediaID) != nil
+}
+
+// Episode returns the series position of the given media, nil if the media is not a series item
+func (s Series) Episode(mediaID string) *Episode {
+	for i, item := range s.Items {
+		if item.MediaID != mediaID {
+			continue
+		}
+
+		episode := &Episode{
+			SeriesID: s.ExternalID,
+			Season:   item.Season,
+			Number:   item.Number,
+			Position: i,
+		}
+		if i > 0 {
+			episode.PreviousID = &s.Items[i-1].MediaID
+		}
+		if i < len(s.Items)-1 {
+			episode.NextID = &s.Items[i+1].MediaID
+		}
+
+		return episode
+	}
+
+	return nil
+}
+
+func (s *Series) IsValid() error {
+	validate := validator.New()
+
+	err := validate.Struct(s)
+	if err != nil {
+		for _, err := range err.(validator.ValidationErrors) {
+			switch {
+			case err.Tag() == "required":
+				return exception.NewErrorDescription(exception.RequiredField,
+					fmt.Sprintf(exception.RequiredFieldString, strings.ToLower(err.Field())))
+			case err.Tag() == "max" || err.Tag() == "min":
+				field := strings.ToLower(err.Field())
+				minLength, maxLength := "1", "n"
+
+				switch field {
+				case "title":
+					maxLength = "255"
+				case "ownerid", "mediaid":
+					maxLength = "128"
+				case "description":
+					minLength, maxLength = "0", "1024"
+				case "items":
+					minLength, maxLength = "0", fmt.Sprint(maxSeriesItems)
+				case "season":
+					minLength = "0"
+				}
+
+				return exception.NewErrorDescription(exception.InvalidFieldRange,
+					fmt.Sprintf(exception.InvalidFieldRangeString, field, minLength, maxLength))
+			case err.Tag() == "url":
+				return exception.NewErrorDescription(exception.InvalidFieldFormat,
+					fmt.Sprintf(exception.InvalidFieldFormatString, strings.ToLower(err.Field()), "url"))
+			}
+		}
+
+		return err
+	}
+
+	return s.validateItems()
+}
+
+// validateItems rejects media added twice and items sharing the same season and number
+func (s Series) validateItems() error {
+	media := make(map[string]bool, len(s.Items))
+	positions := make(map[SeriesItem]bool, len(s.Items))
+	for _, item := range s.Items {
+		if media[item.MediaID] {
+			return exception.NewErrorDescription(exception.EntityExists,
+				fmt.Sprintf("media %s is already in the series", item.MediaID))
+		}
+		media[item.MediaID] = true
+
+		position := SeriesItem{Season: item.Season, Number: item.Number}
+		if positions[position] {
+			return exception.NewErrorDescription(exception.EntityExists,
+				fmt.Sprintf("season %d number %d is already taken", item.Season, item.Number))
+		}
+		positions[position] = true
+	}
+
+	return nil
+}
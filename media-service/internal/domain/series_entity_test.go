@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSeriesItems(t *testing.T) {
+	items := NewSeriesItems([]*SeriesItem{
+		{MediaID: "s2e1", Season: 2},
+		{MediaID: "s1e3", Season: 1, Number: 3},
+		{MediaID: ""},
+		{MediaID: "s2e2", Season: 2},
+		{MediaID: "s1e1", Season: 1, Number: 1},
+		{MediaID: "s1e2", Season: 1},
+	})
+
+	// Missing numbers follow the previous item of the season in the given order
+	assert.Equal(t, []*SeriesItem{
+		{MediaID: "s1e1", Season: 1, Number: 1},
+		{MediaID: "s1e2", Season: 1, Number: 2},
+		{MediaID: "s1e3", Season: 1, Number: 3},
+		{MediaID: "s2e1", Season: 2, Number: 1},
+		{MediaID: "s2e2", Season: 2, Number: 2},
+	}, items)
+}
+
+func TestSeries_Episode(t *testing.T) {
+	series := Series{ExternalID: "dune", Items: NewSeriesItems([]*SeriesItem{
+		{MediaID: "dune"}, {MediaID: "messiah"}, {MediaID: "children"},
+	})}
+
+	episode := series.Episode("messiah")
+	assert.Equal(t, "dune", episode.SeriesID)
+	assert.Equal(t, 2, episode.Number)
+	assert.Equal(t, 1, episode.Position)
+	assert.Equal(t, "dune", *episode.PreviousID)
+	assert.Equal(t, "children", *episode.NextID)
+
+	episode = series.Episode("dune")
+	assert.Nil(t, episode.PreviousID)
+	assert.Equal(t, "messiah", *episode.NextID)
+	assert.Nil(t, series.Episode("children").NextID)
+	assert.Nil(t, series.Episode("emperor"))
+}
+
+func TestSeries_IsValid(t *testing.T) {
+	series, err := NewSeries(&SeriesAggregate{
+		OwnerID: "user-1",
+		Title:   "Dune Chronicles",
+		Items:   []*SeriesItem{{MediaID: "dune"}, {MediaID: "messiah"}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, series.IsValid())
+
+	series.Items = append(series.Items, &SeriesItem{MediaID: "dune", Number: 3})
+	assert.True(t, errors.Is(series.IsValid(), exception.EntityExists))
+
+	series.Items = []*SeriesItem{{MediaID: "dune", Number: 1}, {MediaID: "messiah", Number: 1}}
+	assert.True(t, errors.Is(series.IsValid(), exception.EntityExists))
+
+	cover := "not a url"
+	series.Items, series.CoverURL = nil, &cover
+	assert.True(t, errors.Is(series.IsValid(), exception.InvalidFieldFormat))
+}
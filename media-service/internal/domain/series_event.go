@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+const (
+	SeriesCreated = "SERIES_CREATED"
+	SeriesUpdated = "SERIES_UPDATED"
+	SeriesRemoved = "SERIES_REMOVED"
+)
+
+type SeriesEvent interface {
+	Created(ctx context.Context, series Series) error
+	Updated(ctx context.Context, series Series) error
+	Removed(ctx context.Context, id string) error
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"context"
+	"github.com/alexandria-oss/core"
+)
+
+type SeriesRepository interface {
+	Save(ctx context.Context, series Series) error
+	// Fetch returns a page of series sorted by creation and the next page token, empty if there is no such page
+	Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*Series, string, error)
+	FetchByID(ctx context.Context, id string) (*Series, error)
+	Replace(ctx context.Context, series Series) error
+	Remove(ctx context.Context, id string) error
+}
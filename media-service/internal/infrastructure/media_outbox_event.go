@@ -0,0 +1,298 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"go.opencensus.io/trace"
+)
+
+type MediaOutboxEvent struct {
+	cfg          *config.Kernel
+	outbox       domain.OutboxRepository
+	transactions domain.SAGATransactionRepository
+}
+
+func NewMediaOutboxEvent(cfg *config.Kernel, outbox domain.OutboxRepository,
+	transactions domain.SAGATransactionRepository) *MediaOutboxEvent {
+	return &MediaOutboxEvent{cfg: cfg, outbox: outbox, transactions: transactions}
+}
+
+func (e *MediaOutboxEvent) StartCreate(ctx context.Context, transactionID string, media domain.Media) error {
+	ownerPool := make([]string, 0)
+	ownerPool = append(ownerPool, media.PublisherID)
+
+	ownerJSON, err := json.Marshal(ownerPool)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"owner_pool", "[]string"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: start_create")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.OwnerVerify))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventIntegration, eventbus.PriorityHigh, eventbus.ProviderKafka, ownerJSON)
+	event.TracingContext = string(spanJSON)
+	t := eventbus.Transaction{
+		ID:        transactionID,
+		RootID:    media.ExternalID,
+		SpanID:    span.SpanContext().SpanID.String(),
+		TraceID:   span.SpanContext().TraceID.String(),
+		Operation: domain.MediaCreated,
+	}
+	m := domain.NewOutboxMessage(domain.OwnerVerify, t.RootID, event.Content, map[string]string{
+		"transaction_id":  t.ID,
+		"root_id":         t.RootID,
+		"span_id":         t.SpanID,
+		"trace_id":        t.TraceID,
+		"operation":       t.Operation,
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	if err = e.outbox.Save(ctx, *m); err != nil {
+		return err
+	}
+
+	return e.transactions.Save(ctx, *domain.NewSAGATransaction(t.ID, t.RootID, t.Operation, "", domain.OwnerVerify))
+}
+
+func (e *MediaOutboxEvent) StartUpdate(ctx context.Context, transactionID string, media domain.Media,
+	snapshot domain.Media) error {
+	ownerPool := make([]string, 0)
+	ownerPool = append(ownerPool, media.PublisherID)
+	ownerJSON, err := json.Marshal(ownerPool)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"owner_pool", "[]string"))
+	}
+
+	snapshotJSON, err := json.Marshal(snapshot)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"snapshot", "media entity"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: start_update")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.OwnerVerify))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	t := &eventbus.Transaction{
+		ID:        transactionID,
+		RootID:    media.ExternalID,
+		SpanID:    span.SpanContext().SpanID.String(),
+		TraceID:   span.SpanContext().TraceID.String(),
+		Operation: domain.MediaUpdated,
+		Snapshot:  string(snapshotJSON),
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventIntegration, eventbus.PriorityHigh, eventbus.ProviderKafka, ownerJSON)
+	event.TracingContext = string(spanJSON)
+
+	m := domain.NewOutboxMessage(domain.OwnerVerify, t.RootID, event.Content, map[string]string{
+		"transaction_id":  t.ID,
+		"root_id":         t.RootID,
+		"span_id":         t.SpanID,
+		"trace_id":        t.TraceID,
+		"operation":       t.Operation,
+		"snapshot":        t.Snapshot,
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	if err = e.outbox.Save(ctx, *m); err != nil {
+		return err
+	}
+
+	return e.transactions.Save(ctx, *domain.NewSAGATransaction(t.ID, t.RootID, t.Operation, t.Snapshot,
+		domain.OwnerVerify))
+}
+
+func (e *MediaOutboxEvent) Updated(ctx context.Context, media domain.Media) error {
+	mediaJSON, err := json.Marshal(media)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"media", "media entity"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: updated")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaUpdated))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityLow, eventbus.ProviderKafka, mediaJSON)
+	event.TracingContext = string(spanJSON)
+
+	m := domain.NewOutboxMessage(domain.MediaUpdated, media.ExternalID, event.Content, map[string]string{
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
+
+func (e *MediaOutboxEvent) Removed(ctx context.Context, id string) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: removed")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaRemoved))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityMid, eventbus.ProviderKafka, []byte(id))
+	event.TracingContext = string(spanJSON)
+
+	m := domain.NewOutboxMessage(domain.MediaRemoved, id, event.Content, map[string]string{
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
+
+func (e *MediaOutboxEvent) Restored(ctx context.Context, id string) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: restored")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaRestored))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityMid, eventbus.ProviderKafka, []byte(id))
+	event.TracingContext = string(spanJSON)
+
+	m := domain.NewOutboxMessage(domain.MediaRestored, id, event.Content, map[string]string{
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
+
+func (e *MediaOutboxEvent) HardRemoved(ctx context.Context, id string) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: hard_removed")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaHardRemoved))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityMid, eventbus.ProviderKafka, []byte(id))
+	event.TracingContext = string(spanJSON)
+
+	m := domain.NewOutboxMessage(domain.MediaHardRemoved, id, event.Content, map[string]string{
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"sync"
+	"time"
+)
+
+// MediaOutboxInMemoryRepository in-memory transactional outbox, intended for testing and local development
+type MediaOutboxInMemoryRepository struct {
+	messages []*domain.OutboxMessage
+	seq      int64
+	mu       *sync.RWMutex
+}
+
+func NewMediaOutboxInMemoryRepository() *MediaOutboxInMemoryRepository {
+	return &MediaOutboxInMemoryRepository{
+		messages: make([]*domain.OutboxMessage, 0),
+		seq:      0,
+		mu:       new(sync.RWMutex),
+	}
+}
+
+func (r *MediaOutboxInMemoryRepository) Save(_ context.Context, message domain.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, m := range r.messages {
+		if m.EventID == message.EventID {
+			return exception.EntityExists
+		}
+	}
+
+	r.seq++
+	message.ID = r.seq
+	r.messages = append(r.messages, &message)
+	return nil
+}
+
+// Lease always succeeds, in-memory outboxes are never shared between relays
+func (r *MediaOutboxInMemoryRepository) Lease(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+func (r *MediaOutboxInMemoryRepository) FetchPending(_ context.Context, limit int) ([]*domain.OutboxMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages := make([]*domain.OutboxMessage, 0)
+	for _, m := range r.messages {
+		if len(messages) >= limit {
+			break
+		}
+		if m.DispatchTime == nil && m.ParkTime == nil {
+			message := *m
+			messages = append(messages, &message)
+		}
+	}
+
+	return messages, nil
+}
+
+func (r *MediaOutboxInMemoryRepository) MarkDispatched(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, m := range r.messages {
+		if m.ID == id && m.DispatchTime == nil {
+			now := time.Now()
+			m.Attempts++
+			m.LastError = nil
+			m.DispatchTime = &now
+			return nil
+		}
+	}
+
+	return exception.EntityNotFound
+}
+
+func (r *MediaOutboxInMemoryRepository) MarkFailed(_ context.Context, id int64, msg string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, m := range r.messages {
+		if m.ID == id && m.DispatchTime == nil {
+			m.Attempts++
+			m.LastError = &msg
+			return nil
+		}
+	}
+
+	return exception.EntityNotFound
+}
+
+func (r *MediaOutboxInMemoryRepository) Park(_ context.Context, id int64, msg string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, m := range r.messages {
+		if m.ID == id && m.DispatchTime == nil && m.ParkTime == nil {
+			now := time.Now()
+			m.Attempts++
+			m.LastError = &msg
+			m.ParkTime = &now
+			return nil
+		}
+	}
+
+	return exception.EntityNotFound
+}
+
+// FetchAll returns every stored message, dispatched or not
+func (r *MediaOutboxInMemoryRepository) FetchAll() []domain.OutboxMessage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages := make([]domain.OutboxMessage, 0, len(r.messages))
+	for _, m := range r.messages {
+		messages = append(messages, *m)
+	}
+
+	return messages
+}
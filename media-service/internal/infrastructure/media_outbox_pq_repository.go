@@ -0,0 +1,176 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/lib/pq"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
+)
+
+type MediaOutboxPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+}
+
+func NewMediaOutboxPQRepository(db *sql.DB, logger log.Logger) *MediaOutboxPQRepository {
+	return &MediaOutboxPQRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *MediaOutboxPQRepository) Save(ctx context.Context, message domain.OutboxMessage) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.outbox.save", "db_connection", r.db.Stats().OpenConnections)
+
+	metadataJSON, err := json.Marshal(message.Metadata)
+	if err != nil {
+		return err
+	}
+
+	statement := `INSERT INTO alexa1.outbox(event_id, topic, root_id, body, metadata, create_time) VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err = conn.ExecContext(ctx, statement, message.EventID, message.Topic, message.RootID, message.Body, metadataJSON,
+		message.CreateTime)
+	if err != nil {
+		if customErr, ok := err.(*pq.Error); ok {
+			if customErr.Code == "23505" {
+				return exception.EntityExists
+			}
+		}
+	}
+
+	return err
+}
+
+// outboxLeaseKey advisory lock held by the relay draining the outbox
+const outboxLeaseKey = 7346810016
+
+func (r *MediaOutboxPQRepository) Lease(ctx context.Context) (bool, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.outbox.lease", "db_connection", r.db.Stats().OpenConnections)
+
+	// Transaction-level lock, released by the DBMS on commit, rollback or lost connection
+	leased := false
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, outboxLeaseKey).Scan(&leased)
+	return leased, err
+}
+
+func (r *MediaOutboxPQRepository) FetchPending(ctx context.Context, limit int) ([]*domain.OutboxMessage, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.outbox.fetch_pending", "db_connection", r.db.Stats().OpenConnections)
+
+	// Rows held by a concurrent relay are skipped instead of being published twice
+	statement := `SELECT id, event_id, topic, root_id, body, metadata, attempts, last_error, create_time, dispatch_time, park_time
+					FROM alexa1.outbox WHERE dispatch_time IS NULL AND park_time IS NULL ORDER BY id ASC FETCH FIRST $1 ROWS ONLY 
+					FOR UPDATE SKIP LOCKED`
+	rows, err := conn.QueryContext(ctx, statement, limit)
+	if err != nil {
+		return nil, err
+	} else if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	defer func() {
+		err = rows.Close()
+	}()
+
+	messages := make([]*domain.OutboxMessage, 0)
+	for rows.Next() {
+		message := new(domain.OutboxMessage)
+		metadataJSON := make([]byte, 0)
+		err = rows.Scan(&message.ID, &message.EventID, &message.Topic, &message.RootID, &message.Body, &metadataJSON,
+			&message.Attempts, &message.LastError, &message.CreateTime, &message.DispatchTime,
+			&message.ParkTime)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(metadataJSON, &message.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func (r *MediaOutboxPQRepository) MarkDispatched(ctx context.Context, id int64) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.outbox.mark_dispatched", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `UPDATE alexa1.outbox SET dispatch_time = CURRENT_TIMESTAMP, attempts = attempts + 1, last_error = NULL
+					WHERE id = $1 AND dispatch_time IS NULL`
+	res, err := conn.ExecContext(ctx, statement, id)
+	if err != nil {
+		return err
+	} else if af, err := res.RowsAffected(); af == 0 || err != nil {
+		return exception.EntityNotFound
+	}
+
+	return nil
+}
+
+func (r *MediaOutboxPQRepository) MarkFailed(ctx context.Context, id int64, msg string) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.outbox.mark_failed", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `UPDATE alexa1.outbox SET attempts = attempts + 1, last_error = $1 WHERE id = $2 AND dispatch_time IS NULL`
+	res, err := conn.ExecContext(ctx, statement, msg, id)
+	if err != nil {
+		return err
+	} else if af, err := res.RowsAffected(); af == 0 || err != nil {
+		return exception.EntityNotFound
+	}
+
+	return nil
+}
+
+func (r *MediaOutboxPQRepository) Park(ctx context.Context, id int64, msg string) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.outbox.park", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `UPDATE alexa1.outbox SET park_time = CURRENT_TIMESTAMP, attempts = attempts + 1, last_error = $1 
+					WHERE id = $2 AND dispatch_time IS NULL AND park_time IS NULL`
+	res, err := conn.ExecContext(ctx, statement, msg, id)
+	if err != nil {
+		return err
+	} else if af, err := res.RowsAffected(); af == 0 || err != nil {
+		return exception.EntityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package infrastructure
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/sony/gobreaker"
+	"github.com/spf13/viper"
+	"gocloud.dev/pubsub"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TopicOpener opens a broker topic to publish messages
+type TopicOpener func(ctx context.Context, topic string) (*pubsub.Topic, error)
+
+// NewKafkaTopicOpener returns the default Apache Kafka topic opener
+func NewKafkaTopicOpener() TopicOpener {
+	return eventbus.NewKafkaProducer
+}
+
+type outboxRelayConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	Retries     int
+	Backoff     time.Duration
+	MaxAttempts int
+}
+
+func init() {
+	viper.SetDefault("alexandria.persistence.outbox.interval", "1s")
+	viper.SetDefault("alexandria.persistence.outbox.batch", 100)
+	viper.SetDefault("alexandria.persistence.outbox.retries", 3)
+	viper.SetDefault("alexandria.persistence.outbox.backoff", "200ms")
+	viper.SetDefault("alexandria.persistence.outbox.max_attempts", 10)
+}
+
+func newOutboxRelayConfig() outboxRelayConfig {
+	return outboxRelayConfig{
+		Interval:    viper.GetDuration("alexandria.persistence.outbox.interval"),
+		BatchSize:   viper.GetInt("alexandria.persistence.outbox.batch"),
+		Retries:     viper.GetInt("alexandria.persistence.outbox.retries"),
+		Backoff:     viper.GetDuration("alexandria.persistence.outbox.backoff"),
+		MaxAttempts: viper.GetInt("alexandria.persistence.outbox.max_attempts"),
+	}
+}
+
+// MediaOutboxRelay drains the transactional outbox into the event bus.
+// Messages are published in insertion order, a message that could not be published blocks
+// every following message with the same root_id until it gets published or parked after
+// its last attempt. Only the relay holding the outbox lease drains it, replicas stay idle
+type MediaOutboxRelay struct {
+	repository domain.OutboxRepository
+	uow        domain.UnitOfWork
+	open       TopicOpener
+	logger     log.Logger
+	cfg        outboxRelayConfig
+	topics     map[string]*pubsub.Topic
+	breakers   map[string]*gobreaker.CircuitBreaker
+	mu         *sync.Mutex
+}
+
+func NewMediaOutboxRelay(_ *config.Kernel, logger log.Logger, repo domain.OutboxRepository, uow domain.UnitOfWork,
+	open TopicOpener) (*MediaOutboxRelay, func()) {
+	r := &MediaOutboxRelay{
+		repository: repo,
+		uow:        uow,
+		open:       open,
+		logger:     logger,
+		cfg:        newOutboxRelayConfig(),
+		topics:     make(map[string]*pubsub.Topic),
+		breakers:   make(map[string]*gobreaker.CircuitBreaker),
+		mu:         new(sync.Mutex),
+	}
+
+	return r, r.Close
+}
+
+// circuitBreaker returns the given topic's circuit breaker, a failing topic must not block the rest
+func (r *MediaOutboxRelay) circuitBreaker(topic string) *gobreaker.CircuitBreaker {
+	if cb, ok := r.breakers[topic]; ok {
+		return cb
+	}
+
+	st := gobreaker.Settings{
+		Name:        "media_outbox_relay_" + strings.ToLower(topic),
+		MaxRequests: 1,
+		Interval:    0,
+		Timeout:     15 * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= 3 && failureRatio >= 0.6
+		},
+		OnStateChange: nil,
+	}
+
+	r.breakers[topic] = gobreaker.NewCircuitBreaker(st)
+	return r.breakers[topic]
+}
+
+// Serve drains the outbox periodically until the given context is done
+func (r *MediaOutboxRelay) Serve(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := r.Drain(ctx); err != nil {
+				_ = r.logger.Log("method", "media.infrastructure.outbox.serve", "err", err.Error())
+			}
+		}
+	}
+}
+
+// Drain publishes a single batch of pending messages, returns the total of published messages.
+// The batch is drained within a single unit of work holding the outbox lease and the fetched rows
+func (r *MediaOutboxRelay) Drain(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	total := 0
+	err := r.uow.Do(ctx, func(ctx context.Context) error {
+		total = 0
+		// Another replica is draining the outbox
+		if leased, err := r.repository.Lease(ctx); err != nil || !leased {
+			return err
+		}
+
+		messages, err := r.repository.FetchPending(ctx, r.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		blocked := make(map[string]bool)
+		for _, m := range messages {
+			// Keep per-aggregate ordering
+			if blocked[m.RootID] {
+				continue
+			}
+
+			err = r.publish(ctx, *m)
+			if err != nil {
+				_ = r.logger.Log("method", "media.infrastructure.outbox.drain", "err", err.Error(), "event_id", m.EventID,
+					"topic", m.Topic)
+				if errM := r.fail(ctx, *m, err); errM != nil {
+					return errM
+				}
+				// Parked messages do not block the rest of the aggregate
+				blocked[m.RootID] = m.Attempts+1 < r.cfg.MaxAttempts
+				continue
+			}
+
+			// If the unit of work is rolled back the message will be sent again, consumers must be idempotent
+			if err = r.repository.MarkDispatched(ctx, m.ID); err != nil {
+				return err
+			}
+			total++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if total > 0 {
+		_ = r.logger.Log("method", "media.infrastructure.outbox.drain", "msg", "outbox messages published", "total", total)
+	}
+	return total, nil
+}
+
+// fail records a failed attempt of the given message, the message is parked after its last attempt
+func (r *MediaOutboxRelay) fail(ctx context.Context, m domain.OutboxMessage, errP error) error {
+	if m.Attempts+1 < r.cfg.MaxAttempts {
+		return r.repository.MarkFailed(ctx, m.ID, errP.Error())
+	}
+
+	_ = r.logger.Log("method", "media.infrastructure.outbox.drain", "msg", "outbox message parked", "event_id", m.EventID,
+		"topic", m.Topic, "root_id", m.RootID, "attempts", m.Attempts+1)
+	return r.repository.Park(ctx, m.ID, errP.Error())
+}
+
+// publish sends the given message with retries using an exponential backoff
+func (r *MediaOutboxRelay) publish(ctx context.Context, m domain.OutboxMessage) (err error) {
+	backoff := r.cfg.Backoff
+	for i := 0; i <= r.cfg.Retries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+				backoff *= 2
+			}
+		}
+
+		_, err = r.circuitBreaker(m.Topic).Execute(func() (interface{}, error) {
+			t, err := r.topic(ctx, m.Topic)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, t.Send(ctx, &pubsub.Message{
+				Body:       m.Body,
+				Metadata:   m.Metadata,
+				BeforeSend: nil,
+			})
+		})
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// topic returns a cached topic or opens a new one
+func (r *MediaOutboxRelay) topic(ctx context.Context, name string) (*pubsub.Topic, error) {
+	if t, ok := r.topics[name]; ok {
+		return t, nil
+	}
+
+	t, err := r.open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r.topics[name] = t
+	return t, nil
+}
+
+// Close shutdowns every opened topic
+func (r *MediaOutboxRelay) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, t := range r.topics {
+		_ = t.Shutdown(context.Background())
+		delete(r.topics, name)
+	}
+}
@@ -0,0 +1,180 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/mempubsub"
+	"testing"
+	"time"
+)
+
+// testUnitOfWork runs every unit of work without a transaction
+type testUnitOfWork struct{}
+
+func (testUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// leasedOutboxRepository outbox leased by another relay
+type leasedOutboxRepository struct {
+	*MediaOutboxInMemoryRepository
+}
+
+func (leasedOutboxRepository) Lease(_ context.Context) (bool, error) {
+	return false, nil
+}
+
+func newTestRelay(repo domain.OutboxRepository, topics map[string]*pubsub.Topic) *MediaOutboxRelay {
+	r, _ := NewMediaOutboxRelay(nil, log.NewNopLogger(), repo, testUnitOfWork{}, func(ctx context.Context, topic string) (*pubsub.Topic, error) {
+		if t, ok := topics[topic]; ok {
+			return t, nil
+		}
+
+		return nil, errors.New("broker not available")
+	})
+	r.cfg.Retries = 1
+	r.cfg.Backoff = time.Millisecond
+	r.cfg.MaxAttempts = 2
+	return r
+}
+
+func TestMediaOutboxRelay_Drain(t *testing.T) {
+	ctx := context.Background()
+	topics := map[string]*pubsub.Topic{
+		domain.OwnerVerify:  mempubsub.NewTopic(),
+		domain.MediaUpdated: mempubsub.NewTopic(),
+	}
+	subVerify := mempubsub.NewSubscription(topics[domain.OwnerVerify], time.Second)
+	subUpdated := mempubsub.NewSubscription(topics[domain.MediaUpdated], time.Second)
+
+	repo := NewMediaOutboxInMemoryRepository()
+	messages := []*domain.OutboxMessage{
+		domain.NewOutboxMessage(domain.OwnerVerify, "media-a", []byte(`["owner"]`), map[string]string{
+			"event_id":       "1",
+			"transaction_id": "tx-a",
+			"root_id":        "media-a",
+			"operation":      domain.MediaCreated,
+		}),
+		// Topic without broker, must block every following message of media-b
+		domain.NewOutboxMessage(domain.MediaRemoved, "media-b", []byte("media-b"), map[string]string{
+			"event_id": "2",
+		}),
+		domain.NewOutboxMessage(domain.MediaUpdated, "media-b", []byte("{}"), map[string]string{
+			"event_id": "3",
+		}),
+		domain.NewOutboxMessage(domain.MediaUpdated, "media-a", []byte("{}"), map[string]string{
+			"event_id": "4",
+		}),
+	}
+	for _, m := range messages {
+		assert.Nil(t, repo.Save(ctx, *m))
+	}
+
+	relay := newTestRelay(repo, topics)
+	defer relay.Close()
+
+	total, err := relay.Drain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, total)
+
+	msg, err := subVerify.Receive(ctx)
+	assert.Nil(t, err)
+	msg.Ack()
+	assert.Equal(t, `["owner"]`, string(msg.Body))
+	assert.Equal(t, messages[0].Metadata, msg.Metadata)
+
+	msg, err = subUpdated.Receive(ctx)
+	assert.Nil(t, err)
+	msg.Ack()
+	assert.Equal(t, "4", msg.Metadata["event_id"])
+
+	stored := repo.FetchAll()
+	assert.NotNil(t, stored[0].DispatchTime)
+	assert.Nil(t, stored[1].DispatchTime)
+	assert.Equal(t, 1, stored[1].Attempts)
+	assert.NotNil(t, stored[1].LastError)
+	assert.Nil(t, stored[2].DispatchTime)
+	assert.Equal(t, 0, stored[2].Attempts)
+	assert.NotNil(t, stored[3].DispatchTime)
+
+	// Broker is available again, pending messages of media-b are relayed in order
+	topics[domain.MediaRemoved] = mempubsub.NewTopic()
+	subRemoved := mempubsub.NewSubscription(topics[domain.MediaRemoved], time.Second)
+
+	total, err = relay.Drain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, total)
+
+	msg, err = subRemoved.Receive(ctx)
+	assert.Nil(t, err)
+	msg.Ack()
+	assert.Equal(t, "2", msg.Metadata["event_id"])
+
+	msg, err = subUpdated.Receive(ctx)
+	assert.Nil(t, err)
+	msg.Ack()
+	assert.Equal(t, "3", msg.Metadata["event_id"])
+
+	pending, err := repo.FetchPending(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(pending))
+}
+
+func TestMediaOutboxRelay_DrainPark(t *testing.T) {
+	ctx := context.Background()
+	topics := map[string]*pubsub.Topic{domain.MediaUpdated: mempubsub.NewTopic()}
+	subUpdated := mempubsub.NewSubscription(topics[domain.MediaUpdated], time.Second)
+
+	repo := NewMediaOutboxInMemoryRepository()
+	// Topic without broker, poison message of media-a
+	assert.Nil(t, repo.Save(ctx, *domain.NewOutboxMessage(domain.MediaRemoved, "media-a", []byte("media-a"),
+		map[string]string{"event_id": "1"})))
+	assert.Nil(t, repo.Save(ctx, *domain.NewOutboxMessage(domain.MediaUpdated, "media-a", []byte("{}"),
+		map[string]string{"event_id": "2"})))
+
+	relay := newTestRelay(repo, topics)
+	defer relay.Close()
+
+	total, err := relay.Drain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+
+	// Last attempt parks the message, the rest of the aggregate is relayed
+	total, err = relay.Drain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, total)
+
+	msg, err := subUpdated.Receive(ctx)
+	assert.Nil(t, err)
+	msg.Ack()
+	assert.Equal(t, "2", msg.Metadata["event_id"])
+
+	stored := repo.FetchAll()
+	assert.NotNil(t, stored[0].ParkTime)
+	assert.Nil(t, stored[0].DispatchTime)
+	assert.Equal(t, 2, stored[0].Attempts)
+
+	pending, err := repo.FetchPending(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(pending))
+}
+
+func TestMediaOutboxRelay_DrainLeased(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMediaOutboxInMemoryRepository()
+	assert.Nil(t, repo.Save(ctx, *domain.NewOutboxMessage(domain.MediaUpdated, "media-a", []byte("{}"),
+		map[string]string{"event_id": "1"})))
+
+	relay := newTestRelay(leasedOutboxRepository{repo}, map[string]*pubsub.Topic{domain.MediaUpdated: mempubsub.NewTopic()})
+	defer relay.Close()
+
+	// Outbox is drained by another replica
+	total, err := relay.Drain(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+	assert.Nil(t, repo.FetchAll()[0].DispatchTime)
+}
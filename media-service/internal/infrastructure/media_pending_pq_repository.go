@@ -0,0 +1,127 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
+	"time"
+)
+
+type MediaPendingPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+}
+
+func NewMediaPendingPQRepository(db *sql.DB, logger log.Logger) *MediaPendingPQRepository {
+	return &MediaPendingPQRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *MediaPendingPQRepository) Save(ctx context.Context, tx domain.PendingTransaction) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.pending.save", "db_connection", r.db.Stats().OpenConnections)
+
+	// A new transaction replaces the running one, responses carrying the replaced transaction ID cannot claim it
+	statement := `INSERT INTO alexa1.pending_transaction(root_id, transaction_id, operation, snapshot, attempts, create_time,
+					update_time) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (root_id) DO UPDATE SET
+					transaction_id = EXCLUDED.transaction_id, operation = EXCLUDED.operation, snapshot = EXCLUDED.snapshot,
+					attempts = EXCLUDED.attempts, create_time = EXCLUDED.create_time, update_time = EXCLUDED.update_time`
+	_, err = conn.ExecContext(ctx, statement, tx.RootID, tx.ID, tx.Operation, tx.Snapshot, tx.Attempts, tx.CreateTime,
+		tx.UpdateTime)
+	return err
+}
+
+func (r *MediaPendingPQRepository) FetchExpired(ctx context.Context, since time.Time, limit int) ([]*domain.PendingTransaction,
+	error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.pending.fetch_expired", "db_connection", r.db.Stats().OpenConnections)
+
+	// Lease expired transactions by renewing their update time, rows locked by another watchdog replica are skipped
+	// and leased ones will not expire again until the deadline passes
+	statement := `UPDATE alexa1.pending_transaction SET update_time = CURRENT_TIMESTAMP WHERE root_id IN (
+					SELECT root_id FROM alexa1.pending_transaction WHERE update_time <= $1 ORDER BY update_time ASC
+					LIMIT $2 FOR UPDATE SKIP LOCKED)
+					RETURNING root_id, transaction_id, operation, snapshot, attempts, create_time, update_time`
+	rows, err := conn.QueryContext(ctx, statement, since, limit)
+	if err != nil {
+		return nil, err
+	} else if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	defer func() {
+		err = rows.Close()
+	}()
+
+	transactions := make([]*domain.PendingTransaction, 0)
+	for rows.Next() {
+		tx := new(domain.PendingTransaction)
+		err = rows.Scan(&tx.RootID, &tx.ID, &tx.Operation, &tx.Snapshot, &tx.Attempts, &tx.CreateTime, &tx.UpdateTime)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, tx)
+	}
+
+	return transactions, nil
+}
+
+func (r *MediaPendingPQRepository) Attempt(ctx context.Context, rootID, transactionID, renewedID string) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.pending.attempt", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `UPDATE alexa1.pending_transaction SET transaction_id = $3, attempts = attempts + 1,
+					update_time = CURRENT_TIMESTAMP WHERE root_id = $1 AND transaction_id = $2`
+	res, err := conn.ExecContext(ctx, statement, rootID, transactionID, renewedID)
+	if err != nil {
+		return err
+	} else if af, err := res.RowsAffected(); af == 0 || err != nil {
+		return exception.EntityNotFound
+	}
+
+	return nil
+}
+
+func (r *MediaPendingPQRepository) Claim(ctx context.Context, rootID, transactionID string) (*domain.PendingTransaction,
+	error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.pending.claim", "db_connection", r.db.Stats().OpenConnections)
+
+	// Only one of the verification response and the watchdog gets the row back
+	statement := `DELETE FROM alexa1.pending_transaction WHERE root_id = $1 AND transaction_id = $2
+					RETURNING root_id, transaction_id, operation, snapshot, attempts, create_time, update_time`
+	tx := new(domain.PendingTransaction)
+	err = conn.QueryRowContext(ctx, statement, rootID, transactionID).Scan(&tx.RootID, &tx.ID, &tx.Operation,
+		&tx.Snapshot, &tx.Attempts, &tx.CreateTime, &tx.UpdateTime)
+	if err == sql.ErrNoRows {
+		return nil, exception.EntityNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
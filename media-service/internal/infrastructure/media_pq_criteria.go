@@ -2,88 +2,198 @@ package infrastructure
 
 import (
 	"fmt"
-	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
+// mediaColumns media table columns, generated columns (e.g. search_vector) are not mapped into the entity
+const mediaColumns = `id, external_id, title, display_name, description, language_code, publisher_id, ` +
+	`publish_date, media_type, create_time, update_time, delete_time, active, content_url, total_views, status, visibility, ` +
+	`content_type, content_length, duration, explicit`
+
+// mediaRank full-text search relevance of a media row
+const mediaRank = `ts_rank_cd(search_vector, search_query)`
+
 // PQ Query builder
 type MediaQuery struct {
-	Statement string
+	criteria *pqutil.Criteria
+}
+
+// NewMediaQuery returns a media query builder, snippet and rank columns are always empty
+func NewMediaQuery() *MediaQuery {
+	return &MediaQuery{criteria: pqutil.NewCriteria(`SELECT ` + mediaColumns + `, NULL AS snippet, 0::real AS rank FROM alexa1.media`)}
+}
+
+// NewMediaSearchQuery returns a media full-text search query builder using the text search configuration of the
+// given language, snippet column holds the highlighted matches.
+// Query words are matched by prefix
+func NewMediaSearchQuery(query, lang string) *MediaQuery {
+	return &MediaQuery{criteria: pqutil.NewCriteria(`SELECT `+mediaColumns+`, ts_headline(alexa1.search_config(language_code), `+
+		`title || ' ' || COALESCE(description, ''), search_query, 'MaxFragments=2, StartSel=<b>, StopSel=</b>') AS snippet, `+
+		mediaRank+` AS rank FROM alexa1.media, to_tsquery(alexa1.search_config($1), $2) search_query`, lang, pqutil.PrefixQuery(query)).
+		Where(pqutil.Raw(`search_vector @@ search_query`))}
+}
+
+// NewMediaSeriesQuery returns a query builder of the given series items, position column holds the series order
+func NewMediaSeriesQuery(seriesID string) *MediaQuery {
+	return &MediaQuery{criteria: pqutil.NewCriteria(`SELECT `+mediaColumns+`, NULL AS snippet, 0::real AS rank FROM alexa1.media `+
+		`JOIN alexa1.series_items ON series_items.media_id = media.external_id AND series_items.series_id = $1`, seriesID)}
+}
+
+// NewMediaFetchQuery returns a media query fetching size rows after the given cursor using the filtering params
+func NewMediaFetchQuery(cursor domain.Cursor, size int, filter core.FilterParams) (*MediaQuery, error) {
+	var b *MediaQuery
+	if filter["series"] != "" {
+		b = NewMediaSeriesQuery(filter["series"]).Like(filter["query"])
+	} else if cursor.Sort == domain.SortPosition {
+		// Series page tokens are only valid within their series listing
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "page_token", "a valid page token"))
+	} else if cursor.Sort == domain.SortRank {
+		b = NewMediaSearchQuery(filter["query"], filter["lang"])
+	} else {
+		b = NewMediaQuery().Like(filter["query"])
+	}
+	b.Language(filter["lang"]).Publisher(filter["publisher"]).Author(filter["author"]).MediaType(filter["media_type"])
+
+	if _, err := b.Seek(cursor); err != nil {
+		return nil, err
+	}
+
+	return b.Active(strings.ToUpper(filter["show_disabled"]) != "TRUE").Status(domain.StatusDone).
+		Visibility(filter["viewer"], strings.ToUpper(filter["show_hidden"]) == "TRUE").Limit(size), nil
 }
 
+// Like returns a query to search by title or display_name, not indexed
 func (b *MediaQuery) Like(query string) *MediaQuery {
 	if query == "" {
 		return b
 	}
 
-	b.Statement += `LOWER(title) LIKE LOWER('%` + query + `%') OR LOWER(display_name) LIKE LOWER('%` + query + `%')`
+	b.criteria.Where(pqutil.Contains(query, "title", "display_name"))
 	return b
 }
 
+// Language returns a query to search by language_code
 func (b *MediaQuery) Language(lang string) *MediaQuery {
 	if lang == "" {
 		return b
 	}
 
-	b.Statement += `language_code = '` + lang + `'`
+	b.criteria.Where(pqutil.Compare("language_code", pqutil.Equal, lang))
 	return b
 }
 
+// Publisher returns a query to search by publisher_id
 func (b *MediaQuery) Publisher(id string) *MediaQuery {
 	if id == "" {
 		return b
 	}
 
-	b.Statement += `publisher_id = '` + id + `'`
+	b.criteria.Where(pqutil.Compare("publisher_id", pqutil.Equal, id))
 	return b
 }
 
+// Author returns a query to search by any credited author
 func (b *MediaQuery) Author(id string) *MediaQuery {
 	if id == "" {
 		return b
 	}
 
-	b.Statement += `author_id = '` + id + `'`
+	b.criteria.Where(pqutil.In("external_id", `SELECT media_id FROM alexa1.media_authors WHERE author_id =`, id))
 	return b
 }
 
+// MediaType returns a query to search by any of the given comma-separated media_type values (e.g. book,doc),
+// non-enum values are ignored
 func (b *MediaQuery) MediaType(media string) *MediaQuery {
-	media = domain.ParseMediaType(media)
-
-	if media == "" {
+	types := make([]pqutil.Expression, 0)
+	for _, m := range strings.Split(media, ",") {
+		if m = domain.ParseMediaType(strings.TrimSpace(m)); m != "" {
+			types = append(types, pqutil.Compare("media_type", pqutil.Equal, m))
+		}
+	}
+	if len(types) == 0 {
 		return b
 	}
 
-	b.Statement += `media_type = '` + media + `'`
+	b.criteria.Where(pqutil.Or(types...))
 	return b
 }
 
-// Filter
-// Returns a query to filter useful fields like timestamp, id, or total_views
-/*
-key = field,
-op = operator,
-id = entity external_id,
-state = is entity active
-*/
-func (b *MediaQuery) Filter(key, op, id, state string) *MediaQuery {
-	state = strings.ToUpper(state)
-	b.Statement += fmt.Sprintf(`%s %s (SELECT %s FROM alexa1.media WHERE external_id = '%s' AND active = %s)`,
-		key, op, key, id, state)
+// Visibility returns a query to search public media and every media published by the given viewer,
+// hidden media (private and unlisted) is included if showHidden
+func (b *MediaQuery) Visibility(viewer string, showHidden bool) *MediaQuery {
+	if showHidden {
+		return b
+	}
+
+	if viewer == "" {
+		b.criteria.Where(pqutil.Compare("visibility", pqutil.Equal, domain.VisibilityPublic))
+		return b
+	}
+
+	b.criteria.Where(pqutil.Or(pqutil.Compare("visibility", pqutil.Equal, domain.VisibilityPublic), pqutil.Compare("publisher_id", pqutil.Equal, viewer)))
 	return b
 }
 
+// Seek returns a keyset query fetching rows after the cursor boundary sorted by the cursor key,
+// backward cursors fetch rows before the boundary in reverse order
+func (b *MediaQuery) Seek(cursor domain.Cursor) (*MediaQuery, error) {
+	field := cursor.Sort
+	if cursor.Sort == domain.SortRank {
+		field = mediaRank
+	}
+
+	// Backward pages are read in reverse from the boundary
+	direction := pqutil.Direction(cursor.Direction)
+	if cursor.Backward {
+		direction = pqutil.Ascending
+		if cursor.Direction == domain.SortAscending {
+			direction = pqutil.Descending
+		}
+	}
+
+	if cursor.ID != "" {
+		value, err := parseCursorValue(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		op := pqutil.Less
+		if direction == pqutil.Ascending {
+			op = pqutil.Greater
+		}
+		b.criteria.Where(pqutil.Seek([]string{field, "external_id"}, op, value, cursor.ID))
+	}
+
+	b.criteria.OrderBy(field, direction).OrderBy("external_id", direction)
+	return b, nil
+}
+
 // Generic SQL
 
-// Active return a query to search by entity's state
-func (b *MediaQuery) Active(state string) *MediaQuery {
-	b.Statement += "active = " + state
+// Active returns a query to search by entity's state
+func (b *MediaQuery) Active(state bool) *MediaQuery {
+	b.criteria.Where(pqutil.Compare("active", pqutil.Equal, state))
 	return b
 }
 
-// Raw returns a query with the raw SQL query
-func (b *MediaQuery) Raw(statement string) *MediaQuery {
-	b.Statement += statement
+// Status returns a query to search by entity's SAGA status
+func (b *MediaQuery) Status(status string) *MediaQuery {
+	b.criteria.Where(pqutil.Compare("status", pqutil.Equal, status))
+	return b
+}
+
+// Where returns a query with the given expressions
+func (b *MediaQuery) Where(expressions ...pqutil.Expression) *MediaQuery {
+	b.criteria.Where(expressions...)
 	return b
 }
 
@@ -91,32 +201,45 @@ func (b *MediaQuery) Raw(statement string) *MediaQuery {
 /*
 key = field,
 def = default order,
-param = sorting from params, will replace default value
+param = sorting from params, will replace default value if valid
 */
-func (b *MediaQuery) OrderBy(key, def, param string) *MediaQuery {
-	if param != "" {
-		b.Statement += fmt.Sprintf(` ORDER BY %s %s`, key, param)
-		return b
-	}
-
-	b.Statement += fmt.Sprintf(` ORDER BY %s %s`, key, strings.ToUpper(def))
+func (b *MediaQuery) OrderBy(key string, def pqutil.Direction, param string) *MediaQuery {
+	b.criteria.OrderBy(key, pqutil.ParseDirection(param, def))
 	return b
 }
 
 // Limit returns a query with a limiter, useful for pagination
 func (b *MediaQuery) Limit(limit int) *MediaQuery {
-	b.Statement += fmt.Sprintf(` FETCH FIRST %d ROWS ONLY`, limit)
+	b.criteria.Limit(limit)
 	return b
 }
 
-// And returns a query with the AND statement
-func (b *MediaQuery) And() *MediaQuery {
-	b.Statement += " AND "
-	return b
+// Build returns the SQL statement and its args
+func (b *MediaQuery) Build() (string, []interface{}) {
+	return b.criteria.Build()
 }
 
-// Or returns a query with the OR statement
-func (b *MediaQuery) Or() *MediaQuery {
-	b.Statement += " OR "
-	return b
+// parseCursorValue returns the typed sort key value of the given cursor
+func parseCursorValue(cursor domain.Cursor) (value interface{}, err error) {
+	switch cursor.Sort {
+	case domain.SortCreateTime, domain.SortUpdateTime:
+		value, err = time.Parse(time.RFC3339Nano, cursor.Value)
+	case domain.SortTotalViews:
+		value, err = strconv.ParseInt(cursor.Value, 10, 64)
+	case domain.SortPosition:
+		value, err = strconv.Atoi(cursor.Value)
+	case domain.SortRank:
+		var rank float64
+		rank, err = strconv.ParseFloat(cursor.Value, 32)
+		value = float32(rank)
+	default:
+		value = cursor.Value
+	}
+
+	if err != nil {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "page_token", "a valid page token"))
+	}
+
+	return value, nil
 }
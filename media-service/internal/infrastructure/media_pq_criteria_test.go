@@ -0,0 +1,236 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mediaSelect = `SELECT ` + mediaColumns + `, NULL AS snippet, 0::real AS rank FROM alexa1.media`
+	mediaSearch = `SELECT ` + mediaColumns + `, ts_headline(alexa1.search_config(language_code), ` +
+		`title || ' ' || COALESCE(description, ''), search_query, 'MaxFragments=2, StartSel=<b>, StopSel=</b>') AS snippet, ` +
+		`ts_rank_cd(search_vector, search_query) AS rank FROM alexa1.media, to_tsquery(alexa1.search_config($1), $2) search_query`
+	mediaSeries = mediaSelect + ` JOIN alexa1.series_items ON series_items.media_id = media.external_id AND series_items.series_id = $1`
+)
+
+func TestNewMediaFetchQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		cursor    *domain.Cursor
+		filter    core.FilterParams
+		statement string
+		args      []interface{}
+	}{
+		{
+			name:   "default",
+			filter: core.FilterParams{},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "query",
+			filter: core.FilterParams{"query": "dune' OR 1=1 --"},
+			statement: mediaSelect + ` WHERE (LOWER(title) LIKE LOWER($1) OR LOWER(display_name) LIKE LOWER($1))` +
+				` AND active = $2 AND status = $3 AND visibility = $4 ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"%dune' OR 1=1 --%", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "query wildcards",
+			filter: core.FilterParams{"query": "100%_"},
+			statement: mediaSelect + ` WHERE (LOWER(title) LIKE LOWER($1) OR LOWER(display_name) LIKE LOWER($1))` +
+				` AND active = $2 AND status = $3 AND visibility = $4 ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{`%100\%\_%`, true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "lang",
+			filter: core.FilterParams{"lang": "es"},
+			statement: mediaSelect + ` WHERE language_code = $1 AND active = $2 AND status = $3 AND visibility = $4` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"es", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "publisher",
+			filter: core.FilterParams{"publisher": "123"},
+			statement: mediaSelect + ` WHERE publisher_id = $1 AND active = $2 AND status = $3 AND visibility = $4` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"123", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "author",
+			filter: core.FilterParams{"author": "456"},
+			statement: mediaSelect + ` WHERE external_id IN (SELECT media_id FROM alexa1.media_authors WHERE author_id = $1) AND active = $2 AND status = $3 AND visibility = $4` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"456", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "media_type",
+			filter: core.FilterParams{"media_type": "book"},
+			statement: mediaSelect + ` WHERE media_type = $1 AND active = $2 AND status = $3 AND visibility = $4` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"MEDIA_BOOK", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "many media_type",
+			filter: core.FilterParams{"media_type": "book, doc,comic"},
+			statement: mediaSelect + ` WHERE (media_type = $1 OR media_type = $2) AND active = $3 AND status = $4 AND visibility = $5` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"MEDIA_BOOK", "MEDIA_DOC", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "invalid media_type",
+			filter: core.FilterParams{"media_type": "'; DROP TABLE alexa1.media; --"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "show_disabled",
+			filter: core.FilterParams{"show_disabled": "true"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{false, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "viewer",
+			filter: core.FilterParams{"viewer": "123"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND (visibility = $3 OR publisher_id = $4)` +
+				` ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public", "123"},
+		},
+		{
+			name:   "show_hidden",
+			filter: core.FilterParams{"viewer": "123", "show_hidden": "true"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE"},
+		},
+		{
+			name:   "sort",
+			filter: core.FilterParams{"sort": "asc"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY total_views ASC, external_id ASC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "invalid sort",
+			filter: core.FilterParams{"sort": "asc; DROP TABLE alexa1.media"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY total_views DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "filter_by create_time",
+			filter: core.FilterParams{"filter_by": "create_time"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY create_time ASC, external_id ASC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "filter_by timestamp",
+			filter: core.FilterParams{"filter_by": "timestamp"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY update_time DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "filter_by title",
+			filter: core.FilterParams{"filter_by": "title", "sort": "desc"},
+			statement: mediaSelect + ` WHERE active = $1 AND status = $2 AND visibility = $3 ORDER BY title DESC, external_id DESC` +
+				` FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "search_mode fulltext",
+			filter: core.FilterParams{"query": "micro-serv!", "search_mode": "fulltext"},
+			statement: mediaSearch + ` WHERE search_vector @@ search_query AND active = $3 AND status = $4 AND visibility = $5` +
+				` ORDER BY ts_rank_cd(search_vector, search_query) DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"", "micro:* & serv:*", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "search_mode fulltext without words",
+			filter: core.FilterParams{"query": "&|!", "search_mode": "fulltext"},
+			statement: mediaSelect + ` WHERE (LOWER(title) LIKE LOWER($1) OR LOWER(display_name) LIKE LOWER($1))` +
+				` AND active = $2 AND status = $3 AND visibility = $4 ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"%&|!%", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "total_views cursor",
+			cursor: &domain.Cursor{Sort: domain.SortTotalViews, Direction: domain.SortDescending, Value: "42", ID: "abc"},
+			filter: core.FilterParams{"author": "456"},
+			statement: mediaSelect + ` WHERE external_id IN (SELECT media_id FROM alexa1.media_authors WHERE author_id = $1) AND (total_views, external_id) < ($2, $3)` +
+				` AND active = $4 AND status = $5 AND visibility = $6 ORDER BY total_views DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"456", int64(42), "abc", true, "STATUS_DONE", "public"},
+		},
+		{
+			name: "backward update_time cursor",
+			cursor: &domain.Cursor{Sort: domain.SortUpdateTime, Direction: domain.SortDescending,
+				Value: "2020-06-01T10:00:00.123456Z", ID: "abc", Backward: true},
+			filter: core.FilterParams{},
+			statement: mediaSelect + ` WHERE (update_time, external_id) > ($1, $2)` +
+				` AND active = $3 AND status = $4 AND visibility = $5 ORDER BY update_time ASC, external_id ASC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{time.Date(2020, 6, 1, 10, 0, 0, 123456000, time.UTC), "abc", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "title cursor",
+			cursor: &domain.Cursor{Sort: domain.SortTitle, Direction: domain.SortAscending, Value: "Dune", ID: "abc"},
+			filter: core.FilterParams{"show_disabled": "true"},
+			statement: mediaSelect + ` WHERE (title, external_id) > ($1, $2)` +
+				` AND active = $3 AND status = $4 AND visibility = $5 ORDER BY title ASC, external_id ASC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"Dune", "abc", false, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "rank cursor",
+			cursor: &domain.Cursor{Sort: domain.SortRank, Direction: domain.SortDescending, Value: "0.1", ID: "abc"},
+			filter: core.FilterParams{"query": "Microservices", "search_mode": "fulltext", "lang": "en"},
+			statement: mediaSearch + ` WHERE search_vector @@ search_query AND language_code = $3` +
+				` AND (ts_rank_cd(search_vector, search_query), external_id) < ($4, $5) AND active = $6 AND status = $7 AND visibility = $8` +
+				` ORDER BY ts_rank_cd(search_vector, search_query) DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"en", "microservices:*", "en", float32(0.1), "abc", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "series",
+			filter: core.FilterParams{"series": "789", "filter_by": "title", "search_mode": "fulltext", "query": "dune"},
+			statement: mediaSeries + ` WHERE (LOWER(title) LIKE LOWER($2) OR LOWER(display_name) LIKE LOWER($2))` +
+				` AND active = $3 AND status = $4 AND visibility = $5 ORDER BY position ASC, external_id ASC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"789", "%dune%", true, "STATUS_DONE", "public"},
+		},
+		{
+			name:   "backward position cursor",
+			cursor: &domain.Cursor{Sort: domain.SortPosition, Direction: domain.SortAscending, Value: "4", ID: "abc", Backward: true},
+			filter: core.FilterParams{"series": "789"},
+			statement: mediaSeries + ` WHERE (position, external_id) < ($2, $3)` +
+				` AND active = $4 AND status = $5 AND visibility = $6 ORDER BY position DESC, external_id DESC FETCH FIRST 10 ROWS ONLY`,
+			args: []interface{}{"789", 4, "abc", true, "STATUS_DONE", "public"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := domain.NewCursor(tt.filter)
+			if tt.cursor != nil {
+				cursor = *tt.cursor
+			}
+
+			query, err := NewMediaFetchQuery(cursor, 10, tt.filter)
+			assert.Nil(t, err)
+
+			statement, args := query.Build()
+			assert.Equal(t, tt.statement, statement)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+
+	_, err := NewMediaFetchQuery(domain.Cursor{Sort: domain.SortTotalViews, Direction: domain.SortAscending, Value: "x",
+		ID: "abc"}, 10, core.FilterParams{})
+	assert.NotNil(t, err)
+
+	// Series page tokens are rejected outside their series listing
+	_, err = NewMediaFetchQuery(domain.Cursor{Sort: domain.SortPosition, Direction: domain.SortAscending, Value: "4",
+		ID: "abc"}, 10, core.FilterParams{})
+	assert.NotNil(t, err)
+}
@@ -9,15 +9,13 @@ import (
 	"github.com/go-redis/redis/v7"
 	"github.com/lib/pq"
 	"github.com/maestre3d/alexandria/media-service/internal/domain"
-	"strings"
-	"sync"
+	"github.com/maestre3d/alexandria/shared/pqutil"
 )
 
 type MediaPQRepository struct {
 	db     *sql.DB
 	mem    *redis.Client
 	logger log.Logger
-	mu     *sync.Mutex
 }
 
 func NewMediaPQRepository(db *sql.DB, mem *redis.Client, logger log.Logger) *MediaPQRepository {
@@ -25,72 +23,75 @@ func NewMediaPQRepository(db *sql.DB, mem *redis.Client, logger log.Logger) *Med
 		db:     db,
 		mem:    mem,
 		logger: logger,
-		mu:     new(sync.Mutex),
 	}
 }
 
 func (r *MediaPQRepository) Save(ctx context.Context, media domain.Media) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
-	if err != nil {
-		return err
-	}
-	defer conn.Close()
-	// Use Go CDK OpenCensus database metrics
-	_ = r.logger.Log("method", "media.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
+	// Media and its credits are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "media.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
 
-	statement := `INSERT INTO alexa1.media(external_id, title, display_name, description, language_code, publisher_id, author_id, publish_date, media_type) 
-					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+		statement := `INSERT INTO alexa1.media(external_id, title, display_name, description, language_code, publisher_id, publish_date, media_type, 
+					visibility, duration, explicit) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
 
-	_, err = conn.ExecContext(ctx, statement, media.ExternalID, media.Title, media.DisplayName, media.Description, media.LanguageCode, media.PublisherID,
-		media.AuthorID, media.PublishDate, media.MediaType)
-	if err != nil {
-		if customErr, ok := err.(*pq.Error); ok {
-			if customErr.Code == "23505" {
-				return exception.EntityExists
+		_, err = conn.ExecContext(ctx, statement, media.ExternalID, media.Title, media.DisplayName, media.Description, media.LanguageCode, media.PublisherID,
+			media.PublishDate, media.MediaType, media.Visibility, media.Duration, media.Explicit)
+		if err == nil {
+			err = saveCredits(ctx, conn, media)
+		}
+		if err != nil {
+			if customErr, ok := err.(*pq.Error); ok {
+				if customErr.Code == "23505" {
+					return exception.EntityExists
+				}
 			}
 		}
-	}
 
-	return err
+		return err
+	})
 }
 
 func (r *MediaPQRepository) SaveRaw(ctx context.Context, media domain.Media) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
-	if err != nil {
-		return err
-	}
-	defer conn.Close()
-	// Use Go CDK OpenCensus database metrics
-	_ = r.logger.Log("method", "media.infrastructure.postgres.save_raw", "db_connection", r.db.Stats().OpenConnections)
-
-	statement := `INSERT INTO alexa1.media 
-					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
-
-	_, err = conn.ExecContext(ctx, statement, media.ID, media.ExternalID, media.Title, media.DisplayName, media.Description, media.LanguageCode, media.PublisherID,
-		media.AuthorID, media.PublishDate, media.MediaType, media.CreateTime, media.UpdateTime, media.DeleteTime, media.Active, media.ContentURL, media.TotalViews,
-		media.Status)
-	if err != nil {
-		if customErr, ok := err.(*pq.Error); ok {
-			if customErr.Code == "23505" {
-				return exception.EntityExists
+	// Media and its credits are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "media.infrastructure.postgres.save_raw", "db_connection", r.db.Stats().OpenConnections)
+
+		statement := `INSERT INTO alexa1.media(` + mediaColumns + `) 
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)`
+
+		_, err = conn.ExecContext(ctx, statement, media.ID, media.ExternalID, media.Title, media.DisplayName, media.Description, media.LanguageCode, media.PublisherID,
+			media.PublishDate, media.MediaType, media.CreateTime, media.UpdateTime, media.DeleteTime, media.Active, media.ContentURL, media.TotalViews,
+			media.Status, media.Visibility, media.ContentType, media.ContentLength, media.Duration, media.Explicit)
+		if err == nil {
+			err = saveCredits(ctx, conn, media)
+		}
+		if err != nil {
+			if customErr, ok := err.(*pq.Error); ok {
+				if customErr.Code == "23505" {
+					return exception.EntityExists
+				}
 			}
 		}
-	}
 
-	return err
+		return err
+	})
 }
 
 func (r *MediaPQRepository) FetchByID(ctx context.Context, id string, showDisabled bool) (*domain.Media, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	if r.mem != nil {
+	// Running transactions must read their own writes, the cache is only refreshed after commit
+	if r.mem != nil && !pqutil.InTransaction(ctx) {
 		ctxR, cancel := context.WithCancel(ctx)
 		defer cancel()
 
@@ -114,23 +115,24 @@ func (r *MediaPQRepository) FetchByID(ctx context.Context, id string, showDisabl
 		}
 	}
 
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "media.infrastructure.postgres.fetch_by_id", "db_connection", r.db.Stats().OpenConnections)
 
-	statement := `SELECT * FROM alexa1.media WHERE external_id = $1`
+	statement := `SELECT ` + mediaColumns + ` FROM alexa1.media WHERE external_id = $1`
 	if !showDisabled {
 		statement += ` AND active = TRUE`
 	}
 
 	media := new(domain.Media)
 	err = conn.QueryRowContext(ctx, statement, id).Scan(&media.ID, &media.ExternalID, &media.Title, &media.DisplayName, &media.Description,
-		&media.LanguageCode, &media.PublisherID, &media.AuthorID, &media.PublishDate, &media.MediaType, &media.CreateTime, &media.UpdateTime,
-		&media.DeleteTime, &media.Active, &media.ContentURL, &media.TotalViews, &media.Status)
+		&media.LanguageCode, &media.PublisherID, &media.PublishDate, &media.MediaType, &media.CreateTime, &media.UpdateTime,
+		&media.DeleteTime, &media.Active, &media.ContentURL, &media.TotalViews, &media.Status, &media.Visibility,
+		&media.ContentType, &media.ContentLength, &media.Duration, &media.Explicit)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, exception.EntityNotFound
@@ -139,84 +141,80 @@ func (r *MediaPQRepository) FetchByID(ctx context.Context, id string, showDisabl
 		return nil, err
 	}
 
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Store(ctxR, r.mem, media.ExternalID, "media", media)
+	credits, err := fetchCredits(ctx, conn, media.ExternalID)
+	if err != nil {
+		return nil, err
 	}
+	media.Authors = credits[media.ExternalID]
+
+	r.cache(ctx, media)
 
 	return media, nil
 }
 
-func (r *MediaPQRepository) Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*domain.Media, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+func (r *MediaPQRepository) Fetch(ctx context.Context, cursor domain.Cursor, size int, filter core.FilterParams) ([]*domain.Media, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "media.infrastructure.postgres.fetch", "db_connection", r.db.Stats().OpenConnections)
 
 	// Query building
-	b := &MediaQuery{Statement: `SELECT * FROM alexa1.media WHERE `}
-	for key, value := range filter {
-		switch {
-		case key == "query" && value != "":
-			b.Like(value).And()
-			continue
-		case key == "lang" && value != "":
-			b.Language(value).And()
-			continue
-		case key == "publisher" && value != "":
-			b.Publisher(value).And()
-			continue
-		case key == "author" && value != "":
-			b.Author(value).And()
-			continue
-		case key == "media_type" && value != "":
-			b.MediaType(value).And()
-			continue
-		}
+	query, err := NewMediaFetchQuery(cursor, size, filter)
+	if err != nil {
+		return nil, err
 	}
+	statement, args := query.Build()
 
-	isActive := "TRUE"
-	if strings.ToUpper(filter["show_disabled"]) == "TRUE" {
-		isActive = "FALSE"
+	// Query exec
+	rows, err := conn.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	} else if rows.Err() != nil {
+		return nil, rows.Err()
 	}
+	defer func() {
+		err = rows.Close()
+	}()
 
-	sort := ""
-	if strings.ToUpper(filter["sort"]) == "DESC" {
-		sort = "DESC"
-	} else if strings.ToUpper(filter["sort"]) == "ASC" {
-		sort = "ASC"
+	medias := make([]*domain.Media, 0)
+	for rows.Next() {
+		media := new(domain.Media)
+		err = rows.Scan(&media.ID, &media.ExternalID, &media.Title, &media.DisplayName, &media.Description,
+			&media.LanguageCode, &media.PublisherID, &media.PublishDate, &media.MediaType, &media.CreateTime, &media.UpdateTime,
+			&media.DeleteTime, &media.Active, &media.ContentURL, &media.TotalViews, &media.Status, &media.Visibility,
+			&media.ContentType, &media.ContentLength, &media.Duration, &media.Explicit, &media.Snippet, &media.Rank)
+		if err != nil {
+			return nil, err
+		}
+		medias = append(medias, media)
 	}
 
-	if filter["filter_by"] == "id" {
-		if params.Token != "" {
-			b.Filter("id", ">=", params.Token, isActive).And()
-		}
+	if len(medias) == 0 {
+		return nil, exception.EntitiesNotFound
+	}
 
-		b.Active(isActive).And().Raw("status = '"+domain.StatusDone+"'").OrderBy("id", "asc", sort)
-	} else if filter["filter_by"] == "timestamp" {
-		if params.Token != "" {
-			b.Filter("update_time", "<=", params.Token, isActive).And()
-		}
+	// Credits of the whole page are fetched at once
+	if err = attachCredits(ctx, conn, medias); err != nil {
+		return nil, err
+	}
 
-		b.Active(isActive).And().Raw("status = '"+domain.StatusDone+"'").OrderBy("update_time", "desc", sort)
-	} else {
-		if params.Token != "" {
-			b.Filter("total_views", "<=", params.Token, isActive).And()
-		}
+	return medias, nil
+}
 
-		b.Active(isActive).And().Raw("status = '"+domain.StatusDone+"'").OrderBy("total_views", "desc", sort)
+func (r *MediaPQRepository) FetchByPublisher(ctx context.Context, publisherID string) ([]*domain.Media, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
 	}
-	b.Limit(params.Size)
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.fetch_by_publisher", "db_connection", r.db.Stats().OpenConnections)
 
-	// Query exec
-	rows, err := conn.QueryContext(ctx, b.Statement)
+	statement := `SELECT ` + mediaColumns + ` FROM alexa1.media WHERE publisher_id = $1 ORDER BY id`
+	rows, err := conn.QueryContext(ctx, statement, publisherID)
 	if err != nil {
 		return nil, err
 	} else if rows.Err() != nil {
@@ -226,73 +224,74 @@ func (r *MediaPQRepository) Fetch(ctx context.Context, params core.PaginationPar
 		err = rows.Close()
 	}()
 
+	// Users without media are not an error, cascades over them are no-ops
 	medias := make([]*domain.Media, 0)
 	for rows.Next() {
 		media := new(domain.Media)
 		err = rows.Scan(&media.ID, &media.ExternalID, &media.Title, &media.DisplayName, &media.Description,
-			&media.LanguageCode, &media.PublisherID, &media.AuthorID, &media.PublishDate, &media.MediaType, &media.CreateTime, &media.UpdateTime,
-			&media.DeleteTime, &media.Active, &media.ContentURL, &media.TotalViews, &media.Status)
+			&media.LanguageCode, &media.PublisherID, &media.PublishDate, &media.MediaType, &media.CreateTime, &media.UpdateTime,
+			&media.DeleteTime, &media.Active, &media.ContentURL, &media.TotalViews, &media.Status, &media.Visibility,
+			&media.ContentType, &media.ContentLength, &media.Duration, &media.Explicit)
 		if err != nil {
 			return nil, err
 		}
 		medias = append(medias, media)
 	}
 
-	if len(medias) == 0 {
-		return nil, exception.EntitiesNotFound
+	if err = attachCredits(ctx, conn, medias); err != nil {
+		return nil, err
 	}
 
 	return medias, nil
 }
 
 func (r *MediaPQRepository) Replace(ctx context.Context, media domain.Media) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
-	if err != nil {
-		return err
-	}
-	defer conn.Close()
-	// Use Go CDK OpenCensus database metrics
-	_ = r.logger.Log("method", "media.infrastructure.postgres.replace", "db_connection", r.db.Stats().OpenConnections)
-
-	statement := `UPDATE alexa1.media SET title = $1, display_name = $2, description = $3, language_code = $4, publisher_id = $5, author_id = $6, 
-					publish_date = $7, media_type = $8, update_time = $9, content_url = $10, total_views = $11, status = $12 WHERE 
-					external_id = $13 AND active = TRUE`
-	row, err := conn.ExecContext(ctx, statement, media.Title, media.DisplayName, media.Description, media.LanguageCode, media.PublisherID, media.AuthorID,
-		media.PublishDate, media.MediaType, media.UpdateTime, media.ContentURL, media.TotalViews, media.Status, media.ExternalID)
-	if err != nil {
-		if customErr, ok := err.(*pq.Error); ok {
-			if customErr.Code == "23505" {
-				return exception.EntityExists
+	// Media and its credits are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "media.infrastructure.postgres.replace", "db_connection", r.db.Stats().OpenConnections)
+
+		// total_views is only written by IncrementViews, replacing it would lose concurrent views
+		statement := `UPDATE alexa1.media SET title = $1, display_name = $2, description = $3, language_code = $4, publisher_id = $5, 
+					publish_date = $6, media_type = $7, update_time = $8, content_url = $9, status = $10, visibility = $11, 
+					content_type = $12, content_length = $13, duration = $14, explicit = $15 WHERE external_id = $16 AND active = TRUE`
+		row, err := conn.ExecContext(ctx, statement, media.Title, media.DisplayName, media.Description, media.LanguageCode, media.PublisherID,
+			media.PublishDate, media.MediaType, media.UpdateTime, media.ContentURL, media.Status, media.Visibility, media.ContentType,
+			media.ContentLength, media.Duration, media.Explicit, media.ExternalID)
+		if err != nil {
+			if customErr, ok := err.(*pq.Error); ok {
+				if customErr.Code == "23505" {
+					return exception.EntityExists
+				}
 			}
+
+			return err
+		} else if af, err := row.RowsAffected(); af == 0 || err != nil {
+			return exception.EntityNotFound
 		}
 
-		return err
-	} else if af, err := row.RowsAffected(); af == 0 || err != nil {
-		return exception.EntityNotFound
-	}
+		if err = saveCredits(ctx, conn, media); err != nil {
+			return err
+		}
 
-	// write-through cache pattern
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-		go Store(ctxR, r.mem, media.ExternalID, "media", media)
-	}
+		// write-through cache pattern
+		r.cache(ctx, &media)
 
-	return nil
+		return nil
+	})
 }
 
 func (r *MediaPQRepository) Remove(ctx context.Context, id string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "media.infrastructure.postgres.remove", "db_connection", r.db.Stats().OpenConnections)
 
@@ -304,25 +303,17 @@ func (r *MediaPQRepository) Remove(ctx context.Context, id string) error {
 		return exception.EntityNotFound
 	}
 
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-
-		go Remove(ctxR, r.mem, id, "media")
-	}
+	r.evict(ctx, id)
 
 	return nil
 }
 
 func (r *MediaPQRepository) Restore(ctx context.Context, id string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "media.infrastructure.postgres.restore", "db_connection", r.db.Stats().OpenConnections)
 
@@ -334,25 +325,17 @@ func (r *MediaPQRepository) Restore(ctx context.Context, id string) error {
 		return exception.EntityNotFound
 	}
 
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-
-		go Remove(ctxR, r.mem, id, "media")
-	}
+	r.evict(ctx, id)
 
 	return nil
 }
 
 func (r *MediaPQRepository) HardRemove(ctx context.Context, id string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "media.infrastructure.postgres.hard_remove", "db_connection", r.db.Stats().OpenConnections)
 
@@ -364,25 +347,17 @@ func (r *MediaPQRepository) HardRemove(ctx context.Context, id string) error {
 		return exception.EntityNotFound
 	}
 
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
-
-		go Remove(ctxR, r.mem, id, "media")
-	}
+	r.evict(ctx, id)
 
 	return nil
 }
 
 func (r *MediaPQRepository) ChangeState(ctx context.Context, id, state string) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	conn, err := r.db.Conn(ctx)
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
+	defer release()
 	// Use Go CDK OpenCensus database metrics
 	_ = r.logger.Log("method", "media.infrastructure.postgres.change_state", "db_connection", r.db.Stats().OpenConnections)
 
@@ -394,11 +369,132 @@ func (r *MediaPQRepository) ChangeState(ctx context.Context, id, state string) e
 		return exception.EntityNotFound
 	}
 
-	if r.mem != nil {
-		ctxR, cancel := context.WithCancel(ctx)
-		defer cancel()
+	r.evict(ctx, id)
+
+	return nil
+}
+
+func (r *MediaPQRepository) IncrementViews(ctx context.Context, views map[string]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.increment_views", "db_connection", r.db.Stats().OpenConnections)
+
+	ids := make([]string, 0, len(views))
+	totals := make([]int64, 0, len(views))
+	for id, total := range views {
+		ids = append(ids, id)
+		totals = append(totals, total)
+	}
+
+	// Single statement, every increment is applied atomically by the DBMS
+	statement := `UPDATE alexa1.media SET total_views = media.total_views + v.total FROM 
+					(SELECT unnest($1::varchar[]) AS external_id, unnest($2::bigint[]) AS total) v WHERE media.external_id = v.external_id`
+	if _, err = conn.ExecContext(ctx, statement, pq.Array(ids), pq.Array(totals)); err != nil {
+		return err
+	}
+
+	// Cached entities are refreshed once per batch instead of once per view
+	for _, id := range ids {
+		r.evict(ctx, id)
+	}
+
+	return nil
+}
+
+// cache stores the given media once the running unit of work gets committed, rolled back changes are never cached
+func (r *MediaPQRepository) cache(ctx context.Context, media *domain.Media) {
+	if r.mem == nil {
+		return
+	}
 
-		go Remove(ctxR, r.mem, id, "media")
+	pqutil.AfterCommit(ctx, func() {
+		go Store(context.Background(), r.mem, media.ExternalID, "media", media)
+	})
+}
+
+// evict removes the given media from the cache once the running unit of work gets committed
+func (r *MediaPQRepository) evict(ctx context.Context, id string) {
+	if r.mem == nil {
+		return
+	}
+
+	pqutil.AfterCommit(ctx, func() {
+		go Remove(context.Background(), r.mem, id, "media")
+	})
+}
+
+// saveCredits replaces the credited authors of the given media, positions keep the credits order
+func saveCredits(ctx context.Context, conn pqutil.Executor, media domain.Media) error {
+	_, err := conn.ExecContext(ctx, `DELETE FROM alexa1.media_authors WHERE media_id = $1`, media.ExternalID)
+	if err != nil || len(media.Authors) == 0 {
+		return err
+	}
+
+	ids := make([]string, 0, len(media.Authors))
+	roles := make([]string, 0, len(media.Authors))
+	positions := make([]int64, 0, len(media.Authors))
+	for i, credit := range media.Authors {
+		ids = append(ids, credit.AuthorID)
+		roles = append(roles, credit.Role)
+		positions = append(positions, int64(i))
+	}
+
+	statement := `INSERT INTO alexa1.media_authors(media_id, author_id, role_type, position) SELECT $1, unnest($2::varchar[]),
+    unnest($3::alexa1.credit_enum[]), unnest($4::smallint[])`
+	_, err = conn.ExecContext(ctx, statement, media.ExternalID, pq.Array(ids), pq.Array(roles), pq.Array(positions))
+	return err
+}
+
+// fetchCredits returns the credited authors of each given media (external_id -> credits) in order
+func fetchCredits(ctx context.Context, conn pqutil.Executor, ids ...string) (map[string][]*domain.Credit, error) {
+	statement := `SELECT media_id, author_id, role_type FROM alexa1.media_authors WHERE media_id = ANY($1)
+    ORDER BY media_id, position`
+	rows, err := conn.QueryContext(ctx, statement, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	credits := make(map[string][]*domain.Credit, len(ids))
+	for rows.Next() {
+		var mediaID string
+		credit := new(domain.Credit)
+		if err = rows.Scan(&mediaID, &credit.AuthorID, &credit.Role); err != nil {
+			return nil, err
+		}
+		credits[mediaID] = append(credits[mediaID], credit)
+	}
+
+	return credits, rows.Err()
+}
+
+// attachCredits sets the credited authors of every given media
+func attachCredits(ctx context.Context, conn pqutil.Executor, medias []*domain.Media) error {
+	if len(medias) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(medias))
+	for _, media := range medias {
+		ids = append(ids, media.ExternalID)
+	}
+
+	credits, err := fetchCredits(ctx, conn, ids...)
+	if err != nil {
+		return err
+	}
+	for _, media := range medias {
+		media.Authors = credits[media.ExternalID]
 	}
 
 	return nil
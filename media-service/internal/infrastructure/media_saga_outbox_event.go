@@ -0,0 +1,220 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"go.opencensus.io/trace"
+)
+
+type MediaSAGAOutboxEvent struct {
+	cfg          *config.Kernel
+	outbox       domain.OutboxRepository
+	transactions domain.SAGATransactionRepository
+}
+
+func NewMediaSAGAOutboxEvent(cfg *config.Kernel, outbox domain.OutboxRepository,
+	transactions domain.SAGATransactionRepository) *MediaSAGAOutboxEvent {
+	return &MediaSAGAOutboxEvent{
+		cfg:          cfg,
+		outbox:       outbox,
+		transactions: transactions,
+	}
+}
+
+func (e *MediaSAGAOutboxEvent) VerifyAuthor(ctx context.Context, authorPool []string) error {
+	// Owner/User verified, publish SERVICE_OWNER_VERIFIED
+	ec, err := eventbus.ExtractContext(ctx)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"event", "event context"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: verify")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.AuthorVerify))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	authorJSON, err := json.Marshal(authorPool)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"author_pool", "[]string"))
+	}
+
+	ec.Transaction.SpanID = span.SpanContext().SpanID.String()
+	ec.Transaction.TraceID = span.SpanContext().TraceID.String()
+
+	event := eventbus.NewEvent(e.cfg.Service, ec.Event.EventType, ec.Event.Priority, eventbus.ProviderKafka, authorJSON)
+	event.TracingContext = string(spanJSON)
+	m := domain.NewOutboxMessage(domain.AuthorVerify, ec.Transaction.RootID, event.Content, map[string]string{
+		"transaction_id":  ec.Transaction.ID,
+		"root_id":         ec.Transaction.RootID,
+		"span_id":         ec.Transaction.SpanID,
+		"trace_id":        ec.Transaction.TraceID,
+		"operation":       ec.Transaction.Operation,
+		"snapshot":        ec.Transaction.Snapshot,
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	if err = e.outbox.Save(ctx, *m); err != nil {
+		return err
+	}
+
+	return e.transactions.Save(ctx, *domain.NewSAGATransaction(ec.Transaction.ID, ec.Transaction.RootID,
+		ec.Transaction.Operation, "", domain.AuthorVerify))
+}
+
+func (e *MediaSAGAOutboxEvent) Created(ctx context.Context, media domain.Media) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: created")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaCreated))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	mediaJSON, err := json.Marshal(media)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"media", "media entity"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityLow, eventbus.ProviderKafka, mediaJSON)
+	event.TracingContext = string(spanJSON)
+	m := domain.NewOutboxMessage(domain.MediaCreated, media.ExternalID, event.Content, map[string]string{
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
+
+func (e *MediaSAGAOutboxEvent) BlobFailed(ctx context.Context, msg string) error {
+	ec, err := eventbus.ExtractContext(ctx)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"event", "event context"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: created")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaCreated))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	ec.Transaction.SpanID = span.SpanContext().SpanID.String()
+	ec.Transaction.TraceID = span.SpanContext().TraceID.String()
+
+	ev := eventbus.NewEvent(e.cfg.Service, eventbus.EventIntegration, eventbus.PriorityHigh, eventbus.ProviderKafka, []byte(msg))
+	ev.TracingContext = string(spanJSON)
+	m := domain.NewOutboxMessage(domain.BlobFailed, ec.Transaction.RootID, ev.Content, map[string]string{
+		"transaction_id":  ec.Transaction.ID,
+		"root_id":         ec.Transaction.RootID,
+		"span_id":         ec.Transaction.SpanID,
+		"trace_id":        ec.Transaction.TraceID,
+		"operation":       ec.Transaction.Operation,
+		"snapshot":        ec.Transaction.Snapshot,
+		"tracing_context": ev.TracingContext,
+		"service":         ev.ServiceName,
+		"event_id":        ev.ID,
+		"event_type":      ev.EventType,
+		"priority":        ev.Priority,
+		"provider":        ev.Provider,
+		"dispatch_time":   ev.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
+
+func (e *MediaSAGAOutboxEvent) TimedOut(ctx context.Context, tx domain.PendingTransaction) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: timed_out")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaSAGATimedOut))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	txJSON, err := json.Marshal(tx)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"transaction", "pending transaction"))
+	}
+
+	ev := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityMid, eventbus.ProviderKafka, txJSON)
+	ev.TracingContext = string(spanJSON)
+	m := domain.NewOutboxMessage(domain.MediaSAGATimedOut, tx.RootID, ev.Content, map[string]string{
+		"transaction_id":  tx.ID,
+		"root_id":         tx.RootID,
+		"operation":       tx.Operation,
+		"tracing_context": ev.TracingContext,
+		"service":         ev.ServiceName,
+		"event_id":        ev.ID,
+		"event_type":      ev.EventType,
+		"priority":        ev.Priority,
+		"provider":        ev.Provider,
+		"dispatch_time":   ev.DispatchTime,
+	})
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *m)
+}
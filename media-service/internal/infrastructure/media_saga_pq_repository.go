@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
+)
+
+type MediaSAGAPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+}
+
+func NewMediaSAGAPQRepository(db *sql.DB, logger log.Logger) *MediaSAGAPQRepository {
+	return &MediaSAGAPQRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *MediaSAGAPQRepository) Save(ctx context.Context, tx domain.SAGATransaction) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.saga.save", "db_connection", r.db.Stats().OpenConnections)
+
+	stepsJSON, err := json.Marshal(tx.Steps)
+	if err != nil {
+		return err
+	}
+
+	// Consumers might not know the whole transaction, keep previously stored values
+	statement := `INSERT INTO alexa1.saga_transaction(transaction_id, root_id, operation, step, status, snapshot, failure_message,
+					steps, create_time, update_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+					ON CONFLICT (transaction_id) DO UPDATE SET step = EXCLUDED.step, status = EXCLUDED.status,
+					snapshot = COALESCE(NULLIF(EXCLUDED.snapshot, ''), alexa1.saga_transaction.snapshot),
+					failure_message = COALESCE(EXCLUDED.failure_message, alexa1.saga_transaction.failure_message),
+					steps = alexa1.saga_transaction.steps || EXCLUDED.steps, update_time = EXCLUDED.update_time`
+	_, err = conn.ExecContext(ctx, statement, tx.ID, tx.RootID, tx.Operation, tx.Step, tx.Status, tx.Snapshot,
+		tx.FailureMessage, stepsJSON, tx.CreateTime, tx.UpdateTime)
+	return err
+}
+
+func (r *MediaSAGAPQRepository) FetchByRoot(ctx context.Context, rootID string) ([]*domain.SAGATransaction, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "media.infrastructure.postgres.saga.fetch_by_root", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT transaction_id, root_id, operation, step, status, snapshot, failure_message, steps, create_time,
+					update_time FROM alexa1.saga_transaction WHERE root_id = $1 ORDER BY create_time DESC`
+	rows, err := conn.QueryContext(ctx, statement, rootID)
+	if err != nil {
+		return nil, err
+	} else if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	defer func() {
+		err = rows.Close()
+	}()
+
+	transactions := make([]*domain.SAGATransaction, 0)
+	for rows.Next() {
+		tx := new(domain.SAGATransaction)
+		stepsJSON := make([]byte, 0)
+		err = rows.Scan(&tx.ID, &tx.RootID, &tx.Operation, &tx.Step, &tx.Status, &tx.Snapshot, &tx.FailureMessage,
+			&stepsJSON, &tx.CreateTime, &tx.UpdateTime)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(stepsJSON, &tx.Steps)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, tx)
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,127 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/sony/gobreaker"
+	"go.opencensus.io/trace"
+	"gocloud.dev/pubsub"
+	"sync"
+	"time"
+)
+
+// MediaViewKafkaEvent publishes MEDIA_VIEWED events straight into the event bus, views are not stored in the outbox
+// to keep reads free of writes. The topic is opened once and kept until cleanup
+type MediaViewKafkaEvent struct {
+	cfg     *config.Kernel
+	open    TopicOpener
+	topic   *pubsub.Topic
+	breaker *gobreaker.CircuitBreaker
+	mu      *sync.Mutex
+}
+
+func NewMediaViewKafkaEvent(cfg *config.Kernel, open TopicOpener) (*MediaViewKafkaEvent, func()) {
+	e := &MediaViewKafkaEvent{
+		cfg:  cfg,
+		open: open,
+		breaker: gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:        "media_kafka_viewed",
+			MaxRequests: 1,
+			Interval:    0,
+			Timeout:     15 * time.Second,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+				return counts.Requests >= 3 && failureRatio >= 0.6
+			},
+			OnStateChange: nil,
+		}),
+		mu: new(sync.Mutex),
+	}
+
+	return e, e.Close
+}
+
+func (e *MediaViewKafkaEvent) Viewed(ctx context.Context, view domain.MediaView) error {
+	viewJSON, err := json.Marshal(view)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"media_view", "media view"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "media: viewed")
+	defer span.End()
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaViewed))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityLow, eventbus.ProviderKafka, viewJSON)
+	event.TracingContext = string(spanJSON)
+	m := &pubsub.Message{
+		Body: viewJSON,
+		Metadata: map[string]string{
+			"tracing_context": event.TracingContext,
+			"service":         event.ServiceName,
+			"event_id":        event.ID,
+			"event_type":      event.EventType,
+			"priority":        event.Priority,
+			"provider":        event.Provider,
+			"dispatch_time":   event.DispatchTime,
+		},
+		BeforeSend: nil,
+	}
+
+	// Safe-call with circuit breaker pattern
+	_, err = e.breaker.Execute(func() (interface{}, error) {
+		topic, err := e.openTopic(ctxT)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, topic.Send(ctxT, m)
+	})
+
+	return err
+}
+
+// openTopic returns the opened topic or opens it
+func (e *MediaViewKafkaEvent) openTopic(ctx context.Context) (*pubsub.Topic, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.topic != nil {
+		return e.topic, nil
+	}
+
+	t, err := e.open(ctx, domain.MediaViewed)
+	if err != nil {
+		return nil, err
+	}
+	e.topic = t
+	return t, nil
+}
+
+// Close shutdowns the opened topic
+func (e *MediaViewKafkaEvent) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.topic != nil {
+		_ = e.topic.Shutdown(context.Background())
+		e.topic = nil
+	}
+}
@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-redis/redis/v7"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func init() {
+	viper.SetDefault("alexandria.podcast.feed.ttl", "1h")
+}
+
+// PodcastRedisRepository podcast feed cache, feeds expire after the configured TTL if they are never invalidated
+type PodcastRedisRepository struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewPodcastRedisRepository(client *redis.Client) *PodcastRedisRepository {
+	return &PodcastRedisRepository{
+		client: client,
+		ttl:    viper.GetDuration("alexandria.podcast.feed.ttl"),
+	}
+}
+
+func (r *PodcastRedisRepository) Save(ctx context.Context, feed domain.PodcastFeed) error {
+	if r.client == nil {
+		return fmt.Errorf("redis client is not available")
+	}
+
+	feedJSON, err := json.Marshal(feed)
+	if err != nil {
+		return err
+	}
+
+	return r.client.WithContext(ctx).Set(podcastFeedKey(domain.PodcastFeedKey(feed.Kind, feed.ID)), feedJSON, r.ttl).Err()
+}
+
+func (r *PodcastRedisRepository) FetchByID(ctx context.Context, kind, id string) (*domain.PodcastFeed, error) {
+	if r.client == nil {
+		return nil, fmt.Errorf("redis client is not available")
+	}
+
+	feedJSON, err := r.client.WithContext(ctx).Get(podcastFeedKey(domain.PodcastFeedKey(kind, id))).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, exception.EntityNotFound
+		}
+
+		return nil, err
+	}
+
+	feed := new(domain.PodcastFeed)
+	if err = json.Unmarshal(feedJSON, feed); err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+func (r *PodcastRedisRepository) Remove(ctx context.Context, keys ...string) error {
+	if r.client == nil {
+		return fmt.Errorf("redis client is not available")
+	} else if len(keys) == 0 {
+		return nil
+	}
+
+	redisKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, podcastFeedKey(key))
+	}
+
+	return r.client.WithContext(ctx).Del(redisKeys...).Err()
+}
+
+func podcastFeedKey(key string) string {
+	return fmt.Sprintf("media_podcast:%s", key)
+}
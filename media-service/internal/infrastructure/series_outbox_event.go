@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"go.opencensus.io/trace"
+)
+
+type SeriesOutboxEvent struct {
+	cfg    *config.Kernel
+	outbox domain.OutboxRepository
+}
+
+func NewSeriesOutboxEvent(cfg *config.Kernel, outbox domain.OutboxRepository) *SeriesOutboxEvent {
+	return &SeriesOutboxEvent{cfg: cfg, outbox: outbox}
+}
+
+func (e *SeriesOutboxEvent) Created(ctx context.Context, series domain.Series) error {
+	return e.store(ctx, domain.SeriesCreated, series)
+}
+
+func (e *SeriesOutboxEvent) Updated(ctx context.Context, series domain.Series) error {
+	return e.store(ctx, domain.SeriesUpdated, series)
+}
+
+func (e *SeriesOutboxEvent) Removed(ctx context.Context, id string) error {
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "series: removed")
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.SeriesRemoved))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityMid, eventbus.ProviderKafka, []byte(id))
+	event.TracingContext = string(spanJSON)
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *domain.NewOutboxMessage(domain.SeriesRemoved, id, event.Content, eventMetadata(event)))
+}
+
+// store saves a series domain event holding the whole series into the outbox
+func (e *SeriesOutboxEvent) store(ctx context.Context, topic string, series domain.Series) error {
+	seriesJSON, err := json.Marshal(series)
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"series", "series entity"))
+	}
+
+	// Add tracing
+	ctxT, span := trace.StartSpan(ctx, "series: "+topic)
+	defer span.End()
+	ctx = ctxT
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "send event",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", topic))
+
+	spanJSON, err := json.Marshal(span.SpanContext())
+	if err != nil {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"tracing_context", "span context"))
+	}
+
+	event := eventbus.NewEvent(e.cfg.Service, eventbus.EventDomain, eventbus.PriorityLow, eventbus.ProviderKafka, seriesJSON)
+	event.TracingContext = string(spanJSON)
+
+	// Stored within the running unit of work, relayed to the event bus afterwards
+	return e.outbox.Save(ctx, *domain.NewOutboxMessage(topic, series.ExternalID, event.Content, eventMetadata(event)))
+}
+
+// eventMetadata returns the broker message metadata of the given domain event
+func eventMetadata(event *eventbus.Event) map[string]string {
+	return map[string]string{
+		"tracing_context": event.TracingContext,
+		"service":         event.ServiceName,
+		"event_id":        event.ID,
+		"event_type":      event.EventType,
+		"priority":        event.Priority,
+		"provider":        event.Provider,
+		"dispatch_time":   event.DispatchTime,
+	}
+}
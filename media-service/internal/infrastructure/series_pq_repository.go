@@ -0,0 +1,271 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/lib/pq"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/shared/pqutil"
+)
+
+// seriesColumns series table columns
+const seriesColumns = `id, external_id, owner_id, title, description, cover_url, create_time, update_time`
+
+type SeriesPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+}
+
+func NewSeriesPQRepository(db *sql.DB, logger log.Logger) *SeriesPQRepository {
+	return &SeriesPQRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *SeriesPQRepository) Save(ctx context.Context, series domain.Series) error {
+	// Series and its items are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "series.infrastructure.postgres.save", "db_connection", r.db.Stats().OpenConnections)
+
+		statement := `INSERT INTO alexa1.series(external_id, owner_id, title, description, cover_url, create_time, update_time)
+					VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		_, err = conn.ExecContext(ctx, statement, series.ExternalID, series.OwnerID, series.Title, series.Description,
+			series.CoverURL, series.CreateTime, series.UpdateTime)
+		if err != nil {
+			return seriesError(err)
+		}
+
+		return seriesError(saveSeriesItems(ctx, conn, series))
+	})
+}
+
+func (r *SeriesPQRepository) Fetch(ctx context.Context, params core.PaginationParams, filter core.FilterParams) ([]*domain.Series, string, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "series.infrastructure.postgres.fetch", "db_connection", r.db.Stats().OpenConnections)
+
+	// Page tokens hold the id of the first row of the page
+	criteria := pqutil.NewCriteria(`SELECT ` + seriesColumns + ` FROM alexa1.series`)
+	if params.Token != "" {
+		id, err := strconv.ParseInt(params.Token, 10, 64)
+		if err != nil {
+			return nil, "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf(exception.InvalidFieldFormatString, "page_token", "a valid page token"))
+		}
+		criteria.Where(pqutil.Compare("id", pqutil.GreaterOrEqual, id))
+	}
+	if filter["owner"] != "" {
+		criteria.Where(pqutil.Compare("owner_id", pqutil.Equal, filter["owner"]))
+	}
+	if filter["media"] != "" {
+		criteria.Where(pqutil.In("external_id", `SELECT series_id FROM alexa1.series_items WHERE media_id =`, filter["media"]))
+	}
+	if filter["query"] != "" {
+		criteria.Where(pqutil.Contains(filter["query"], "title"))
+	}
+	// Fetch an extra row to know if there is another page
+	statement, args := criteria.OrderBy("id", pqutil.Ascending).Limit(params.Size + 1).Build()
+
+	rows, err := conn.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	series := make([]*domain.Series, 0)
+	for rows.Next() {
+		s := new(domain.Series)
+		err = rows.Scan(&s.ID, &s.ExternalID, &s.OwnerID, &s.Title, &s.Description, &s.CoverURL, &s.CreateTime, &s.UpdateTime)
+		if err != nil {
+			return nil, "", err
+		}
+		series = append(series, s)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(series) == 0 {
+		return nil, "", exception.EntitiesNotFound
+	}
+
+	nextToken := ""
+	if len(series) > params.Size {
+		nextToken = strconv.FormatInt(series[params.Size].ID, 10)
+		series = series[0:params.Size]
+	}
+
+	// Items of the whole page are fetched at once
+	ids := make([]string, 0, len(series))
+	for _, s := range series {
+		ids = append(ids, s.ExternalID)
+	}
+	items, err := fetchSeriesItems(ctx, conn, ids...)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, s := range series {
+		s.Items = items[s.ExternalID]
+	}
+
+	return series, nextToken, nil
+}
+
+func (r *SeriesPQRepository) FetchByID(ctx context.Context, id string) (*domain.Series, error) {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "series.infrastructure.postgres.fetch_by_id", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT ` + seriesColumns + ` FROM alexa1.series WHERE external_id = $1`
+	series := new(domain.Series)
+	err = conn.QueryRowContext(ctx, statement, id).Scan(&series.ID, &series.ExternalID, &series.OwnerID, &series.Title,
+		&series.Description, &series.CoverURL, &series.CreateTime, &series.UpdateTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, exception.EntityNotFound
+		}
+
+		return nil, err
+	}
+
+	items, err := fetchSeriesItems(ctx, conn, series.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	series.Items = items[series.ExternalID]
+
+	return series, nil
+}
+
+func (r *SeriesPQRepository) Replace(ctx context.Context, series domain.Series) error {
+	// Series and its items are stored atomically, running units of work are joined
+	return pqutil.NewUnitOfWork(r.db).Do(ctx, func(ctx context.Context) error {
+		conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+		if err != nil {
+			return err
+		}
+		defer release()
+		// Use Go CDK OpenCensus database metrics
+		_ = r.logger.Log("method", "series.infrastructure.postgres.replace", "db_connection", r.db.Stats().OpenConnections)
+
+		statement := `UPDATE alexa1.series SET owner_id = $1, title = $2, description = $3, cover_url = $4, update_time = $5
+					WHERE external_id = $6`
+		res, err := conn.ExecContext(ctx, statement, series.OwnerID, series.Title, series.Description, series.CoverURL,
+			series.UpdateTime, series.ExternalID)
+		if err != nil {
+			return seriesError(err)
+		} else if af, err := res.RowsAffected(); af == 0 || err != nil {
+			return exception.EntityNotFound
+		}
+
+		return seriesError(saveSeriesItems(ctx, conn, series))
+	})
+}
+
+func (r *SeriesPQRepository) Remove(ctx context.Context, id string) error {
+	conn, release, err := pqutil.OpenExecutor(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "series.infrastructure.postgres.remove", "db_connection", r.db.Stats().OpenConnections)
+
+	// Items are removed along with the series, grouped media is kept
+	res, err := conn.ExecContext(ctx, `DELETE FROM alexa1.series WHERE external_id = $1`, id)
+	if err != nil {
+		return err
+	} else if af, err := res.RowsAffected(); af == 0 || err != nil {
+		return exception.EntityNotFound
+	}
+
+	return nil
+}
+
+// saveSeriesItems replaces the items of the given series, positions keep the items order
+func saveSeriesItems(ctx context.Context, conn pqutil.Executor, series domain.Series) error {
+	_, err := conn.ExecContext(ctx, `DELETE FROM alexa1.series_items WHERE series_id = $1`, series.ExternalID)
+	if err != nil || len(series.Items) == 0 {
+		return err
+	}
+
+	ids := make([]string, 0, len(series.Items))
+	seasons := make([]int64, 0, len(series.Items))
+	numbers := make([]int64, 0, len(series.Items))
+	positions := make([]int64, 0, len(series.Items))
+	for i, item := range series.Items {
+		ids = append(ids, item.MediaID)
+		seasons = append(seasons, int64(item.Season))
+		numbers = append(numbers, int64(item.Number))
+		positions = append(positions, int64(i))
+	}
+
+	statement := `INSERT INTO alexa1.series_items(series_id, media_id, season, number, position) SELECT $1, unnest($2::varchar[]),
+    unnest($3::smallint[]), unnest($4::integer[]), unnest($5::integer[])`
+	_, err = conn.ExecContext(ctx, statement, series.ExternalID, pq.Array(ids), pq.Array(seasons), pq.Array(numbers),
+		pq.Array(positions))
+	return err
+}
+
+// fetchSeriesItems returns the items of each given series (external_id -> items) in order
+func fetchSeriesItems(ctx context.Context, conn pqutil.Executor, ids ...string) (map[string][]*domain.SeriesItem, error) {
+	statement := `SELECT series_id, media_id, season, number FROM alexa1.series_items WHERE series_id = ANY($1)
+    ORDER BY series_id, position`
+	rows, err := conn.QueryContext(ctx, statement, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	items := make(map[string][]*domain.SeriesItem, len(ids))
+	for rows.Next() {
+		var seriesID string
+		item := new(domain.SeriesItem)
+		if err = rows.Scan(&seriesID, &item.MediaID, &item.Season, &item.Number); err != nil {
+			return nil, err
+		}
+		items[seriesID] = append(items[seriesID], item)
+	}
+
+	return items, rows.Err()
+}
+
+// seriesError maps constraint violations into domain errors
+func seriesError(err error) error {
+	if customErr, ok := err.(*pq.Error); ok {
+		switch customErr.Code {
+		case "23505":
+			return exception.EntityExists
+		case "23503":
+			// Media removed while being added
+			return exception.NewErrorDescription(exception.EntityNotFound, "media not found")
+		}
+	}
+
+	return err
+}
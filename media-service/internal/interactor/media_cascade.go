@@ -0,0 +1,149 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+)
+
+// MediaCascade applies the cascade policy over the media of removed users, cascades are idempotent,
+// media already cascaded is no longer published by the user or gets skipped
+type MediaCascade struct {
+	logger     log.Logger
+	repository domain.MediaRepository
+	event      domain.MediaEvent
+	reports    domain.CascadeReportRepository
+	policy     domain.CascadePolicy
+	uow        domain.UnitOfWork
+}
+
+func NewMediaCascade(logger log.Logger, repo domain.MediaRepository, event domain.MediaEvent,
+	reports domain.CascadeReportRepository, policy domain.CascadePolicy, uow domain.UnitOfWork) *MediaCascade {
+	return &MediaCascade{
+		logger:     logger,
+		repository: repo,
+		event:      event,
+		reports:    reports,
+		policy:     policy,
+		uow:        uow,
+	}
+}
+
+// Cascade applies the given action over the media of the given user, the policy's action is used if
+// none was given. Dry-runs only report the affected media, the policy's dry-run mode turns every cascade
+// into a dry-run
+func (u *MediaCascade) Cascade(ctx context.Context, userID, action string, dryRun bool) (*domain.CascadeReport, error) {
+	if userID == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField,
+			fmt.Sprintf(exception.RequiredFieldString, "user_id"))
+	}
+	if action == "" {
+		action = u.policy.Action
+	}
+	action, err := domain.ParseCascadeAction(action)
+	if err != nil {
+		return nil, err
+	}
+	dryRun = dryRun || u.policy.DryRun
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	medias, err := u.repository.FetchByPublisher(ctxR, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domain.NewCascadeReport(userID, action, dryRun)
+	items := make([]*domain.CascadeItem, 0, len(medias))
+	for _, media := range medias {
+		items = append(items, report.Plan(media.ExternalID, action))
+	}
+
+	if dryRun {
+		report.Finish()
+		// Dry-runs never hide the report of an actual cascade
+		if prev, err := u.reports.FetchByID(ctxR, userID); err != nil || prev.DryRun {
+			u.saveReport(ctxR, report)
+		}
+		return report, nil
+	}
+
+	u.saveReport(ctxR, report)
+	for i, media := range medias {
+		status, err := u.apply(ctxR, media, action)
+		report.Resolve(items[i], status, err)
+		u.saveReport(ctxR, report)
+	}
+	report.Finish()
+	u.saveReport(ctxR, report)
+
+	if report.Failed > 0 {
+		return report, fmt.Errorf("%d of %d media of user %s could not be cascaded", report.Failed,
+			report.Total, userID)
+	}
+
+	_ = u.logger.Log("method", "media.interactor.cascade", "msg",
+		fmt.Sprintf("%d media of user %s cascaded", report.Total, userID))
+	return report, nil
+}
+
+// GetReport returns the latest cascade report of the given user
+func (u *MediaCascade) GetReport(ctx context.Context, userID string) (*domain.CascadeReport, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return u.reports.FetchByID(ctxR, userID)
+}
+
+// apply cascades a single media, entity and side-effects/domain event are stored atomically
+func (u *MediaCascade) apply(ctx context.Context, media *domain.Media, action string) (string, error) {
+	switch action {
+	case domain.CascadeTransfer:
+		media.PublisherID = u.policy.CommunityOwner
+		media.UpdateTime = time.Now()
+
+		return domain.CascadeItemDone, u.uow.Do(ctx, func(ctxT context.Context) error {
+			if err := u.repository.Replace(ctxT, *media); err != nil {
+				return err
+			}
+
+			return u.event.Updated(ctxT, *media)
+		})
+	case domain.CascadeSoftDelete:
+		if !media.Active {
+			return domain.CascadeItemSkipped, nil
+		}
+
+		return domain.CascadeItemDone, u.uow.Do(ctx, func(ctxT context.Context) error {
+			if err := u.repository.Remove(ctxT, media.ExternalID); err != nil {
+				return err
+			}
+
+			return u.event.Removed(ctxT, media.ExternalID)
+		})
+	case domain.CascadeHardDelete:
+		// Blob service removes the media's content
+		return domain.CascadeItemDone, u.uow.Do(ctx, func(ctxT context.Context) error {
+			if err := u.repository.HardRemove(ctxT, media.ExternalID); err != nil {
+				return err
+			}
+
+			return u.event.HardRemoved(ctxT, media.ExternalID)
+		})
+	default:
+		return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "cascade_action", action))
+	}
+}
+
+// saveReport stores the cascade progress, reports are informative hence failures are only logged
+func (u *MediaCascade) saveReport(ctx context.Context, report *domain.CascadeReport) {
+	if err := u.reports.Save(ctx, *report); err != nil {
+		_ = u.logger.Log("method", "media.interactor.cascade", "err", err.Error())
+	}
+}
@@ -0,0 +1,238 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// cascadeRepository in-memory media, any other repository call panics
+type cascadeRepository struct {
+	domain.MediaRepository
+	media map[string]*domain.Media
+}
+
+func (r *cascadeRepository) FetchByPublisher(_ context.Context, publisherID string) ([]*domain.Media, error) {
+	medias := make([]*domain.Media, 0)
+	for _, id := range []string{"media-a", "media-b", "media-c"} {
+		if media, ok := r.media[id]; ok && media.PublisherID == publisherID {
+			m := *media
+			medias = append(medias, &m)
+		}
+	}
+	return medias, nil
+}
+
+func (r *cascadeRepository) Replace(_ context.Context, media domain.Media) error {
+	r.media[media.ExternalID] = &media
+	return nil
+}
+
+func (r *cascadeRepository) Remove(_ context.Context, id string) error {
+	r.media[id].Active = false
+	return nil
+}
+
+func (r *cascadeRepository) HardRemove(_ context.Context, id string) error {
+	delete(r.media, id)
+	return nil
+}
+
+// cascadeEvent records published events, fails events of the given media
+type cascadeEvent struct {
+	domain.MediaEvent
+	events []string
+	failID string
+}
+
+func (e *cascadeEvent) publish(event, id string) error {
+	if id == e.failID {
+		return errors.New("kafka not available")
+	}
+	e.events = append(e.events, event+":"+id)
+	return nil
+}
+
+func (e *cascadeEvent) Updated(_ context.Context, media domain.Media) error {
+	return e.publish(domain.MediaUpdated, media.ExternalID)
+}
+
+func (e *cascadeEvent) Removed(_ context.Context, id string) error {
+	return e.publish(domain.MediaRemoved, id)
+}
+
+func (e *cascadeEvent) HardRemoved(_ context.Context, id string) error {
+	return e.publish(domain.MediaHardRemoved, id)
+}
+
+type cascadeReports map[string]domain.CascadeReport
+
+func (r cascadeReports) Save(_ context.Context, report domain.CascadeReport) error {
+	r[report.UserID] = report
+	return nil
+}
+
+func (r cascadeReports) FetchByID(_ context.Context, userID string) (*domain.CascadeReport, error) {
+	report, ok := r[userID]
+	if !ok {
+		return nil, exception.EntityNotFound
+	}
+	return &report, nil
+}
+
+// cascadeUnitOfWork restores the repository's media if the transaction fails
+type cascadeUnitOfWork struct {
+	repository *cascadeRepository
+}
+
+func (u cascadeUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	snapshot := make(map[string]domain.Media, len(u.repository.media))
+	for id, media := range u.repository.media {
+		snapshot[id] = *media
+	}
+
+	err := fn(ctx)
+	if err != nil {
+		u.repository.media = make(map[string]*domain.Media, len(snapshot))
+		for id := range snapshot {
+			media := snapshot[id]
+			u.repository.media[id] = &media
+		}
+	}
+	return err
+}
+
+func newCascadeMedia(id, publisherID string) *domain.Media {
+	return &domain.Media{ExternalID: id, PublisherID: publisherID, Active: true, Status: domain.StatusDone}
+}
+
+func newCascadeFixture(policy domain.CascadePolicy) (*MediaCascade, *cascadeRepository, *cascadeEvent, cascadeReports) {
+	repo := &cascadeRepository{media: map[string]*domain.Media{
+		"media-a": newCascadeMedia("media-a", "user-1"),
+		"media-b": newCascadeMedia("media-b", "user-1"),
+		"media-c": newCascadeMedia("media-c", "user-2"),
+	}}
+	event := new(cascadeEvent)
+	reports := cascadeReports{}
+
+	return NewMediaCascade(log.NewNopLogger(), repo, event, reports, policy, cascadeUnitOfWork{repo}), repo, event,
+		reports
+}
+
+func TestMediaCascade_Cascade(t *testing.T) {
+	u, repo, event, _ := newCascadeFixture(domain.CascadePolicy{
+		Action:         domain.CascadeSoftDelete,
+		CommunityOwner: "community",
+	})
+
+	_, err := u.Cascade(context.Background(), "", "", false)
+	assert.True(t, errors.Is(err, exception.RequiredField))
+	_, err = u.Cascade(context.Background(), "user-1", "purge", false)
+	assert.True(t, errors.Is(err, exception.InvalidFieldFormat))
+
+	// Dry-runs only list the affected media
+	report, err := u.Cascade(context.Background(), "user-1", "", true)
+	assert.Nil(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, domain.CascadeSoftDelete, report.Items[0].Action)
+	assert.Equal(t, domain.CascadeItemPlanned, report.Items[1].Status)
+	assert.Empty(t, event.events)
+	assert.True(t, repo.media["media-a"].Active)
+
+	// Failed items are rolled back and reported
+	event.failID = "media-a"
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.NotNil(t, err)
+	assert.Equal(t, domain.CascadeFailed, report.Status)
+	assert.Equal(t, domain.CascadeItemFailed, report.Items[0].Status)
+	assert.Equal(t, domain.CascadeItemDone, report.Items[1].Status)
+	assert.True(t, repo.media["media-a"].Active)
+	assert.False(t, repo.media["media-b"].Active)
+	assert.Equal(t, []string{domain.MediaRemoved + ":media-b"}, event.events)
+
+	// Re-runs only apply pending items, already removed media is skipped
+	event.failID = ""
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CascadeDone, report.Status)
+	assert.Equal(t, domain.CascadeItemDone, report.Items[0].Status)
+	assert.Equal(t, domain.CascadeItemSkipped, report.Items[1].Status)
+	assert.False(t, repo.media["media-a"].Active)
+	assert.Equal(t, []string{domain.MediaRemoved + ":media-b", domain.MediaRemoved + ":media-a"}, event.events)
+
+	// Dry-runs never hide the report of an actual cascade
+	_, err = u.Cascade(context.Background(), "user-1", "", true)
+	assert.Nil(t, err)
+	report, err = u.GetReport(context.Background(), "user-1")
+	assert.Nil(t, err)
+	assert.False(t, report.DryRun)
+	assert.Equal(t, domain.CascadeDone, report.Status)
+
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CascadeItemSkipped, report.Items[0].Status)
+	assert.Equal(t, domain.CascadeItemSkipped, report.Items[1].Status)
+	assert.Len(t, event.events, 2)
+}
+
+func TestMediaCascade_Transfer(t *testing.T) {
+	u, repo, event, _ := newCascadeFixture(domain.CascadePolicy{
+		Action:         domain.CascadeSoftDelete,
+		CommunityOwner: "community",
+	})
+
+	// Transferred media is published by the community
+	report, err := u.Cascade(context.Background(), "user-1", domain.CascadeTransfer, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, "community", repo.media["media-a"].PublisherID)
+	assert.Equal(t, "community", repo.media["media-b"].PublisherID)
+	assert.True(t, repo.media["media-a"].Active)
+	assert.Equal(t, "user-2", repo.media["media-c"].PublisherID)
+	assert.Equal(t, []string{domain.MediaUpdated + ":media-a", domain.MediaUpdated + ":media-b"}, event.events)
+
+	// The user no longer publishes the transferred media
+	report, err = u.Cascade(context.Background(), "user-1", domain.CascadeTransfer, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, report.Total)
+	assert.Equal(t, domain.CascadeDone, report.Status)
+	assert.Len(t, event.events, 2)
+}
+
+func TestMediaCascade_HardDelete(t *testing.T) {
+	// The policy's dry-run mode turns every cascade into a dry-run
+	u, repo, event, reports := newCascadeFixture(domain.CascadePolicy{
+		Action: domain.CascadeHardDelete,
+		DryRun: true,
+	})
+
+	report, err := u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, domain.CascadeHardDelete, report.Items[0].Action)
+	assert.Len(t, repo.media, 3)
+	assert.Empty(t, event.events)
+
+	u.policy.DryRun = false
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CascadeDone, report.Status)
+	assert.Equal(t, 2, report.Processed)
+	assert.NotContains(t, repo.media, "media-a")
+	assert.NotContains(t, repo.media, "media-b")
+	assert.Contains(t, repo.media, "media-c")
+	assert.Equal(t, []string{domain.MediaHardRemoved + ":media-a", domain.MediaHardRemoved + ":media-b"}, event.events)
+
+	// Removed media is gone, re-runs cascade nothing
+	report, err = u.Cascade(context.Background(), "user-1", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, report.Total)
+	assert.Equal(t, domain.CascadeDone, reports["user-1"].Status)
+	assert.Len(t, event.events, 2)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/alexandria-oss/core/exception"
 	"github.com/alexandria-oss/core/httputil"
 	"github.com/go-kit/kit/log"
+	"github.com/google/uuid"
 	"github.com/maestre3d/alexandria/media-service/internal/domain"
 )
 
@@ -15,14 +16,22 @@ type MediaSAGA struct {
 	repository domain.MediaRepository
 	eventBus   domain.MediaEvent
 	eventSAGA  domain.MediaEventSAGA
+	pending    domain.PendingTransactionRepository
+	saga       domain.SAGATransactionRepository
+	uow        domain.UnitOfWork
 	logger     log.Logger
 }
 
-func NewMediaSAGA(repo domain.MediaRepository, ev domain.MediaEvent, es domain.MediaEventSAGA, logger log.Logger) *MediaSAGA {
+func NewMediaSAGA(repo domain.MediaRepository, ev domain.MediaEvent, es domain.MediaEventSAGA,
+	pending domain.PendingTransactionRepository, saga domain.SAGATransactionRepository, uow domain.UnitOfWork,
+	logger log.Logger) *MediaSAGA {
 	return &MediaSAGA{
 		repository: repo,
 		eventBus:   ev,
 		eventSAGA:  es,
+		pending:    pending,
+		saga:       saga,
+		uow:        uow,
 		logger:     logger,
 	}
 }
@@ -39,18 +48,19 @@ func (u *MediaSAGA) VerifyAuthor(ctx context.Context, rootID string) error {
 		return err
 	}
 
-	authorPool := make([]string, 0)
-	authorPool = append(authorPool, media.AuthorID)
-	err = u.eventSAGA.VerifyAuthor(ctxR, authorPool)
+	// Every credited author is verified, the transaction fails if any of them does not exist
+	err = u.eventSAGA.VerifyAuthor(ctxR, media.AuthorPool())
 	if err != nil {
 		_ = u.logger.Log("method", "media.interactor.saga.verify_author", "err", err.Error())
+		return err
 	}
 
-	_ = u.logger.Log("method", "media.interactor.saga.verify_author", "msg", domain.AuthorVerify+" event published")
+	_ = u.logger.Log("method", "media.interactor.saga.verify_author", "msg", domain.AuthorVerify+" event stored")
 	return nil
 }
 
-func (u *MediaSAGA) UpdateStatic(ctx context.Context, rootID string, urlJSON []byte) error {
+// UpdateStatic sets the content of a media, blob holds the content metadata sent by the blob service
+func (u *MediaSAGA) UpdateStatic(ctx context.Context, rootID string, urlJSON []byte, blob domain.BlobMetadata) error {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -63,7 +73,7 @@ func (u *MediaSAGA) UpdateStatic(ctx context.Context, rootID string, urlJSON []b
 			return errE
 		}
 
-		_ = u.logger.Log("method", "media.interactor.saga.update_static", "msg", domain.BlobFailed+" integration event published")
+		_ = u.logger.Log("method", "media.interactor.saga.update_static", "msg", domain.BlobFailed+" integration event stored")
 		return err
 	}
 
@@ -77,12 +87,14 @@ func (u *MediaSAGA) UpdateStatic(ctx context.Context, rootID string, urlJSON []b
 			return errE
 		}
 
-		_ = u.logger.Log("method", "media.interactor.saga.update_static", "msg", domain.BlobFailed+" integration event published")
+		_ = u.logger.Log("method", "media.interactor.saga.update_static", "msg", domain.BlobFailed+" integration event stored")
 		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
 			"url", "[]string"))
 	}
 
 	media.ContentURL = &urlPool[0]
+	media.ContentType = blob.ContentType
+	media.ContentLength = blob.Size
 
 	err = u.repository.Replace(ctxR, *media)
 	if code := httputil.ErrorToCode(err); err != nil && code != 500 {
@@ -93,7 +105,7 @@ func (u *MediaSAGA) UpdateStatic(ctx context.Context, rootID string, urlJSON []b
 			return errE
 		}
 
-		_ = u.logger.Log("method", "media.interactor.saga.update_static", "msg", domain.BlobFailed+" integration event published")
+		_ = u.logger.Log("method", "media.interactor.saga.update_static", "msg", domain.BlobFailed+" integration event stored")
 		return err
 	}
 
@@ -117,6 +129,8 @@ func (u *MediaSAGA) RemoveStatic(ctx context.Context, rootID []byte) error {
 		return err
 	}
 	media.ContentURL = nil
+	media.ContentType = ""
+	media.ContentLength = 0
 
 	return u.repository.Replace(ctxR, *media)
 }
@@ -125,7 +139,7 @@ func (u *MediaSAGA) RemoveStatic(ctx context.Context, rootID []byte) error {
 
 // Finishing actions
 
-func (u *MediaSAGA) Done(ctx context.Context, rootID, operation string) error {
+func (u *MediaSAGA) Done(ctx context.Context, rootID, transactionID, operation string) error {
 	if operation != domain.MediaCreated && operation != domain.MediaUpdated {
 		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
 			"operation", domain.MediaCreated+" or "+domain.MediaUpdated))
@@ -134,72 +148,237 @@ func (u *MediaSAGA) Done(ctx context.Context, rootID, operation string) error {
 	ctxR, cl := context.WithCancel(ctx)
 	defer cl()
 
-	err := u.repository.ChangeState(ctxR, rootID, domain.StatusDone)
+	// Change state and propagate side-effects atomically
+	event := domain.MediaCreated
+	superseded := false
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		// Responses of replaced transactions must not change the media
+		_, ok, err := u.claim(ctxT, rootID, transactionID)
+		if err != nil {
+			return err
+		} else if !ok {
+			superseded = true
+			return nil
+		}
+
+		if err := u.repository.ChangeState(ctxT, rootID, domain.StatusDone); err != nil {
+			return err
+		}
+
+		// Get media to properly propagate side-effects with respective payload
+		// Using repo directly to avoid non-organic views
+		media, err := u.repository.FetchByID(ctxT, rootID, false)
+		if err != nil {
+			return err
+		}
+
+		if operation == domain.MediaUpdated {
+			event = domain.MediaUpdated
+			return u.eventBus.Updated(ctxT, *media)
+		}
+
+		return u.eventSAGA.Created(ctxT, *media)
+	})
 	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.saga.done", "err", err.Error())
 		return err
+	} else if superseded {
+		_ = u.logger.Log("method", "media.interactor.saga.done", "msg", "transaction already resolved or replaced",
+			"root_id", rootID, "transaction_id", transactionID)
+		return nil
 	}
 
-	// Propagate side-effects
-	errC := make(chan error)
-	defer close(errC)
+	_ = u.logger.Log("method", "media.interactor.saga.done", "msg", event+" event stored")
+	return nil
+}
 
-	go func() {
-		ctxE, cl := context.WithCancel(ctx)
-		defer cl()
+func (u *MediaSAGA) Failed(ctx context.Context, rootID, transactionID, operation, snapshot string) (err error) {
+	if operation != domain.MediaCreated && operation != domain.MediaUpdated {
+		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
+			"operation", domain.MediaCreated+" or "+domain.MediaUpdated))
+	}
 
-		// Get author to properly propagate side-effects with respective payload
-		// Using repo directly to avoid non-organic views
-		media, err := u.repository.FetchByID(ctxE, rootID, false)
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	superseded := false
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		_, ok, err := u.claim(ctxT, rootID, transactionID)
 		if err != nil {
-			errC <- err
-			return
+			return err
+		} else if !ok {
+			superseded = true
+			return nil
 		}
 
-		event := domain.MediaCreated
-		if operation == domain.MediaCreated {
-			err = u.eventSAGA.Created(ctxE, *media)
-		} else if operation == domain.MediaUpdated {
-			err = u.eventBus.Updated(ctxE, *media)
-			event = domain.MediaUpdated
+		return u.rollback(ctxT, rootID, operation, snapshot)
+	})
+	if err != nil {
+		return err
+	} else if superseded {
+		_ = u.logger.Log("method", "media.interactor.saga.failed", "msg", "transaction already resolved or replaced",
+			"root_id", rootID, "transaction_id", transactionID)
+		return nil
+	}
+
+	_ = u.logger.Log("method", "media.interactor.saga.failed", "msg", fmt.Sprintf("media %s rolled back", rootID),
+		"operation", operation)
+	return nil
+}
+
+// Timeout actions
+
+// Retry re-emits the verification request of the given expired transaction under a new transaction ID, the
+// transaction is discarded if its media no longer exists
+func (u *MediaSAGA) Retry(ctx context.Context, tx domain.PendingTransaction) error {
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
+
+	discarded, superseded := false, false
+	renewedID := uuid.New().String()
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		// Disabled media keep their transaction, it must be verified if restored
+		media, err := u.repository.FetchByID(ctxT, tx.RootID, true)
+		if errors.Is(err, exception.EntityNotFound) {
+			discarded = true
+			return u.discard(ctxT, tx)
+		} else if err != nil {
+			return err
 		}
-		if err != nil {
-			// Rollback
-			err = u.repository.ChangeState(ctxE, rootID, domain.StatusPending)
-			if err != nil {
-				// Failed to rollback
-				errC <- err
-				return
+
+		// Responses of the expired request are ignored from now on
+		err = u.pending.Attempt(ctxT, tx.RootID, tx.ID, renewedID)
+		if errors.Is(err, exception.EntityNotFound) {
+			superseded = true
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err = u.expire(ctxT, tx.RootID, "verification request re-emitted"); err != nil {
+			return err
+		}
+
+		if tx.Operation == domain.MediaUpdated {
+			mediaSnapshot := new(domain.Media)
+			if err = json.Unmarshal([]byte(tx.Snapshot), mediaSnapshot); err != nil {
+				return exception.NewErrorDescription(exception.InvalidFieldFormat,
+					fmt.Sprintf(exception.InvalidFieldFormatString, "snapshot", "media entity"))
 			}
-			_ = u.logger.Log("method", "media.interactor.saga.done", "msg", "could not send event, rolled back")
-			errC <- err
-			return
+
+			return u.eventBus.StartUpdate(ctxT, renewedID, *media, *mediaSnapshot)
 		}
 
-		_ = u.logger.Log("method", "media.interactor.saga.done", "msg", event+" event published")
-		errC <- nil
-	}()
+		return u.eventBus.StartCreate(ctxT, renewedID, *media)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.saga.retry", "err", err.Error(), "root_id", tx.RootID)
+		return err
+	}
+
+	if superseded {
+		_ = u.logger.Log("method", "media.interactor.saga.retry", "msg", "transaction already resolved or replaced",
+			"root_id", tx.RootID, "transaction_id", tx.ID)
+		return nil
+	} else if discarded {
+		_ = u.logger.Log("method", "media.interactor.saga.retry", "msg", "transaction of removed media discarded",
+			"root_id", tx.RootID)
+		return nil
+	}
+
+	_ = u.logger.Log("method", "media.interactor.saga.retry", "msg", domain.OwnerVerify+" integration event stored",
+		"root_id", tx.RootID, "attempt", tx.Attempts+1)
+	return nil
+}
+
+// TimeOut rolls back the given expired transaction the same way Failed does and propagates the timeout
+func (u *MediaSAGA) TimeOut(ctx context.Context, tx domain.PendingTransaction) error {
+	ctxR, cl := context.WithCancel(ctx)
+	defer cl()
 
-	select {
-	case err = <-errC:
+	superseded := false
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		// A verification response might have resolved the transaction since it was fetched
+		claimed, ok, err := u.claim(ctxT, tx.RootID, tx.ID)
 		if err != nil {
 			return err
+		} else if !ok {
+			superseded = true
+			return nil
+		}
+
+		if err := u.rollback(ctxT, claimed.RootID, claimed.Operation, claimed.Snapshot); err != nil {
+			return err
+		}
+		if err := u.expire(ctxT, claimed.RootID, fmt.Sprintf("verification not received after %d attempts",
+			claimed.Attempts)); err != nil {
+			return err
 		}
+
+		return u.eventSAGA.TimedOut(ctxT, *claimed)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.saga.time_out", "err", err.Error(), "root_id", tx.RootID)
+		return err
+	} else if superseded {
+		_ = u.logger.Log("method", "media.interactor.saga.time_out", "msg", "transaction already resolved or replaced",
+			"root_id", tx.RootID, "transaction_id", tx.ID)
+		return nil
 	}
 
+	_ = u.logger.Log("method", "media.interactor.saga.time_out", "msg", domain.MediaSAGATimedOut+" event stored",
+		"root_id", tx.RootID, "operation", tx.Operation)
 	return nil
 }
 
-func (u *MediaSAGA) Failed(ctx context.Context, rootID, operation, snapshot string) (err error) {
-	if operation != domain.MediaCreated && operation != domain.MediaUpdated {
-		return exception.NewErrorDescription(exception.InvalidFieldFormat, fmt.Sprintf(exception.InvalidFieldFormatString,
-			"operation", domain.MediaCreated+" or "+domain.MediaUpdated))
+// claim takes the given running transaction, ok is false if the transaction was already resolved or replaced
+// by another one, the media must be left untouched then
+func (u *MediaSAGA) claim(ctx context.Context, rootID, transactionID string) (tx *domain.PendingTransaction, ok bool,
+	err error) {
+	tx, err = u.pending.Claim(ctx, rootID, transactionID)
+	if errors.Is(err, exception.EntityNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
 	}
 
-	ctxR, cl := context.WithCancel(ctx)
-	defer cl()
+	return tx, true, nil
+}
+
+// expire sets every pending transaction of the given media as timed out
+func (u *MediaSAGA) expire(ctx context.Context, rootID, msg string) error {
+	transactions, err := u.saga.FetchByRoot(ctx, rootID)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range transactions {
+		if tx.Status != domain.TransactionPending {
+			continue
+		}
+
+		expired := domain.NewSAGATransaction(tx.ID, tx.RootID, tx.Operation, "", domain.MediaSAGATimedOut)
+		expired.Fail(domain.TransactionTimedOut, msg)
+		if err = u.saga.Save(ctx, *expired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discard removes the pending transaction of a hard removed media, nothing is left to verify or roll back
+func (u *MediaSAGA) discard(ctx context.Context, tx domain.PendingTransaction) error {
+	if _, ok, err := u.claim(ctx, tx.RootID, tx.ID); err != nil || !ok {
+		return err
+	}
+
+	return u.expire(ctx, tx.RootID, "media was removed before its verification")
+}
 
+// rollback hard removes a created media or restores an updated media from the given snapshot
+func (u *MediaSAGA) rollback(ctx context.Context, rootID, operation, snapshot string) (err error) {
 	if operation == domain.MediaCreated {
-		err = u.repository.HardRemove(ctxR, rootID)
+		err = u.repository.HardRemove(ctx, rootID)
 	} else if operation == domain.MediaUpdated {
 		mediaSnapshot := new(domain.Media)
 		err = json.Unmarshal([]byte(snapshot), mediaSnapshot)
@@ -208,7 +387,7 @@ func (u *MediaSAGA) Failed(ctx context.Context, rootID, operation, snapshot stri
 				"snapshot", "media entity"))
 		}
 
-		err = u.repository.Replace(ctxR, *mediaSnapshot)
+		err = u.repository.Replace(ctx, *mediaSnapshot)
 	}
 
 	// Avoid not found errors to send acknowledgement to broker
@@ -216,7 +395,5 @@ func (u *MediaSAGA) Failed(ctx context.Context, rootID, operation, snapshot stri
 		return err
 	}
 
-	_ = u.logger.Log("method", "media.interactor.saga.failed", "msg", fmt.Sprintf("media %s rolled back", rootID),
-		"operation", operation)
-	return err
+	return nil
 }
@@ -0,0 +1,247 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// sagaMediaRepository stores media in memory, any repository call not used by the SAGA panics
+type sagaMediaRepository struct {
+	domain.MediaRepository
+	media map[string]*domain.Media
+}
+
+func (r *sagaMediaRepository) FetchByID(_ context.Context, id string, _ bool) (*domain.Media, error) {
+	media, ok := r.media[id]
+	if !ok {
+		return nil, exception.EntityNotFound
+	}
+
+	return media, nil
+}
+
+func (r *sagaMediaRepository) ChangeState(_ context.Context, id, state string) error {
+	media, ok := r.media[id]
+	if !ok {
+		return exception.EntityNotFound
+	}
+
+	media.Status = state
+	return nil
+}
+
+func (r *sagaMediaRepository) HardRemove(_ context.Context, id string) error {
+	if _, ok := r.media[id]; !ok {
+		return exception.EntityNotFound
+	}
+
+	delete(r.media, id)
+	return nil
+}
+
+type pendingRepository struct {
+	transactions map[string]*domain.PendingTransaction
+}
+
+func (r *pendingRepository) Save(_ context.Context, tx domain.PendingTransaction) error {
+	r.transactions[tx.RootID] = &tx
+	return nil
+}
+
+func (r *pendingRepository) FetchExpired(_ context.Context, since time.Time, limit int) ([]*domain.PendingTransaction, error) {
+	transactions := make([]*domain.PendingTransaction, 0)
+	for _, tx := range r.transactions {
+		if tx.UpdateTime.Before(since) && len(transactions) < limit {
+			transactions = append(transactions, tx)
+		}
+	}
+
+	return transactions, nil
+}
+
+func (r *pendingRepository) Attempt(_ context.Context, rootID, transactionID, renewedID string) error {
+	tx, ok := r.transactions[rootID]
+	if !ok || tx.ID != transactionID {
+		return exception.EntityNotFound
+	}
+
+	tx.ID = renewedID
+	tx.Attempts++
+	tx.UpdateTime = time.Now()
+	return nil
+}
+
+func (r *pendingRepository) Claim(_ context.Context, rootID, transactionID string) (*domain.PendingTransaction, error) {
+	tx, ok := r.transactions[rootID]
+	if !ok || tx.ID != transactionID {
+		return nil, exception.EntityNotFound
+	}
+
+	delete(r.transactions, rootID)
+	return tx, nil
+}
+
+type sagaTransactionRepository struct {
+	transactions []domain.SAGATransaction
+}
+
+func (r *sagaTransactionRepository) Save(_ context.Context, tx domain.SAGATransaction) error {
+	r.transactions = append(r.transactions, tx)
+	return nil
+}
+
+func (r *sagaTransactionRepository) FetchByRoot(_ context.Context, rootID string) ([]*domain.SAGATransaction, error) {
+	transactions := make([]*domain.SAGATransaction, 0)
+	for i := range r.transactions {
+		if r.transactions[i].RootID == rootID {
+			transactions = append(transactions, &r.transactions[i])
+		}
+	}
+
+	return transactions, nil
+}
+
+// sagaEvent records emitted events by name, any event not used by the SAGA panics
+type sagaEvent struct {
+	domain.MediaEvent
+	domain.MediaEventSAGA
+	events []string
+}
+
+func (e *sagaEvent) StartCreate(_ context.Context, _ string, media domain.Media) error {
+	e.events = append(e.events, domain.OwnerVerify+":"+media.ExternalID)
+	return nil
+}
+
+func (e *sagaEvent) Created(_ context.Context, media domain.Media) error {
+	e.events = append(e.events, domain.MediaCreated+":"+media.ExternalID)
+	return nil
+}
+
+func (e *sagaEvent) TimedOut(_ context.Context, tx domain.PendingTransaction) error {
+	e.events = append(e.events, domain.MediaSAGATimedOut+":"+tx.RootID)
+	return nil
+}
+
+type sagaUnitOfWork struct{}
+
+func (sagaUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type sagaFixture struct {
+	media   *sagaMediaRepository
+	pending *pendingRepository
+	saga    *sagaTransactionRepository
+	events  *sagaEvent
+}
+
+func newSAGAFixture(rootIDs ...string) (*MediaSAGA, *sagaFixture) {
+	f := &sagaFixture{
+		media:   &sagaMediaRepository{media: map[string]*domain.Media{}},
+		pending: &pendingRepository{transactions: map[string]*domain.PendingTransaction{}},
+		saga:    new(sagaTransactionRepository),
+		events:  new(sagaEvent),
+	}
+	for _, id := range rootIDs {
+		f.media.media[id] = &domain.Media{ExternalID: id, Status: domain.StatusPending}
+		tx := domain.NewPendingTransaction(id, domain.MediaCreated, "")
+		tx.UpdateTime = time.Now().Add(-time.Hour)
+		f.pending.transactions[id] = tx
+		f.saga.transactions = append(f.saga.transactions,
+			*domain.NewSAGATransaction("tx-"+id, id, domain.MediaCreated, "", domain.OwnerVerify))
+	}
+
+	return NewMediaSAGA(f.media, f.events, f.events, f.pending, f.saga, sagaUnitOfWork{}, log.NewNopLogger()), f
+}
+
+func TestMediaSAGA_Retry(t *testing.T) {
+	saga, f := newSAGAFixture("dune")
+	tx := *f.pending.transactions["dune"]
+
+	assert.Nil(t, saga.Retry(context.Background(), tx))
+	assert.Equal(t, []string{domain.OwnerVerify + ":dune"}, f.events.events)
+	assert.Equal(t, 1, f.pending.transactions["dune"].Attempts)
+	// Re-emitted request starts a new transaction
+	assert.NotEqual(t, tx.ID, f.pending.transactions["dune"].ID)
+	assert.Equal(t, domain.TransactionTimedOut, f.saga.transactions[len(f.saga.transactions)-1].Status)
+
+	// Responses of the expired request are ignored
+	assert.Nil(t, saga.Done(context.Background(), "dune", tx.ID, domain.MediaCreated))
+	assert.Equal(t, domain.StatusPending, f.media.media["dune"].Status)
+	assert.Contains(t, f.pending.transactions, "dune")
+
+	// Concurrent lookups already retried the transaction
+	assert.Nil(t, saga.Retry(context.Background(), tx))
+	assert.Equal(t, 1, f.pending.transactions["dune"].Attempts)
+	assert.Len(t, f.events.events, 1)
+}
+
+func TestMediaSAGA_TimeOutResolved(t *testing.T) {
+	saga, f := newSAGAFixture("dune")
+	tx := *f.pending.transactions["dune"]
+
+	// Verification response claims the transaction before the watchdog
+	assert.Nil(t, saga.Done(context.Background(), "dune", tx.ID, domain.MediaCreated))
+	assert.Equal(t, domain.StatusDone, f.media.media["dune"].Status)
+	assert.Empty(t, f.pending.transactions)
+
+	// Verified media is never rolled back
+	assert.Nil(t, saga.TimeOut(context.Background(), tx))
+	assert.Contains(t, f.media.media, "dune")
+	assert.Equal(t, []string{domain.MediaCreated + ":dune"}, f.events.events)
+}
+
+func TestMediaSAGA_RetryRemoved(t *testing.T) {
+	saga, f := newSAGAFixture("dune")
+	tx := *f.pending.transactions["dune"]
+	delete(f.media.media, "dune")
+
+	// Hard removed media are never verified, transaction must not be retried forever
+	assert.Nil(t, saga.Retry(context.Background(), tx))
+	assert.Empty(t, f.events.events)
+	assert.Empty(t, f.pending.transactions)
+	last := f.saga.transactions[len(f.saga.transactions)-1]
+	assert.Equal(t, domain.TransactionTimedOut, last.Status)
+	assert.Equal(t, "media was removed before its verification", *last.FailureMessage)
+}
+
+func TestMediaSAGAWatchdog_Resolve(t *testing.T) {
+	saga, f := newSAGAFixture("dune", "emma", "removed")
+	f.pending.transactions["emma"].Attempts = 3
+	delete(f.media.media, "removed")
+	w := NewMediaSAGAWatchdog(saga, f.pending, domain.SAGAPolicy{
+		Deadline:  time.Minute,
+		Retries:   3,
+		Interval:  time.Minute,
+		BatchSize: 10,
+	}, log.NewNopLogger())
+
+	total, err := w.Resolve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, total)
+	assert.ElementsMatch(t, []string{domain.OwnerVerify + ":dune", domain.MediaSAGATimedOut + ":emma"}, f.events.events)
+	// Timed out media are rolled back, removed media transactions are discarded
+	assert.NotContains(t, f.media.media, "emma")
+	assert.Equal(t, []string{"dune"}, pendingRoots(f.pending))
+
+	// Retried transaction is not expired yet
+	total, err = w.Resolve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func pendingRoots(r *pendingRepository) []string {
+	roots := make([]string, 0, len(r.transactions))
+	for id := range r.transactions {
+		roots = append(roots, id)
+	}
+
+	return roots
+}
@@ -0,0 +1,68 @@
+package interactor
+
+import (
+	"context"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"time"
+)
+
+// MediaSAGAWatchdog resolves media stuck in STATUS_PENDING, verification requests are re-emitted
+// up to the policy retries, after that the transaction is rolled back and times out
+type MediaSAGAWatchdog struct {
+	saga    *MediaSAGA
+	pending domain.PendingTransactionRepository
+	policy  domain.SAGAPolicy
+	logger  log.Logger
+}
+
+func NewMediaSAGAWatchdog(saga *MediaSAGA, pending domain.PendingTransactionRepository, policy domain.SAGAPolicy,
+	logger log.Logger) *MediaSAGAWatchdog {
+	return &MediaSAGAWatchdog{
+		saga:    saga,
+		pending: pending,
+		policy:  policy,
+		logger:  logger,
+	}
+}
+
+// Serve resolves expired transactions periodically until the given context is done
+func (w *MediaSAGAWatchdog) Serve(ctx context.Context) error {
+	ticker := time.NewTicker(w.policy.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := w.Resolve(ctx); err != nil {
+				_ = w.logger.Log("method", "media.interactor.saga.watchdog", "err", err.Error())
+			}
+		}
+	}
+}
+
+// Resolve handles a single batch of expired transactions, returns the total of resolved transactions
+func (w *MediaSAGAWatchdog) Resolve(ctx context.Context) (int, error) {
+	transactions, err := w.pending.FetchExpired(ctx, time.Now().Add(-w.policy.Deadline), w.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, tx := range transactions {
+		if tx.Attempts < w.policy.Retries {
+			err = w.saga.Retry(ctx, *tx)
+		} else {
+			err = w.saga.TimeOut(ctx, *tx)
+		}
+		// Failed transactions are resolved on the next lookup
+		if err != nil {
+			continue
+		}
+		total++
+	}
+
+	return total, nil
+}
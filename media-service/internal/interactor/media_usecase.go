@@ -2,8 +2,11 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
 	"github.com/go-kit/kit/log"
 	"github.com/maestre3d/alexandria/media-service/internal/domain"
 	"strings"
@@ -13,18 +16,41 @@ import (
 type Media struct {
 	logger     log.Logger
 	repository domain.MediaRepository
+	series     domain.SeriesRepository
+	cursor     domain.CursorCodec
 	event      domain.MediaEvent
+	view       domain.MediaViewEvent
+	pending    domain.PendingTransactionRepository
+	saga       domain.SAGATransactionRepository
+	uow        domain.UnitOfWork
 }
 
-func NewMedia(logger log.Logger, repo domain.MediaRepository, event domain.MediaEvent) *Media {
+func NewMedia(logger log.Logger, repo domain.MediaRepository, series domain.SeriesRepository, cursor domain.CursorCodec,
+	event domain.MediaEvent, view domain.MediaViewEvent, pending domain.PendingTransactionRepository,
+	saga domain.SAGATransactionRepository, uow domain.UnitOfWork) *Media {
 	return &Media{
 		logger:     logger,
 		repository: repo,
+		series:     series,
+		cursor:     cursor,
 		event:      event,
+		view:       view,
+		pending:    pending,
+		saga:       saga,
+		uow:        uow,
 	}
 }
 
 func (u *Media) Create(ctx context.Context, ag *domain.MediaAggregate) (*domain.Media, error) {
+	identity, ok := domain.IdentityFromContext(ctx)
+	if !ok {
+		return nil, exception.NewErrorDescription(domain.ErrForbidden, "caller is not authenticated")
+	}
+	// Media is published by its caller, administrators may publish on behalf of another user
+	if !identity.Admin || ag.PublisherID == "" {
+		ag.PublisherID = identity.Subject
+	}
+
 	media, err := domain.NewMedia(ag)
 	if err != nil {
 		return nil, err
@@ -34,47 +60,29 @@ func (u *Media) Create(ctx context.Context, ag *domain.MediaAggregate) (*domain.
 		return nil, err
 	}
 
-	ctxR, _ := context.WithCancel(ctx)
-	err = u.repository.Save(ctxR, *media)
-	if err != nil {
-		return nil, err
-	}
-
-	// Start SAGA transaction
-	errC := make(chan error)
-	ctxE, cancel := context.WithCancel(ctx)
+	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
-	go func() {
-		err = u.event.StartCreate(ctxE, *media)
-		if err != nil {
-			// Event failed to be sent
-			_ = u.logger.Log("method", "media.interactor.create", "err", err.Error())
-			// Rollback
-			err = u.repository.HardRemove(ctxE, media.ExternalID)
-			if err != nil {
-				// Failed to rollback
-				_ = u.logger.Log("method", "media.interactor.create", "err", err.Error())
-				errC <- err
-				return
-			}
 
-			_ = u.logger.Log("method", "media.interactor.create", "msg", domain.OwnerVerify+" event sending failed, rolled back")
-			errC <- err
-			return
+	// Store entity and start SAGA transaction atomically, event will be relayed from the outbox
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Save(ctxT, *media); err != nil {
+			return err
 		}
 
-		_ = u.logger.Log("method", "media.interactor.create", "msg", domain.OwnerVerify+" integration event published")
-		errC <- nil
-	}()
-
-	select {
-	case err = <-errC:
-		if err != nil {
-			return nil, err
+		// Keep track of the transaction until it gets resolved, see MediaSAGAWatchdog
+		tx := domain.NewPendingTransaction(media.ExternalID, domain.MediaCreated, "")
+		if err := u.pending.Save(ctxT, *tx); err != nil {
+			return err
 		}
-		break
+
+		return u.event.StartCreate(ctxT, tx.ID, *media)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.create", "err", err.Error())
+		return nil, err
 	}
 
+	_ = u.logger.Log("method", "media.interactor.create", "msg", domain.OwnerVerify+" integration event stored")
 	return media, nil
 }
 
@@ -82,42 +90,156 @@ func (u *Media) Get(ctx context.Context, id string) (*domain.Media, error) {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	media, err := u.repository.FetchByID(ctxR, id, false)
+	media, err := u.fetchVisible(ctxR, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update total views organically
+	// Views are folded into total_views asynchronously, see MediaViewAggregator
 	if media != nil {
-		media.TotalViews++
-		err = u.repository.Replace(ctxR, *media)
-		if err != nil {
-			_ = u.logger.Log("method", "author.interactor.get", "msg", fmt.Sprintf("could not update total_views for media %s, error: %s",
-				media.ExternalID, err.Error()))
-		}
+		view := domain.NewMediaView(media.ExternalID, domain.ViewerFromContext(ctx))
+		go func() {
+			ctxE, cancelE := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancelE()
+
+			if err := u.view.Viewed(ctxE, *view); err != nil {
+				_ = u.logger.Log("method", "media.interactor.get", "msg", fmt.Sprintf("could not publish view for media %s, error: %s",
+					view.ID, err.Error()))
+			}
+		}()
 	}
 
 	return media, nil
 }
 
-func (u *Media) List(ctx context.Context, pageToken, pageSize string, filter core.FilterParams) ([]*domain.Media, string, error) {
+// Head returns an error if the given media does not exist or the caller cannot see it, views are not recorded
+func (u *Media) Head(ctx context.Context, id string) error {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	_, err := u.fetchVisible(ctxR, id)
+	return err
+}
+
+// fetchVisible returns the given media if the caller may read it, hidden media is reported as not found
+func (u *Media) fetchVisible(ctx context.Context, id string) (*domain.Media, error) {
+	media, err := u.repository.FetchByID(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, _ := domain.IdentityFromContext(ctx)
+	if !media.IsVisibleTo(identity) {
+		return nil, exception.EntityNotFound
+	}
+
+	return media, nil
+}
+
+// List returns a page of media with its next and previous page tokens, tokens are empty if there is no such page.
+// Media of a series (series filter) is listed in order along with its series position
+func (u *Media) List(ctx context.Context, pageToken, pageSize string, filter core.FilterParams) ([]*domain.Media, string, string, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Private and unlisted media is only listed for its publisher, never trust the caller's filter
+	if filter == nil {
+		filter = core.FilterParams{}
+	}
+	identity, _ := domain.IdentityFromContext(ctx)
+	filter["viewer"] = identity.Subject
+	filter["show_hidden"] = ""
+	if identity.Admin {
+		filter["show_hidden"] = "true"
+	}
+
+	var series *domain.Series
+	if filter["series"] != "" {
+		var err error
+		if series, err = u.series.FetchByID(ctxR, filter["series"]); err != nil {
+			return nil, "", "", err
+		}
+	}
+
 	params := core.NewPaginationParams(pageToken, pageSize)
-	params.Size++
-	medias, err := u.repository.Fetch(ctxR, *params, filter)
+	cursor := domain.NewCursor(filter)
+	if params.Token != "" {
+		c, err := u.cursor.Decode(params.Token)
+		if err != nil {
+			return nil, "", "", err
+		}
+		cursor = *c
+	}
+
+	// Fetch an extra row to know if there is another page
+	medias, err := u.repository.Fetch(ctxR, cursor, params.Size+1, filter)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	hasMore := len(medias) > params.Size
+	if hasMore {
+		medias = medias[0:params.Size]
+	}
+	if cursor.Backward {
+		for i, j := 0, len(medias)-1; i < j; i, j = i+1, j-1 {
+			medias[i], medias[j] = medias[j], medias[i]
+		}
+	}
+	// Series positions are required by the page cursors
+	if series != nil {
+		for _, media := range medias {
+			media.Episode = series.Episode(media.ExternalID)
+		}
+	}
+
+	// Backward pages always have a next page, forward pages have a previous one if they are not the first page
+	var next, prev *domain.Cursor
+	if len(medias) > 0 {
+		if hasMore || cursor.Backward {
+			c := domain.NewMediaCursor(cursor, medias[len(medias)-1], false)
+			next = &c
+		}
+		if (hasMore && cursor.Backward) || (!cursor.Backward && cursor.ID != "") {
+			c := domain.NewMediaCursor(cursor, medias[0], true)
+			prev = &c
+		}
+	}
+
+	nextToken, err := u.encodeCursor(next)
+	if err != nil {
+		return nil, "", "", err
+	}
+	prevToken, err := u.encodeCursor(prev)
 	if err != nil {
-		return nil, "", err
+		return nil, "", "", err
 	}
 
-	nextPage := ""
-	if len(medias) >= params.Size {
-		nextPage = medias[len(medias)-1].ExternalID
-		medias = medias[0 : len(medias)-1]
+	return medias, nextToken, prevToken, nil
+}
+
+// ListTransactions returns every SAGA transaction of the given media, newest first, only its publisher or an
+// administrator is allowed. Media is not required to exist, a rolled back creation keeps its transactions
+// and only administrators are allowed to list them
+func (u *Media) ListTransactions(ctx context.Context, id string) ([]*domain.SAGATransaction, error) {
+	if id == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField, fmt.Sprintf(exception.RequiredFieldString, "id"))
 	}
 
-	return medias, nextPage, nil
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	media, err := u.repository.FetchByID(ctxR, id, true)
+	if errors.Is(err, exception.EntityNotFound) {
+		err = domain.AuthorizeAdmin(ctx)
+	} else if err == nil {
+		err = domain.AuthorizeOwner(ctx, media.PublisherID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u.saga.FetchByRoot(ctxR, id)
 }
 
 func (u *Media) Update(ctx context.Context, ag *domain.MediaUpdateAggregate) (*domain.Media, error) {
@@ -128,6 +250,9 @@ func (u *Media) Update(ctx context.Context, ag *domain.MediaUpdateAggregate) (*d
 	if err != nil {
 		return nil, err
 	}
+	if err = domain.AuthorizeOwner(ctx, media.PublisherID); err != nil {
+		return nil, err
+	}
 	// Store backup for event rollbacks
 	mediaBackup := *media
 
@@ -155,19 +280,41 @@ func (u *Media) Update(ctx context.Context, ag *domain.MediaUpdateAggregate) (*d
 		}
 		media.PublishDate = date
 	}
-	if ag.Root.PublisherID != "" {
+	if ag.Root.PublisherID != "" && ag.Root.PublisherID != media.PublisherID {
+		// Only administrators may transfer media to another publisher
+		if err := domain.AuthorizeAdmin(ctx); err != nil {
+			return nil, err
+		}
 		media.PublisherID = ag.Root.PublisherID
 		// Must execute transaction for user validation
 		media.Status = domain.StatusPending
 	}
-	if ag.Root.AuthorID != "" {
-		media.AuthorID = ag.Root.AuthorID
+	if credits := domain.NewCredits(ag.Root.Authors); len(credits) > 0 {
+		// Credits are replaced as a whole, order included
+		media.Authors = credits
 		// Must execute transaction for author validation
 		media.Status = domain.StatusPending
 	}
 	if ag.URL != "" {
 		media.ContentURL = &ag.URL
 	}
+	if ag.Root.Visibility != "" {
+		media.Visibility = strings.ToLower(ag.Root.Visibility)
+	}
+	if ag.Root.Duration != "" {
+		duration, err := domain.ParseDuration(ag.Root.Duration)
+		if err != nil {
+			return nil, err
+		}
+		media.Duration = duration
+	}
+	if ag.Root.Explicit != "" {
+		explicit, err := domain.ParseExplicit(ag.Root.Explicit)
+		if err != nil {
+			return nil, err
+		}
+		media.Explicit = explicit
+	}
 	media.UpdateTime = time.Now()
 
 	err = media.IsValid()
@@ -175,53 +322,37 @@ func (u *Media) Update(ctx context.Context, ag *domain.MediaUpdateAggregate) (*d
 		return nil, err
 	}
 
-	// Send side-effects/transaction events
-	err = u.repository.Replace(ctxR, *media)
-	if err != nil {
-		return nil, err
-	}
-
-	errC := make(chan error)
-	ctxE, cancel := context.WithCancel(ctx)
-	defer cancel()
-	go func() {
-		event := domain.OwnerVerify
-		if media.Status == domain.StatusPending {
-			err = u.event.StartUpdate(ctxE, *media, mediaBackup)
-		} else {
-			event = domain.MediaUpdated
-			err = u.event.Updated(ctxE, *media)
+	// Store entity and side-effects/transaction events atomically
+	event := domain.OwnerVerify
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Replace(ctxT, *media); err != nil {
+			return err
 		}
 
-		if err != nil {
-			// Event failed to be sent
-			_ = u.logger.Log("method", "media.interactor.update", "err", err.Error())
-			// Rollback
-			err = u.repository.Replace(ctxE, mediaBackup)
+		if media.Status == domain.StatusPending {
+			snapshotJSON, err := json.Marshal(mediaBackup)
 			if err != nil {
-				// Failed to rollback
-				_ = u.logger.Log("method", "media.interactor.update", "err", err.Error())
-				errC <- err
-				return
+				return exception.NewErrorDescription(exception.InvalidFieldFormat,
+					fmt.Sprintf(exception.InvalidFieldFormatString, "snapshot", "media entity"))
 			}
 
-			_ = u.logger.Log("method", "media.interactor.update", "msg", event+" event sending failed, rolled back")
-			errC <- err
-			return
-		}
-
-		_ = u.logger.Log("method", "media.interactor.update", "msg", event+" integration event published")
-		errC <- nil
-	}()
+			tx := domain.NewPendingTransaction(media.ExternalID, domain.MediaUpdated, string(snapshotJSON))
+			if err := u.pending.Save(ctxT, *tx); err != nil {
+				return err
+			}
 
-	select {
-	case err = <-errC:
-		if err != nil {
-			return nil, err
+			return u.event.StartUpdate(ctxT, tx.ID, *media, mediaBackup)
 		}
-		break
+
+		event = domain.MediaUpdated
+		return u.event.Updated(ctxT, *media)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.update", "err", err.Error())
+		return nil, err
 	}
 
+	_ = u.logger.Log("method", "media.interactor.update", "msg", event+" integration event stored")
 	return media, nil
 }
 
@@ -229,46 +360,28 @@ func (u *Media) Delete(ctx context.Context, id string) error {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	err := u.repository.Remove(ctxR, id)
+	media, err := u.repository.FetchByID(ctxR, id, false)
 	if err != nil {
 		return err
 	}
+	if err = domain.AuthorizeOwner(ctx, media.PublisherID); err != nil {
+		return err
+	}
 
-	// Send side-effects/domain event
-	errC := make(chan error)
-	ctxE, cancel := context.WithCancel(ctx)
-	defer cancel()
-	go func() {
-		err = u.event.Removed(ctxE, id)
-		if err != nil {
-			// Event failed to be sent
-			_ = u.logger.Log("method", "media.interactor.delete", "err", err.Error())
-			// Rollback
-			err = u.repository.Restore(ctxE, id)
-			if err != nil {
-				// Failed to rollback
-				_ = u.logger.Log("method", "media.interactor.delete", "err", err.Error())
-				errC <- err
-				return
-			}
-
-			_ = u.logger.Log("method", "media.interactor.delete", "msg", domain.MediaRemoved+" event sending failed, rolled back")
-			errC <- err
-			return
-		}
-
-		_ = u.logger.Log("method", "media.interactor.delete", "msg", domain.MediaRemoved+" event published")
-		errC <- nil
-	}()
-
-	select {
-	case err = <-errC:
-		if err != nil {
+	// Store entity and side-effects/domain event atomically
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Remove(ctxT, id); err != nil {
 			return err
 		}
-		break
+
+		return u.event.Removed(ctxT, id)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.delete", "err", err.Error())
+		return err
 	}
 
+	_ = u.logger.Log("method", "media.interactor.delete", "msg", domain.MediaRemoved+" event stored")
 	return nil
 }
 
@@ -276,46 +389,28 @@ func (u *Media) Restore(ctx context.Context, id string) error {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	err := u.repository.Restore(ctxR, id)
+	media, err := u.repository.FetchByID(ctxR, id, true)
 	if err != nil {
 		return err
 	}
+	if err = domain.AuthorizeOwner(ctx, media.PublisherID); err != nil {
+		return err
+	}
 
-	// Send side-effects/domain event
-	errC := make(chan error)
-	ctxE, cancel := context.WithCancel(ctx)
-	defer cancel()
-	go func() {
-		err = u.event.Restored(ctxE, id)
-		if err != nil {
-			// Event failed to be sent
-			_ = u.logger.Log("method", "media.interactor.restore", "err", err.Error())
-			// Rollback
-			err = u.repository.Remove(ctxE, id)
-			if err != nil {
-				// Failed to rollback
-				_ = u.logger.Log("method", "media.interactor.restore", "err", err.Error())
-				errC <- err
-				return
-			}
-
-			_ = u.logger.Log("method", "media.interactor.restore", "msg", domain.MediaRestored+" event sending failed, rolled back")
-			errC <- err
-			return
-		}
-
-		_ = u.logger.Log("method", "media.interactor.restore", "msg", domain.MediaRestored+" event published")
-		errC <- nil
-	}()
-
-	select {
-	case err = <-errC:
-		if err != nil {
+	// Store entity and side-effects/domain event atomically
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Restore(ctxT, id); err != nil {
 			return err
 		}
-		break
+
+		return u.event.Restored(ctxT, id)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.restore", "err", err.Error())
+		return err
 	}
 
+	_ = u.logger.Log("method", "media.interactor.restore", "msg", domain.MediaRestored+" event stored")
 	return nil
 }
 
@@ -323,50 +418,28 @@ func (u *Media) HardDelete(ctx context.Context, id string) error {
 	ctxR, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	media, err := u.repository.FetchByID(ctxR, id, true)
-	if err != nil {
-		return err
-	}
+	// Store entity and side-effects/domain event atomically
+	err := u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.HardRemove(ctxT, id); err != nil {
+			return err
+		}
 
-	err = u.repository.HardRemove(ctxR, id)
+		return u.event.HardRemoved(ctxT, id)
+	})
 	if err != nil {
+		_ = u.logger.Log("method", "media.interactor.hard_delete", "err", err.Error())
 		return err
 	}
 
-	// Send side-effects/domain event
-	errC := make(chan error)
-	ctxE, cancel := context.WithCancel(ctx)
-	defer cancel()
-	go func() {
-		err = u.event.HardRemoved(ctxE, id)
-		if err != nil {
-			// Event failed to be sent
-			_ = u.logger.Log("method", "media.interactor.hard_delete", "err", err.Error())
-			// Rollback
-			err = u.repository.SaveRaw(ctxE, *media)
-			if err != nil {
-				// Failed to rollback
-				_ = u.logger.Log("method", "media.interactor.hard_delete", "err", err.Error())
-				errC <- err
-				return
-			}
-
-			_ = u.logger.Log("method", "media.interactor.hard_delete", "msg", domain.MediaHardRemoved+" event sending failed, rolled back")
-			errC <- err
-			return
-		}
-
-		_ = u.logger.Log("method", "media.interactor.hard_delete", "msg", domain.MediaHardRemoved+" event published")
-		errC <- nil
-	}()
+	_ = u.logger.Log("method", "media.interactor.hard_delete", "msg", domain.MediaHardRemoved+" event stored")
+	return nil
+}
 
-	select {
-	case err = <-errC:
-		if err != nil {
-			return err
-		}
-		break
+// encodeCursor returns the page token of the given cursor, empty if nil
+func (u *Media) encodeCursor(cursor *domain.Cursor) (string, error) {
+	if cursor == nil {
+		return "", nil
 	}
 
-	return nil
+	return u.cursor.Encode(*cursor)
 }
@@ -0,0 +1,44 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMedia_ListTransactions(t *testing.T) {
+	_, f := newSAGAFixture("dune", "rolled-back")
+	f.media.media["dune"].PublisherID = "frank"
+	delete(f.media.media, "rolled-back")
+	u := NewMedia(log.NewNopLogger(), f.media, nil, nil, nil, nil, f.pending, f.saga, sagaUnitOfWork{})
+
+	publisher := domain.WithIdentity(context.Background(), domain.Identity{Subject: "frank"})
+	transactions, err := u.ListTransactions(publisher, "dune")
+	assert.Nil(t, err)
+	assert.Len(t, transactions, 1)
+
+	admin := domain.WithIdentity(context.Background(), domain.Identity{Subject: "root", Admin: true})
+	transactions, err = u.ListTransactions(admin, "dune")
+	assert.Nil(t, err)
+	assert.Len(t, transactions, 1)
+
+	// Transactions might leak the media snapshot
+	stranger := domain.WithIdentity(context.Background(), domain.Identity{Subject: "brian"})
+	transactions, err = u.ListTransactions(stranger, "dune")
+	assert.True(t, errors.Is(err, domain.ErrForbidden))
+	assert.Nil(t, transactions)
+
+	_, err = u.ListTransactions(context.Background(), "dune")
+	assert.True(t, errors.Is(err, domain.ErrForbidden))
+
+	// Rolled back media have no publisher, only administrators are allowed
+	_, err = u.ListTransactions(publisher, "rolled-back")
+	assert.True(t, errors.Is(err, domain.ErrForbidden))
+	transactions, err = u.ListTransactions(admin, "rolled-back")
+	assert.Nil(t, err)
+	assert.Len(t, transactions, 1)
+}
@@ -0,0 +1,87 @@
+package interactor
+
+import (
+	"context"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"sync"
+	"time"
+)
+
+// MediaViewAggregator folds MEDIA_VIEWED events into total_views, views are stored in batches
+// using atomic increments instead of a write per read
+type MediaViewAggregator struct {
+	repository domain.MediaRepository
+	policy     domain.ViewPolicy
+	logger     log.Logger
+	batch      *viewBatch
+	mu         *sync.Mutex
+}
+
+// viewBatch views waiting to be stored, done gets closed once the batch was stored
+type viewBatch struct {
+	views map[string]int64
+	size  int
+	done  chan struct{}
+	err   error
+	once  *sync.Once
+}
+
+func NewMediaViewAggregator(repo domain.MediaRepository, policy domain.ViewPolicy, logger log.Logger) *MediaViewAggregator {
+	return &MediaViewAggregator{
+		repository: repo,
+		policy:     policy,
+		logger:     logger,
+		mu:         new(sync.Mutex),
+	}
+}
+
+// Fold adds the given view into the current batch, blocks until the batch gets stored so the
+// event is acknowledged only after its view was counted
+func (a *MediaViewAggregator) Fold(ctx context.Context, view domain.MediaView) error {
+	a.mu.Lock()
+	if a.batch == nil {
+		b := &viewBatch{
+			views: make(map[string]int64),
+			done:  make(chan struct{}),
+			once:  new(sync.Once),
+		}
+		a.batch = b
+		time.AfterFunc(a.policy.Interval, func() {
+			a.flush(b)
+		})
+	}
+	b := a.batch
+	b.views[view.ID]++
+	b.size++
+	full := b.size >= a.policy.BatchSize
+	a.mu.Unlock()
+
+	if full {
+		a.flush(b)
+	}
+
+	<-b.done
+	return b.err
+}
+
+// flush stores the given batch once, new views are folded into a new batch
+func (a *MediaViewAggregator) flush(b *viewBatch) {
+	b.once.Do(func() {
+		a.mu.Lock()
+		if a.batch == b {
+			a.batch = nil
+		}
+		a.mu.Unlock()
+
+		// Detached from any request, the batch holds views of many events
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		b.err = a.repository.IncrementViews(ctx, b.views)
+		if b.err != nil {
+			_ = a.logger.Log("method", "media.interactor.view.fold", "err", b.err.Error())
+		}
+		close(b.done)
+	})
+}
@@ -0,0 +1,66 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+// viewRepository records stored view batches, any other repository call panics
+type viewRepository struct {
+	domain.MediaRepository
+	mu      sync.Mutex
+	batches []map[string]int64
+	err     error
+}
+
+func (r *viewRepository) IncrementViews(_ context.Context, views map[string]int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, views)
+	return r.err
+}
+
+func foldAll(a *MediaViewAggregator, ids ...string) []error {
+	errs := make([]error, len(ids))
+	wg := new(sync.WaitGroup)
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			errs[i] = a.Fold(context.Background(), *domain.NewMediaView(id, domain.Viewer{}))
+		}(i, id)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestMediaViewAggregator_Fold(t *testing.T) {
+	repo := new(viewRepository)
+	a := NewMediaViewAggregator(repo, domain.ViewPolicy{Interval: 50 * time.Millisecond, BatchSize: 100},
+		log.NewNopLogger())
+
+	// Batch stored after the interval
+	for _, err := range foldAll(a, "media-a", "media-b", "media-a") {
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, []map[string]int64{{"media-a": 2, "media-b": 1}}, repo.batches)
+
+	// Full batch stored right away
+	a.policy = domain.ViewPolicy{Interval: time.Hour, BatchSize: 2}
+	for _, err := range foldAll(a, "media-c", "media-c") {
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, map[string]int64{"media-c": 2}, repo.batches[1])
+
+	// Every view of a failed batch gets the error so its event can be retried
+	repo.err = errors.New("database not available")
+	for _, err := range foldAll(a, "media-d", "media-e") {
+		assert.Equal(t, repo.err, err)
+	}
+}
@@ -0,0 +1,108 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+)
+
+// podcastFeedSize episodes listed by a feed, podcast apps only sync the latest episodes
+const podcastFeedSize = 100
+
+type Podcast struct {
+	logger     log.Logger
+	repository domain.MediaRepository
+	series     domain.SeriesRepository
+	feeds      domain.PodcastFeedRepository
+}
+
+func NewPodcast(logger log.Logger, repo domain.MediaRepository, series domain.SeriesRepository,
+	feeds domain.PodcastFeedRepository) *Podcast {
+	return &Podcast{
+		logger:     logger,
+		repository: repo,
+		series:     series,
+		feeds:      feeds,
+	}
+}
+
+// Get returns the podcast feed of an author or a series, feeds are built from public episodes only
+func (u *Podcast) Get(ctx context.Context, kind, id string) (*domain.PodcastFeed, error) {
+	kind, err := domain.ParsePodcastKind(kind)
+	if err != nil {
+		return nil, err
+	} else if id == "" {
+		return nil, exception.NewErrorDescription(exception.RequiredField, fmt.Sprintf(exception.RequiredFieldString, "id"))
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The feed cache is optional, feeds are built again if it is not available
+	if feed, err := u.feeds.FetchByID(ctxR, kind, id); err == nil {
+		return feed, nil
+	}
+
+	// Viewer is never set, private and unlisted episodes are not listed
+	filter := core.FilterParams{"media_type": domain.Podcast}
+	var series *domain.Series
+	switch kind {
+	case domain.PodcastByAuthor:
+		filter["author"] = id
+		filter["filter_by"] = "create_time"
+		filter["sort"] = "desc"
+	case domain.PodcastBySeries:
+		if series, err = u.series.FetchByID(ctxR, id); err != nil {
+			return nil, err
+		}
+		filter["series"] = id
+	}
+
+	episodes, err := u.repository.Fetch(ctxR, domain.NewCursor(filter), podcastFeedSize, filter)
+	// Series exist without episodes, authors without episodes have no feed
+	if err != nil && (series == nil || !errors.Is(err, exception.EntitiesNotFound)) {
+		return nil, err
+	}
+	if series != nil {
+		for _, episode := range episodes {
+			episode.Episode = series.Episode(episode.ExternalID)
+		}
+	}
+
+	feed := domain.NewPodcastFeed(kind, id, episodes, series)
+	if err = u.feeds.Save(ctxR, *feed); err != nil {
+		_ = u.logger.Log("method", "media.interactor.podcast.get", "err", err.Error())
+	}
+
+	return feed, nil
+}
+
+// Invalidate removes the feeds of every author credited by the given media and every series grouping it
+func (u *Podcast) Invalidate(ctx context.Context, mediaID string) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	media, err := u.repository.FetchByID(ctxR, mediaID, true)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0)
+	for _, author := range media.AuthorPool() {
+		keys = append(keys, domain.PodcastFeedKey(domain.PodcastByAuthor, author))
+	}
+
+	series, _, err := u.series.Fetch(ctxR, *core.NewPaginationParams("", "100"), core.FilterParams{"media": mediaID})
+	if err != nil && !errors.Is(err, exception.EntitiesNotFound) {
+		return err
+	}
+	for _, s := range series {
+		keys = append(keys, domain.PodcastFeedKey(domain.PodcastBySeries, s.ExternalID))
+	}
+
+	return u.feeds.Remove(ctxR, keys...)
+}
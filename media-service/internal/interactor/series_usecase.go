@@ -0,0 +1,214 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"time"
+)
+
+type Series struct {
+	logger     log.Logger
+	repository domain.SeriesRepository
+	media      domain.MediaRepository
+	event      domain.SeriesEvent
+	feeds      domain.PodcastFeedRepository
+	uow        domain.UnitOfWork
+}
+
+func NewSeries(logger log.Logger, repo domain.SeriesRepository, media domain.MediaRepository, event domain.SeriesEvent,
+	feeds domain.PodcastFeedRepository, uow domain.UnitOfWork) *Series {
+	return &Series{
+		logger:     logger,
+		repository: repo,
+		media:      media,
+		event:      event,
+		feeds:      feeds,
+		uow:        uow,
+	}
+}
+
+func (u *Series) Create(ctx context.Context, ag *domain.SeriesAggregate) (*domain.Series, error) {
+	identity, ok := domain.IdentityFromContext(ctx)
+	if !ok {
+		return nil, exception.NewErrorDescription(domain.ErrForbidden, "caller is not authenticated")
+	}
+	// Series are owned by its caller, administrators may create series on behalf of another user
+	if !identity.Admin || ag.OwnerID == "" {
+		ag.OwnerID = identity.Subject
+	}
+
+	series, err := domain.NewSeries(ag)
+	if err != nil {
+		return nil, err
+	}
+	err = series.IsValid()
+	if err != nil {
+		return nil, err
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err = u.verifyItems(ctxR, series.Items, domain.Series{}); err != nil {
+		return nil, err
+	}
+
+	// Store entity and domain event atomically
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Save(ctxT, *series); err != nil {
+			return err
+		}
+
+		return u.event.Created(ctxT, *series)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "series.interactor.create", "err", err.Error())
+		return nil, err
+	}
+
+	_ = u.logger.Log("method", "series.interactor.create", "msg", domain.SeriesCreated+" event stored")
+	return series, nil
+}
+
+// List returns a page of series with its next page token, empty if there is no such page
+func (u *Series) List(ctx context.Context, pageToken, pageSize string, filter core.FilterParams) ([]*domain.Series, string, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return u.repository.Fetch(ctxR, *core.NewPaginationParams(pageToken, pageSize), filter)
+}
+
+func (u *Series) Get(ctx context.Context, id string) (*domain.Series, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return u.repository.FetchByID(ctxR, id)
+}
+
+func (u *Series) Update(ctx context.Context, ag *domain.SeriesUpdateAggregate) (*domain.Series, error) {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	series, err := u.repository.FetchByID(ctxR, ag.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = domain.AuthorizeOwner(ctx, series.OwnerID); err != nil {
+		return nil, err
+	}
+	// Items already grouped are not verified again
+	snapshot := *series
+
+	if ag.Root.Title != "" {
+		series.Title = ag.Root.Title
+	}
+	if ag.Root.Description != "" {
+		series.Description = ag.Root.Description
+	}
+	if ag.Root.CoverURL != "" {
+		series.CoverURL = &ag.Root.CoverURL
+	}
+	if ag.Root.OwnerID != "" && ag.Root.OwnerID != series.OwnerID {
+		// Only administrators may transfer series to another user
+		if err := domain.AuthorizeAdmin(ctx); err != nil {
+			return nil, err
+		}
+		series.OwnerID = ag.Root.OwnerID
+	}
+	if ag.Root.Items != nil {
+		// Items are replaced as a whole, an empty list removes every item
+		series.Items = domain.NewSeriesItems(ag.Root.Items)
+	}
+	series.UpdateTime = time.Now()
+
+	err = series.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	if err = u.verifyItems(ctxR, series.Items, snapshot); err != nil {
+		return nil, err
+	}
+
+	// Store entity and domain event atomically
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Replace(ctxT, *series); err != nil {
+			return err
+		}
+
+		return u.event.Updated(ctxT, *series)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "series.interactor.update", "err", err.Error())
+		return nil, err
+	}
+
+	_ = u.logger.Log("method", "series.interactor.update", "msg", domain.SeriesUpdated+" event stored")
+	u.invalidateFeed(ctxR, series.ExternalID)
+	return series, nil
+}
+
+func (u *Series) Delete(ctx context.Context, id string) error {
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	series, err := u.repository.FetchByID(ctxR, id)
+	if err != nil {
+		return err
+	}
+	if err = domain.AuthorizeOwner(ctx, series.OwnerID); err != nil {
+		return err
+	}
+
+	// Store entity and domain event atomically
+	err = u.uow.Do(ctxR, func(ctxT context.Context) error {
+		if err := u.repository.Remove(ctxT, id); err != nil {
+			return err
+		}
+
+		return u.event.Removed(ctxT, id)
+	})
+	if err != nil {
+		_ = u.logger.Log("method", "series.interactor.delete", "err", err.Error())
+		return err
+	}
+
+	_ = u.logger.Log("method", "series.interactor.delete", "msg", domain.SeriesRemoved+" event stored")
+	u.invalidateFeed(ctxR, id)
+	return nil
+}
+
+// invalidateFeed removes the podcast feed of a series, feeds expire anyway so failures are only logged
+func (u *Series) invalidateFeed(ctx context.Context, id string) {
+	if err := u.feeds.Remove(ctx, domain.PodcastFeedKey(domain.PodcastBySeries, id)); err != nil {
+		_ = u.logger.Log("method", "series.interactor.invalidate_feed", "err", err.Error())
+	}
+}
+
+// verifyItems returns an error if any item not grouped by the given series does not exist or
+// the caller did not publish it, administrators may group any media
+func (u *Series) verifyItems(ctx context.Context, items []*domain.SeriesItem, series domain.Series) error {
+	for _, item := range items {
+		if series.Contains(item.MediaID) {
+			continue
+		}
+
+		media, err := u.media.FetchByID(ctx, item.MediaID, false)
+		if err != nil {
+			if errors.Is(err, exception.EntityNotFound) {
+				return exception.NewErrorDescription(exception.EntityNotFound, fmt.Sprintf("media %s not found", item.MediaID))
+			}
+
+			return err
+		}
+		if err = domain.AuthorizeOwner(ctx, media.PublisherID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
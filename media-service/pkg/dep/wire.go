@@ -11,16 +11,23 @@ import (
 	"github.com/alexandria-oss/core/transport"
 	"github.com/alexandria-oss/core/transport/proxy"
 	"github.com/go-kit/kit/log"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/media-service/internal/dependency"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/media-service/internal/interactor"
 	"github.com/maestre3d/alexandria/media-service/pkg/media"
 	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
 	"github.com/maestre3d/alexandria/media-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	"github.com/openzipkin/zipkin-go"
 	"github.com/openzipkin/zipkin-go/model"
 	"github.com/openzipkin/zipkin-go/reporter"
 	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 var Ctx = context.Background()
@@ -29,6 +36,9 @@ var interactorSet = wire.NewSet(
 	provideContext,
 	logger.NewZapLogger,
 	provideMediaInteractor,
+	provideMediaCascadeInteractor,
+	provideSeriesInteractor,
+	providePodcastInteractor,
 )
 
 var zipkinSet = wire.NewSet(
@@ -42,20 +52,30 @@ var httpProxySet = wire.NewSet(
 	config.NewKernel,
 	zipkinSet,
 	tracer.WrapZipkinOpenTracing,
+	auth.NewAuthenticator,
 	bind.NewMediaHTTP,
+	bind.NewMediaCascadeHTTP,
+	bind.NewSeriesHTTP,
+	bind.NewOPDSHTTP,
+	bind.NewPodcastHTTP,
 	provideHTTPHandlers,
 	proxy.NewHTTP,
 )
 
 var rpcProxySet = wire.NewSet(
 	bind.NewMediaRPC,
+	bind.NewSeriesRPC,
 	bind.NewHealthRPC,
 	provideRPCServers,
-	proxy.NewRPC,
+	provideRPCPolicy,
+	provideRPCProxy,
 )
 
 var eventProxySet = wire.NewSet(
+	provideMessageStore,
+	provideSAGATransactionRepository,
 	provideMediaSAGAInteractor,
+	provideMediaViewAggregator,
 	bind.NewMediaEventConsumer,
 	provideEventConsumers,
 	proxy.NewEvent,
@@ -83,20 +103,107 @@ func provideMediaSAGAInteractor(ctx context.Context, logger log.Logger) (usecase
 	return mediaService, cleanup, err
 }
 
+func provideMediaCascadeInteractor(ctx context.Context, logger log.Logger) (usecase.MediaCascadeInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	cascadeInteractor, cleanup, err := dependency.InjectMediaCascadeUseCase()
+	return media.WrapMediaCascadeInstrumentation(cascadeInteractor, logger), cleanup, err
+}
+
+func provideSeriesInteractor(ctx context.Context, logger log.Logger) (usecase.SeriesInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	seriesInteractor, cleanup, err := dependency.InjectSeriesUseCase()
+	return media.WrapSeriesInstrumentation(seriesInteractor, logger), cleanup, err
+}
+
+func providePodcastInteractor(ctx context.Context, logger log.Logger) (usecase.PodcastInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	podcastInteractor, cleanup, err := dependency.InjectPodcastUseCase()
+	return media.WrapPodcastInstrumentation(podcastInteractor, logger), cleanup, err
+}
+
+func provideMediaViewAggregator(ctx context.Context) (usecase.MediaViewAggregator, func(), error) {
+	dependency.Ctx = ctx
+
+	aggregator, cleanup, err := dependency.InjectMediaViewAggregator()
+	return media.WrapMediaViewInstrumentation(aggregator), cleanup, err
+}
+
+func provideOutboxRelay(ctx context.Context) (*infrastructure.MediaOutboxRelay, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMediaOutboxRelay()
+}
+
+func provideSAGAWatchdog(ctx context.Context) (*interactor.MediaSAGAWatchdog, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMediaSAGAWatchdog()
+}
+
 // Bind/Map used http handlers
-func provideHTTPHandlers(mediaHandler *bind.MediaHandler) []proxy.Handler {
+func provideHTTPHandlers(mediaHandler *bind.MediaHandler, cascadeHandler *bind.MediaCascadeHandler,
+	seriesHandler *bind.SeriesHandler, opdsHandler *bind.OPDSHandler, podcastHandler *bind.PodcastHandler,
+	authenticator *auth.Authenticator) []proxy.Handler {
 	handlers := make([]proxy.Handler, 0)
-	handlers = append(handlers, mediaHandler)
+	handlers = append(handlers, mediaHandler, cascadeHandler, seriesHandler, opdsHandler, podcastHandler, authenticator)
 	return handlers
 }
 
 // Bind/Map used rpc servers
-func provideRPCServers(mediaServer *bind.MediaRPCServer, healthServer *bind.HealthRPCServer) []proxy.RPCServer {
+func provideRPCServers(mediaServer *bind.MediaRPCServer, seriesServer *bind.SeriesRPCServer,
+	healthServer *bind.HealthRPCServer) []proxy.RPCServer {
 	servers := make([]proxy.RPCServer, 0)
-	servers = append(servers, mediaServer, healthServer)
+	servers = append(servers, mediaServer, seriesServer, healthServer)
 	return servers
 }
 
+// Bind/Map access level of every rpc method
+func provideRPCPolicy() auth.Policy {
+	policy := auth.Policy{}
+	for _, p := range []auth.Policy{bind.MediaRPCPolicy, bind.SeriesRPCPolicy, bind.HealthRPCPolicy} {
+		for method, access := range p {
+			policy[method] = access
+		}
+	}
+	return policy
+}
+
+// provideRPCProxy replaces proxy.NewRPC to guard rpc methods, grpc-go v1.27 accepts a single
+// unary interceptor so callers are authenticated before go-kit's interceptor runs
+func provideRPCProxy(servers []proxy.RPCServer, authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	authorize := authenticator.UnaryServerInterceptor(policy)
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return authorize(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return kitgrpc.Interceptor(ctx, req, info, handler)
+		})
+	}))
+	for _, srv := range servers {
+		srv.SetRoutes(rpcServer)
+	}
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+func provideMessageStore(ctx context.Context) (messaging.Store, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMessageStore()
+}
+
+func provideSAGATransactionRepository(ctx context.Context) (domain.SAGATransactionRepository, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectSAGATransactionRepository()
+}
+
 // Bind/Map used event consumers
 func provideEventConsumers(mediaConsumer *bind.MediaEventConsumer) []proxy.Consumer {
 	consumers := make([]proxy.Consumer, 0)
@@ -158,3 +265,15 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 
 	return &transport.Transport{}, nil, nil
 }
+
+func InjectOutboxRelay() (*infrastructure.MediaOutboxRelay, func(), error) {
+	wire.Build(provideContext, provideOutboxRelay)
+
+	return &infrastructure.MediaOutboxRelay{}, nil, nil
+}
+
+func InjectSAGAWatchdog() (*interactor.MediaSAGAWatchdog, func(), error) {
+	wire.Build(provideContext, provideSAGAWatchdog)
+
+	return &interactor.MediaSAGAWatchdog{}, nil, nil
+}
@@ -14,16 +14,23 @@ import (
 	"github.com/alexandria-oss/core/transport"
 	"github.com/alexandria-oss/core/transport/proxy"
 	"github.com/go-kit/kit/log"
+	grpc2 "github.com/go-kit/kit/transport/grpc"
 	"github.com/google/wire"
 	"github.com/maestre3d/alexandria/media-service/internal/dependency"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/media-service/internal/interactor"
 	"github.com/maestre3d/alexandria/media-service/pkg/media"
 	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
 	"github.com/maestre3d/alexandria/media-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/shared/auth"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	"github.com/openzipkin/zipkin-go"
 	"github.com/openzipkin/zipkin-go/model"
 	"github.com/openzipkin/zipkin-go/reporter"
 	"github.com/openzipkin/zipkin-go/reporter/http"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
 )
 
 // Injectors from wire.go:
@@ -45,24 +52,108 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	zipkinTracer, cleanup2 := provideZipkinTracer(reporter, endpoint)
 	opentracingTracer := tracer.WrapZipkinOpenTracing(kernel, zipkinTracer)
 	mediaRPCServer := bind.NewMediaRPC(mediaInteractor, logLogger, opentracingTracer, zipkinTracer)
+	seriesInteractor, cleanup3, err := provideSeriesInteractor(context, logLogger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	seriesRPCServer := bind.NewSeriesRPC(seriesInteractor, logLogger, opentracingTracer, zipkinTracer)
 	healthRPCServer := bind.NewHealthRPC()
-	v := provideRPCServers(mediaRPCServer, healthRPCServer)
-	server, cleanup3 := proxy.NewRPC(v)
+	v := provideRPCServers(mediaRPCServer, seriesRPCServer, healthRPCServer)
+	authenticator, err := auth.NewAuthenticator(logLogger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policy := provideRPCPolicy()
+	server, cleanup4 := provideRPCProxy(v, authenticator, policy)
 	mediaHandler := bind.NewMediaHTTP(mediaInteractor, logLogger, opentracingTracer, zipkinTracer)
-	v2 := provideHTTPHandlers(mediaHandler)
-	http, cleanup4 := proxy.NewHTTP(kernel, v2...)
-	mediaSAGAInteractor, cleanup5, err := provideMediaSAGAInteractor(context, logLogger)
+	mediaCascadeInteractor, cleanup5, err := provideMediaCascadeInteractor(context, logLogger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaCascadeHandler := bind.NewMediaCascadeHTTP(mediaCascadeInteractor, logLogger, opentracingTracer, zipkinTracer)
+	seriesHandler := bind.NewSeriesHTTP(seriesInteractor, logLogger, opentracingTracer, zipkinTracer)
+	opdsHandler := bind.NewOPDSHTTP(mediaInteractor, logLogger, opentracingTracer, zipkinTracer)
+	podcastInteractor, cleanup6, err := providePodcastInteractor(context, logLogger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	podcastHandler := bind.NewPodcastHTTP(podcastInteractor, logLogger, opentracingTracer, zipkinTracer)
+	v2 := provideHTTPHandlers(mediaHandler, mediaCascadeHandler, seriesHandler, opdsHandler, podcastHandler, authenticator)
+	http, cleanup7 := proxy.NewHTTP(kernel, v2...)
+	mediaSAGAInteractor, cleanup8, err := provideMediaSAGAInteractor(context, logLogger)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaViewAggregator, cleanup9, err := provideMediaViewAggregator(context)
 	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	mediaEventConsumer := bind.NewMediaEventConsumer(mediaSAGAInteractor, logLogger, kernel)
+	store, cleanup10, err := provideMessageStore(context)
+	if err != nil {
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sagaTransactionRepository, cleanup11, err := provideSAGATransactionRepository(context)
+	if err != nil {
+		cleanup10()
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mediaEventConsumer := bind.NewMediaEventConsumer(mediaSAGAInteractor, mediaViewAggregator, mediaCascadeInteractor, podcastInteractor, logLogger, kernel, store, sagaTransactionRepository)
 	v3 := provideEventConsumers(mediaEventConsumer)
-	event, cleanup6, err := proxy.NewEvent(context, kernel, v3...)
+	event, cleanup12, err := proxy.NewEvent(context, kernel, v3...)
 	if err != nil {
+		cleanup11()
+		cleanup10()
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
 		cleanup5()
 		cleanup4()
 		cleanup3()
@@ -72,6 +163,12 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	}
 	transportTransport := transport.NewTransport(server, http, event, kernel)
 	return transportTransport, func() {
+		cleanup12()
+		cleanup11()
+		cleanup10()
+		cleanup9()
+		cleanup8()
+		cleanup7()
 		cleanup6()
 		cleanup5()
 		cleanup4()
@@ -81,12 +178,37 @@ func InjectTransportService() (*transport.Transport, func(), error) {
 	}, nil
 }
 
+func InjectOutboxRelay() (*infrastructure.MediaOutboxRelay, func(), error) {
+	context := provideContext()
+	mediaOutboxRelay, cleanup, err := provideOutboxRelay(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mediaOutboxRelay, func() {
+		cleanup()
+	}, nil
+}
+
+func InjectSAGAWatchdog() (*interactor.MediaSAGAWatchdog, func(), error) {
+	context := provideContext()
+	mediaSAGAWatchdog, cleanup, err := provideSAGAWatchdog(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mediaSAGAWatchdog, func() {
+		cleanup()
+	}, nil
+}
+
 // wire.go:
 
 var Ctx = context.Background()
 
 var interactorSet = wire.NewSet(
 	provideContext, logger.NewZapLogger, provideMediaInteractor,
+	provideMediaCascadeInteractor,
+	provideSeriesInteractor,
+	providePodcastInteractor,
 )
 
 var zipkinSet = wire.NewSet(
@@ -96,13 +218,19 @@ var zipkinSet = wire.NewSet(
 )
 
 var httpProxySet = wire.NewSet(
-	interactorSet, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, bind.NewMediaHTTP, provideHTTPHandlers, proxy.NewHTTP,
+	interactorSet, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, auth.NewAuthenticator, bind.NewMediaHTTP, bind.NewMediaCascadeHTTP, bind.NewSeriesHTTP, bind.NewOPDSHTTP, bind.NewPodcastHTTP, provideHTTPHandlers, proxy.NewHTTP,
 )
 
-var rpcProxySet = wire.NewSet(bind.NewMediaRPC, bind.NewHealthRPC, provideRPCServers, proxy.NewRPC)
+var rpcProxySet = wire.NewSet(bind.NewMediaRPC, bind.NewSeriesRPC, bind.NewHealthRPC, provideRPCServers,
+	provideRPCPolicy,
+	provideRPCProxy,
+)
 
 var eventProxySet = wire.NewSet(
-	provideMediaSAGAInteractor, bind.NewMediaEventConsumer, provideEventConsumers, proxy.NewEvent,
+	provideMessageStore,
+	provideSAGATransactionRepository,
+	provideMediaSAGAInteractor,
+	provideMediaViewAggregator, bind.NewMediaEventConsumer, provideEventConsumers, proxy.NewEvent,
 )
 
 func provideContext() context.Context {
@@ -127,20 +255,107 @@ func provideMediaSAGAInteractor(ctx context.Context, logger2 log.Logger) (usecas
 	return mediaService, cleanup, err
 }
 
+func provideMediaCascadeInteractor(ctx context.Context, logger2 log.Logger) (usecase.MediaCascadeInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	cascadeInteractor, cleanup, err := dependency.InjectMediaCascadeUseCase()
+	return media.WrapMediaCascadeInstrumentation(cascadeInteractor, logger2), cleanup, err
+}
+
+func provideSeriesInteractor(ctx context.Context, logger2 log.Logger) (usecase.SeriesInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	seriesInteractor, cleanup, err := dependency.InjectSeriesUseCase()
+	return media.WrapSeriesInstrumentation(seriesInteractor, logger2), cleanup, err
+}
+
+func providePodcastInteractor(ctx context.Context, logger2 log.Logger) (usecase.PodcastInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	podcastInteractor, cleanup, err := dependency.InjectPodcastUseCase()
+	return media.WrapPodcastInstrumentation(podcastInteractor, logger2), cleanup, err
+}
+
+func provideMediaViewAggregator(ctx context.Context) (usecase.MediaViewAggregator, func(), error) {
+	dependency.Ctx = ctx
+
+	aggregator, cleanup, err := dependency.InjectMediaViewAggregator()
+	return media.WrapMediaViewInstrumentation(aggregator), cleanup, err
+}
+
+func provideOutboxRelay(ctx context.Context) (*infrastructure.MediaOutboxRelay, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMediaOutboxRelay()
+}
+
+func provideSAGAWatchdog(ctx context.Context) (*interactor.MediaSAGAWatchdog, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMediaSAGAWatchdog()
+}
+
 // Bind/Map used http handlers
-func provideHTTPHandlers(mediaHandler *bind.MediaHandler) []proxy.Handler {
+func provideHTTPHandlers(mediaHandler *bind.MediaHandler, cascadeHandler *bind.MediaCascadeHandler,
+	seriesHandler *bind.SeriesHandler, opdsHandler *bind.OPDSHandler, podcastHandler *bind.PodcastHandler,
+	authenticator *auth.Authenticator) []proxy.Handler {
 	handlers := make([]proxy.Handler, 0)
-	handlers = append(handlers, mediaHandler)
+	handlers = append(handlers, mediaHandler, cascadeHandler, seriesHandler, opdsHandler, podcastHandler, authenticator)
 	return handlers
 }
 
 // Bind/Map used rpc servers
-func provideRPCServers(mediaServer *bind.MediaRPCServer, healthServer *bind.HealthRPCServer) []proxy.RPCServer {
+func provideRPCServers(mediaServer *bind.MediaRPCServer, seriesServer *bind.SeriesRPCServer,
+	healthServer *bind.HealthRPCServer) []proxy.RPCServer {
 	servers := make([]proxy.RPCServer, 0)
-	servers = append(servers, mediaServer, healthServer)
+	servers = append(servers, mediaServer, seriesServer, healthServer)
 	return servers
 }
 
+// Bind/Map access level of every rpc method
+func provideRPCPolicy() auth.Policy {
+	policy := auth.Policy{}
+	for _, p := range []auth.Policy{bind.MediaRPCPolicy, bind.SeriesRPCPolicy, bind.HealthRPCPolicy} {
+		for method, access := range p {
+			policy[method] = access
+		}
+	}
+	return policy
+}
+
+// provideRPCProxy replaces proxy.NewRPC to guard rpc methods, grpc-go v1.27 accepts a single
+// unary interceptor so callers are authenticated before go-kit's interceptor runs
+func provideRPCProxy(servers []proxy.RPCServer, authenticator *auth.Authenticator, policy auth.Policy) (*grpc.Server, func()) {
+	authorize := authenticator.UnaryServerInterceptor(policy)
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return authorize(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return grpc2.Interceptor(ctx, req, info, handler)
+		})
+	}))
+	for _, srv := range servers {
+		srv.SetRoutes(rpcServer)
+	}
+
+	cleanup := func() {
+		rpcServer.Stop()
+	}
+
+	return rpcServer, cleanup
+}
+
+func provideMessageStore(ctx context.Context) (messaging.Store, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMessageStore()
+}
+
+func provideSAGATransactionRepository(ctx context.Context) (domain.SAGATransactionRepository, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectSAGATransactionRepository()
+}
+
 // Bind/Map used event consumers
 func provideEventConsumers(mediaConsumer *bind.MediaEventConsumer) []proxy.Consumer {
 	consumers := make([]proxy.Consumer, 0)
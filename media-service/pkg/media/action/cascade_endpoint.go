@@ -0,0 +1,60 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type CascadeRequest struct {
+	UserID string `json:"user_id"`
+	Action string `json:"action"`
+	DryRun bool   `json:"dry_run"`
+}
+
+type CascadeResponse struct {
+	Report *domain.CascadeReport `json:"report"`
+	Err    error                 `json:"-"`
+}
+
+func MakeCascadeMediaEndpoint(svc usecase.MediaCascadeInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(CascadeRequest)
+		report, err := svc.Cascade(ctx, req.UserID, req.Action, req.DryRun)
+		if err != nil {
+			return CascadeResponse{
+				Report: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return CascadeResponse{
+			Report: report,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "cascade"
+	ep = middleware.WrapResiliency(ep, "media", action)
+	return middleware.WrapInstrumentation(ep, "media", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CascadeResponse{}
+)
+
+func (r CascadeResponse) Failed() error { return r.Err }
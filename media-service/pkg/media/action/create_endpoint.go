@@ -13,14 +13,17 @@ import (
 )
 
 type CreateRequest struct {
-	Title        string `json:"title"`
-	DisplayName  string `json:"display_name"`
-	Description  string `json:"description"`
-	LanguageCode string `json:"language_code"`
-	PublisherID  string `json:"publisher_id"`
-	AuthorID     string `json:"author_id"`
-	PublishDate  string `json:"publish_date"`
-	MediaType    string `json:"media_type"`
+	Title        string           `json:"title"`
+	DisplayName  string           `json:"display_name"`
+	Description  string           `json:"description"`
+	LanguageCode string           `json:"language_code"`
+	PublisherID  string           `json:"publisher_id"`
+	Authors      []*domain.Credit `json:"authors"`
+	PublishDate  string           `json:"publish_date"`
+	MediaType    string           `json:"media_type"`
+	Visibility   string           `json:"visibility"`
+	Duration     string           `json:"duration"`
+	Explicit     string           `json:"explicit"`
 }
 
 type CreateResponse struct {
@@ -39,9 +42,12 @@ func MakeCreateMediaEndpoint(svc usecase.MediaInteractor, logger log.Logger, dur
 			Description:  req.Description,
 			LanguageCode: req.LanguageCode,
 			PublisherID:  req.PublisherID,
-			AuthorID:     req.AuthorID,
+			Authors:      req.Authors,
 			PublishDate:  req.PublishDate,
 			MediaType:    req.MediaType,
+			Visibility:   req.Visibility,
+			Duration:     req.Duration,
+			Explicit:     req.Explicit,
 		})
 		if err != nil {
 			return CreateResponse{
@@ -0,0 +1,69 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type CreateSeriesRequest struct {
+	OwnerID     string               `json:"owner_id"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	CoverURL    string               `json:"cover_url"`
+	Items       []*domain.SeriesItem `json:"items"`
+}
+
+type CreateSeriesResponse struct {
+	Series *domain.Series `json:"series"`
+	Err    error          `json:"-"`
+}
+
+func MakeCreateSeriesEndpoint(svc usecase.SeriesInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(CreateSeriesRequest)
+
+		series, err := svc.Create(ctx, &domain.SeriesAggregate{
+			OwnerID:     req.OwnerID,
+			Title:       req.Title,
+			Description: req.Description,
+			CoverURL:    req.CoverURL,
+			Items:       req.Items,
+		})
+		if err != nil {
+			return CreateSeriesResponse{
+				Series: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return CreateSeriesResponse{
+			Series: series,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "create"
+	ep = middleware.WrapResiliency(ep, "series", action)
+	return middleware.WrapInstrumentation(ep, "series", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CreateSeriesResponse{}
+)
+
+func (r CreateSeriesResponse) Failed() error { return r.Err }
@@ -0,0 +1,50 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type DeleteSeriesRequest struct {
+	ID string `json:"id"`
+}
+
+type DeleteSeriesResponse struct {
+	Err error `json:"-"`
+}
+
+func MakeDeleteSeriesEndpoint(svc usecase.SeriesInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(DeleteSeriesRequest)
+		err = svc.Delete(ctx, req.ID)
+		if err != nil {
+			return DeleteSeriesResponse{err}, nil
+		}
+
+		return DeleteSeriesResponse{nil}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "delete"
+	ep = middleware.WrapResiliency(ep, "series", action)
+	return middleware.WrapInstrumentation(ep, "series", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = DeleteSeriesResponse{}
+)
+
+func (r DeleteSeriesResponse) Failed() error { return r.Err }
@@ -0,0 +1,59 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type GetPodcastFeedRequest struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+type GetPodcastFeedResponse struct {
+	Feed *domain.PodcastFeed `json:"feed"`
+	Err  error               `json:"-"`
+}
+
+func MakeGetPodcastFeedEndpoint(svc usecase.PodcastInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(GetPodcastFeedRequest)
+		feed, err := svc.Get(ctx, req.Kind, req.ID)
+		if err != nil {
+			return GetPodcastFeedResponse{
+				Feed: nil,
+				Err:  err,
+			}, nil
+		}
+
+		return GetPodcastFeedResponse{
+			Feed: feed,
+			Err:  nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "get_feed"
+	ep = middleware.WrapResiliency(ep, "podcast", action)
+	return middleware.WrapInstrumentation(ep, "podcast", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetPodcastFeedResponse{}
+)
+
+func (r GetPodcastFeedResponse) Failed() error { return r.Err }
@@ -0,0 +1,58 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type GetSeriesRequest struct {
+	ID string `json:"id"`
+}
+
+type GetSeriesResponse struct {
+	Series *domain.Series `json:"series"`
+	Err    error          `json:"-"`
+}
+
+func MakeGetSeriesEndpoint(svc usecase.SeriesInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(GetSeriesRequest)
+		series, err := svc.Get(ctx, req.ID)
+		if err != nil {
+			return GetSeriesResponse{
+				Series: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return GetSeriesResponse{
+			Series: series,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "get"
+	ep = middleware.WrapResiliency(ep, "series", action)
+	return middleware.WrapInstrumentation(ep, "series", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = GetSeriesResponse{}
+)
+
+func (r GetSeriesResponse) Failed() error { return r.Err }
@@ -0,0 +1,50 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type HeadRequest struct {
+	ID string `json:"id"`
+}
+
+type HeadResponse struct {
+	Err error `json:"-"`
+}
+
+func MakeHeadMediaEndpoint(svc usecase.MediaInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(HeadRequest)
+		err = svc.Head(ctx, req.ID)
+		if err != nil {
+			return HeadResponse{err}, nil
+		}
+
+		return HeadResponse{nil}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "head"
+	ep = middleware.WrapResiliency(ep, "media", action)
+	return middleware.WrapInstrumentation(ep, "media", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = HeadResponse{}
+)
+
+func (r HeadResponse) Failed() error { return r.Err }
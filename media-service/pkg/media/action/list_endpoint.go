@@ -22,6 +22,7 @@ type ListRequest struct {
 type ListResponse struct {
 	Medias        []*domain.Media `json:"media"`
 	NextPageToken string          `json:"next_page_token"`
+	PrevPageToken string          `json:"prev_page_token"`
 	Err           error           `json:"-"`
 }
 
@@ -29,11 +30,12 @@ func MakeListMediaEndpoint(svc usecase.MediaInteractor, logger log.Logger, durat
 	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
 	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(ListRequest)
-		Medias, nextToken, err := svc.List(ctx, req.PageToken, req.PageSize, req.FilterParams)
+		Medias, nextToken, prevToken, err := svc.List(ctx, req.PageToken, req.PageSize, req.FilterParams)
 		if err != nil {
 			return ListResponse{
 				Medias:        nil,
 				NextPageToken: "",
+				PrevPageToken: "",
 				Err:           err,
 			}, err
 		}
@@ -41,6 +43,7 @@ func MakeListMediaEndpoint(svc usecase.MediaInteractor, logger log.Logger, durat
 		return ListResponse{
 			Medias:        Medias,
 			NextPageToken: nextToken,
+			PrevPageToken: prevToken,
 			Err:           nil,
 		}, nil
 	}
@@ -0,0 +1,64 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type ListSeriesRequest struct {
+	PageToken    string            `json:"page_token"`
+	PageSize     string            `json:"page_size"`
+	FilterParams core.FilterParams `json:"filter_params"`
+}
+
+type ListSeriesResponse struct {
+	Series        []*domain.Series `json:"series"`
+	NextPageToken string           `json:"next_page_token"`
+	Err           error            `json:"-"`
+}
+
+func MakeListSeriesEndpoint(svc usecase.SeriesInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListSeriesRequest)
+		series, nextToken, err := svc.List(ctx, req.PageToken, req.PageSize, req.FilterParams)
+		if err != nil {
+			return ListSeriesResponse{
+				Series:        nil,
+				NextPageToken: "",
+				Err:           err,
+			}, nil
+		}
+
+		return ListSeriesResponse{
+			Series:        series,
+			NextPageToken: nextToken,
+			Err:           nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "list"
+	ep = middleware.WrapResiliency(ep, "series", action)
+	return middleware.WrapInstrumentation(ep, "series", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ListSeriesResponse{}
+)
+
+func (r ListSeriesResponse) Failed() error { return r.Err }
@@ -0,0 +1,58 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type ListTransactionsRequest struct {
+	ID string `json:"id"`
+}
+
+type ListTransactionsResponse struct {
+	Transactions []*domain.SAGATransaction `json:"transactions"`
+	Err          error                     `json:"-"`
+}
+
+func MakeListTransactionsMediaEndpoint(svc usecase.MediaInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListTransactionsRequest)
+		transactions, err := svc.ListTransactions(ctx, req.ID)
+		if err != nil {
+			return ListTransactionsResponse{
+				Transactions: nil,
+				Err:          err,
+			}, nil
+		}
+
+		return ListTransactionsResponse{
+			Transactions: transactions,
+			Err:          nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "list_transactions"
+	ep = middleware.WrapResiliency(ep, "media", action)
+	return middleware.WrapInstrumentation(ep, "media", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ListTransactionsResponse{}
+)
+
+func (r ListTransactionsResponse) Failed() error { return r.Err }
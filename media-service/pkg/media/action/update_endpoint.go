@@ -13,16 +13,19 @@ import (
 )
 
 type UpdateRequest struct {
-	ID           string `json:"id"`
-	Title        string `json:"title"`
-	DisplayName  string `json:"display_name"`
-	Description  string `json:"description"`
-	LanguageCode string `json:"language_code"`
-	PublisherID  string `json:"publisher_id"`
-	AuthorID     string `json:"author_id"`
-	PublishDate  string `json:"publish_date"`
-	MediaType    string `json:"media_type"`
-	URL          string `json:"url"`
+	ID           string           `json:"id"`
+	Title        string           `json:"title"`
+	DisplayName  string           `json:"display_name"`
+	Description  string           `json:"description"`
+	LanguageCode string           `json:"language_code"`
+	PublisherID  string           `json:"publisher_id"`
+	Authors      []*domain.Credit `json:"authors"`
+	PublishDate  string           `json:"publish_date"`
+	MediaType    string           `json:"media_type"`
+	URL          string           `json:"url"`
+	Visibility   string           `json:"visibility"`
+	Duration     string           `json:"duration"`
+	Explicit     string           `json:"explicit"`
 }
 
 type UpdateResponse struct {
@@ -42,9 +45,12 @@ func MakeUpdateMediaEndpoint(svc usecase.MediaInteractor, logger log.Logger, dur
 				Description:  req.Description,
 				LanguageCode: req.LanguageCode,
 				PublisherID:  req.PublisherID,
-				AuthorID:     req.AuthorID,
+				Authors:      req.Authors,
 				PublishDate:  req.PublishDate,
 				MediaType:    req.MediaType,
+				Visibility:   req.Visibility,
+				Duration:     req.Duration,
+				Explicit:     req.Explicit,
 			},
 			ID:  req.ID,
 			URL: req.URL,
@@ -0,0 +1,74 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type UpdateSeriesRequest struct {
+	ID          string `json:"id"`
+	OwnerID     string `json:"owner_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	CoverURL    string `json:"cover_url"`
+	// Replaces every item if not nil
+	Items []*domain.SeriesItem `json:"items"`
+}
+
+type UpdateSeriesResponse struct {
+	Series *domain.Series `json:"series"`
+	Err    error          `json:"-"`
+}
+
+func MakeUpdateSeriesEndpoint(svc usecase.SeriesInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UpdateSeriesRequest)
+
+		series, err := svc.Update(ctx, &domain.SeriesUpdateAggregate{
+			Root: &domain.SeriesAggregate{
+				OwnerID:     req.OwnerID,
+				Title:       req.Title,
+				Description: req.Description,
+				CoverURL:    req.CoverURL,
+				Items:       req.Items,
+			},
+			ID: req.ID,
+		})
+		if err != nil {
+			return UpdateSeriesResponse{
+				Series: nil,
+				Err:    err,
+			}, nil
+		}
+
+		return UpdateSeriesResponse{
+			Series: series,
+			Err:    nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "update"
+	ep = middleware.WrapResiliency(ep, "series", action)
+	return middleware.WrapInstrumentation(ep, "series", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = UpdateSeriesResponse{}
+)
+
+func (r UpdateSeriesResponse) Failed() error { return r.Err }
@@ -30,19 +30,20 @@ func (mw LoggingMediaMiddleware) Create(ctx context.Context, aggregate *domain.M
 	return
 }
 
-func (mw LoggingMediaMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Media, nextToken string, err error) {
+func (mw LoggingMediaMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Media, nextToken, prevToken string, err error) {
 	defer func(begin time.Time) {
 		mw.Logger.Log(
 			"method", "media.list",
 			"input", fmt.Sprintf("page_token: %s, page_size: %s, filter: %s", pageToken, pageSize, filterParams),
 			"output", fmt.Sprintf("%+v", output),
 			"next_token", nextToken,
+			"prev_token", prevToken,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	output, nextToken, prevToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
 	return
 }
 
@@ -61,6 +62,35 @@ func (mw LoggingMediaMiddleware) Get(ctx context.Context, id string) (output *do
 	return
 }
 
+func (mw LoggingMediaMiddleware) Head(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "media.head",
+			"input", fmt.Sprintf("id: %s", id),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Head(ctx, id)
+	return
+}
+
+func (mw LoggingMediaMiddleware) ListTransactions(ctx context.Context, id string) (output []*domain.SAGATransaction, err error) {
+	defer func(begin time.Time) {
+		mw.Logger.Log(
+			"method", "media.list_transactions",
+			"input", fmt.Sprintf("id: %s", id),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.ListTransactions(ctx, id)
+	return
+}
+
 func (mw LoggingMediaMiddleware) Update(ctx context.Context, aggregate *domain.MediaUpdateAggregate) (output *domain.Media, err error) {
 	defer func(begin time.Time) {
 		mw.Logger.Log(
@@ -141,17 +171,19 @@ func (mw LoggingMediaSAGAMiddleware) VerifyAuthor(ctx context.Context, rootID st
 	return
 }
 
-func (mw LoggingMediaSAGAMiddleware) UpdateStatic(ctx context.Context, rootID string, urlJSON []byte) (err error) {
+func (mw LoggingMediaSAGAMiddleware) UpdateStatic(ctx context.Context, rootID string, urlJSON []byte,
+	blob domain.BlobMetadata) (err error) {
 	defer func(begin time.Time) {
 		_ = mw.Logger.Log(
 			"method", "media.saga.update_static",
-			"input", fmt.Sprintf("root_id: %s, url_pool: %s", rootID, string(urlJSON)),
+			"input", fmt.Sprintf("root_id: %s, url_pool: %s, content_type: %s, size: %d", rootID, string(urlJSON),
+				blob.ContentType, blob.Size),
 			"output", err,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
-	err = mw.Next.UpdateStatic(ctx, rootID, urlJSON)
+	err = mw.Next.UpdateStatic(ctx, rootID, urlJSON, blob)
 	return
 }
 
@@ -170,32 +202,186 @@ func (mw LoggingMediaSAGAMiddleware) RemoveStatic(ctx context.Context, rootID []
 	return
 }
 
-func (mw LoggingMediaSAGAMiddleware) Done(ctx context.Context, rootID, operation string) (err error) {
+func (mw LoggingMediaSAGAMiddleware) Done(ctx context.Context, rootID, transactionID, operation string) (err error) {
 	defer func(begin time.Time) {
 		mw.Logger.Log(
 			"method", "media.saga.done",
-			"input", fmt.Sprintf("root_id: %s, operation: %s", rootID, operation),
+			"input", fmt.Sprintf("root_id: %s, transaction_id: %s, operation: %s", rootID, transactionID, operation),
 			"output", err,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	err = mw.Next.Done(ctx, rootID, operation)
+	err = mw.Next.Done(ctx, rootID, transactionID, operation)
 	return
 }
 
-func (mw LoggingMediaSAGAMiddleware) Failed(ctx context.Context, rootID, operation, backup string) (err error) {
+func (mw LoggingMediaSAGAMiddleware) Failed(ctx context.Context, rootID, transactionID, operation, backup string) (err error) {
 	defer func(begin time.Time) {
 		mw.Logger.Log(
 			"method", "media.saga.failed",
-			"input", fmt.Sprintf("root_id: %s, operation: %s, backup: %s", rootID, operation, backup),
+			"input", fmt.Sprintf("root_id: %s, transaction_id: %s, operation: %s, backup: %s", rootID, transactionID,
+				operation, backup),
 			"output", err,
 			"err", err,
 			"took", time.Since(begin),
 		)
 	}(time.Now())
 
-	err = mw.Next.Failed(ctx, rootID, operation, backup)
+	err = mw.Next.Failed(ctx, rootID, transactionID, operation, backup)
+	return
+}
+
+type LoggingMediaCascadeMiddleware struct {
+	Logger log.Logger
+	Next   usecase.MediaCascadeInteractor
+}
+
+func (mw LoggingMediaCascadeMiddleware) Cascade(ctx context.Context, userID, action string, dryRun bool) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.cascade.cascade",
+			"input", fmt.Sprintf("user_id: %s, action: %s, dry_run: %t", userID, action, dryRun),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Cascade(ctx, userID, action, dryRun)
+	return
+}
+
+func (mw LoggingMediaCascadeMiddleware) GetReport(ctx context.Context, userID string) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.cascade.get_report",
+			"input", fmt.Sprintf("user_id: %s", userID),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.GetReport(ctx, userID)
+	return
+}
+
+type LoggingSeriesMiddleware struct {
+	Logger log.Logger
+	Next   usecase.SeriesInteractor
+}
+
+func (mw LoggingSeriesMiddleware) Create(ctx context.Context, aggregate *domain.SeriesAggregate) (output *domain.Series, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.series.create",
+			"input", fmt.Sprintf("%+v", aggregate),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Create(ctx, aggregate)
+	return
+}
+
+func (mw LoggingSeriesMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Series, nextToken string, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.series.list",
+			"input", fmt.Sprintf("page_token: %s, page_size: %s, filter: %s", pageToken, pageSize, filterParams),
+			"output", fmt.Sprintf("%+v", output),
+			"next_token", nextToken,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	return
+}
+
+func (mw LoggingSeriesMiddleware) Get(ctx context.Context, id string) (output *domain.Series, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.series.get",
+			"input", fmt.Sprintf("id: %s", id),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Get(ctx, id)
+	return
+}
+
+func (mw LoggingSeriesMiddleware) Update(ctx context.Context, aggregate *domain.SeriesUpdateAggregate) (output *domain.Series, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.series.update",
+			"input", fmt.Sprintf("%+v", aggregate),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Update(ctx, aggregate)
+	return
+}
+
+func (mw LoggingSeriesMiddleware) Delete(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.series.delete",
+			"input", fmt.Sprintf("id: %s", id),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Delete(ctx, id)
+	return
+}
+
+type LoggingPodcastMiddleware struct {
+	Logger log.Logger
+	Next   usecase.PodcastInteractor
+}
+
+func (mw LoggingPodcastMiddleware) Get(ctx context.Context, kind, id string) (output *domain.PodcastFeed, err error) {
+	defer func(begin time.Time) {
+		episodes := 0
+		if output != nil {
+			episodes = len(output.Episodes)
+		}
+		_ = mw.Logger.Log(
+			"method", "media.podcast.get",
+			"input", fmt.Sprintf("kind: %s, id: %s", kind, id),
+			"output", fmt.Sprintf("episodes: %d", episodes),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.Get(ctx, kind, id)
+	return
+}
+
+func (mw LoggingPodcastMiddleware) Invalidate(ctx context.Context, mediaID string) (err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "media.podcast.invalidate",
+			"input", fmt.Sprintf("media_id: %s", mediaID),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Invalidate(ctx, mediaID)
 	return
 }
@@ -29,14 +29,14 @@ func (mw MetricMediaMiddleware) Create(ctx context.Context, aggregate *domain.Me
 	return
 }
 
-func (mw MetricMediaMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Media, nextToken string, err error) {
+func (mw MetricMediaMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Media, nextToken, prevToken string, err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "media.list", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	output, nextToken, prevToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
 	return
 }
 
@@ -51,6 +51,28 @@ func (mw MetricMediaMiddleware) Get(ctx context.Context, id string) (output *dom
 	return
 }
 
+func (mw MetricMediaMiddleware) Head(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.head", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Head(ctx, id)
+	return
+}
+
+func (mw MetricMediaMiddleware) ListTransactions(ctx context.Context, id string) (output []*domain.SAGATransaction, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.list_transactions", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.ListTransactions(ctx, id)
+	return
+}
+
 func (mw MetricMediaMiddleware) Update(ctx context.Context, aggregate *domain.MediaUpdateAggregate) (output *domain.Media, err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "media.update", "error", fmt.Sprint(err != nil)}
@@ -113,14 +135,15 @@ func (mw MetricMediaSAGAMiddleware) VerifyAuthor(ctx context.Context, rootID str
 	return
 }
 
-func (mw MetricMediaSAGAMiddleware) UpdateStatic(ctx context.Context, rootID string, urlJSON []byte) (err error) {
+func (mw MetricMediaSAGAMiddleware) UpdateStatic(ctx context.Context, rootID string, urlJSON []byte,
+	blob domain.BlobMetadata) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "media.saga.update_static", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	err = mw.Next.UpdateStatic(ctx, rootID, urlJSON)
+	err = mw.Next.UpdateStatic(ctx, rootID, urlJSON, blob)
 	return
 }
 
@@ -135,24 +158,158 @@ func (mw MetricMediaSAGAMiddleware) RemoveStatic(ctx context.Context, rootID []b
 	return
 }
 
-func (mw MetricMediaSAGAMiddleware) Done(ctx context.Context, rootID, operation string) (err error) {
+func (mw MetricMediaSAGAMiddleware) Done(ctx context.Context, rootID, transactionID, operation string) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "media.saga.done", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	err = mw.Next.Done(ctx, rootID, operation)
+	err = mw.Next.Done(ctx, rootID, transactionID, operation)
 	return
 }
 
-func (mw MetricMediaSAGAMiddleware) Failed(ctx context.Context, rootID, operation, backup string) (err error) {
+func (mw MetricMediaSAGAMiddleware) Failed(ctx context.Context, rootID, transactionID, operation, backup string) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "media.saga.failed", "error", fmt.Sprint(err != nil)}
 		mw.RequestCount.With(lvs...).Add(1)
 		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	err = mw.Next.Failed(ctx, rootID, operation, backup)
+	err = mw.Next.Failed(ctx, rootID, transactionID, operation, backup)
+	return
+}
+
+type MetricMediaViewMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.MediaViewAggregator
+}
+
+func (mw MetricMediaViewMiddleware) Fold(ctx context.Context, view domain.MediaView) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.view.fold", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Fold(ctx, view)
+	return
+}
+
+type MetricMediaCascadeMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.MediaCascadeInteractor
+}
+
+func (mw MetricMediaCascadeMiddleware) Cascade(ctx context.Context, userID, action string, dryRun bool) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.cascade.cascade", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Cascade(ctx, userID, action, dryRun)
+	return
+}
+
+func (mw MetricMediaCascadeMiddleware) GetReport(ctx context.Context, userID string) (output *domain.CascadeReport, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.cascade.get_report", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.GetReport(ctx, userID)
+	return
+}
+
+type MetricSeriesMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.SeriesInteractor
+}
+
+func (mw MetricSeriesMiddleware) Create(ctx context.Context, aggregate *domain.SeriesAggregate) (output *domain.Series, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.series.create", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Create(ctx, aggregate)
+	return
+}
+
+func (mw MetricSeriesMiddleware) List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) (output []*domain.Series, nextToken string, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.series.list", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, nextToken, err = mw.Next.List(ctx, pageToken, pageSize, filterParams)
+	return
+}
+
+func (mw MetricSeriesMiddleware) Get(ctx context.Context, id string) (output *domain.Series, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.series.get", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Get(ctx, id)
+	return
+}
+
+func (mw MetricSeriesMiddleware) Update(ctx context.Context, aggregate *domain.SeriesUpdateAggregate) (output *domain.Series, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.series.update", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Update(ctx, aggregate)
+	return
+}
+
+func (mw MetricSeriesMiddleware) Delete(ctx context.Context, id string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.series.delete", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Delete(ctx, id)
+	return
+}
+
+type MetricPodcastMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.PodcastInteractor
+}
+
+func (mw MetricPodcastMiddleware) Get(ctx context.Context, kind, id string) (output *domain.PodcastFeed, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.podcast.get", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.Get(ctx, kind, id)
+	return
+}
+
+func (mw MetricPodcastMiddleware) Invalidate(ctx context.Context, mediaID string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "media.podcast.invalidate", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Invalidate(ctx, mediaID)
 	return
 }
@@ -8,18 +8,42 @@ import (
 
 type MediaInteractor interface {
 	Create(ctx context.Context, aggregate *domain.MediaAggregate) (*domain.Media, error)
-	List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) ([]*domain.Media, string, error)
+	List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) ([]*domain.Media, string, string, error)
 	Get(ctx context.Context, id string) (*domain.Media, error)
+	Head(ctx context.Context, id string) error
 	Update(ctx context.Context, aggregate *domain.MediaUpdateAggregate) (*domain.Media, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	HardDelete(ctx context.Context, id string) error
+	ListTransactions(ctx context.Context, id string) ([]*domain.SAGATransaction, error)
+}
+
+type SeriesInteractor interface {
+	Create(ctx context.Context, aggregate *domain.SeriesAggregate) (*domain.Series, error)
+	List(ctx context.Context, pageToken, pageSize string, filterParams core.FilterParams) ([]*domain.Series, string, error)
+	Get(ctx context.Context, id string) (*domain.Series, error)
+	Update(ctx context.Context, aggregate *domain.SeriesUpdateAggregate) (*domain.Series, error)
+	Delete(ctx context.Context, id string) error
 }
 
 type MediaSAGAInteractor interface {
 	VerifyAuthor(ctx context.Context, rootID string) error
-	UpdateStatic(ctx context.Context, rootID string, urlJSON []byte) error
+	UpdateStatic(ctx context.Context, rootID string, urlJSON []byte, blob domain.BlobMetadata) error
 	RemoveStatic(ctx context.Context, rootID []byte) error
-	Done(ctx context.Context, rootID, operation string) error
-	Failed(ctx context.Context, rootID, operation, snapshot string) error
+	Done(ctx context.Context, rootID, transactionID, operation string) error
+	Failed(ctx context.Context, rootID, transactionID, operation, snapshot string) error
+}
+
+type PodcastInteractor interface {
+	Get(ctx context.Context, kind, id string) (*domain.PodcastFeed, error)
+	Invalidate(ctx context.Context, mediaID string) error
+}
+
+type MediaViewAggregator interface {
+	Fold(ctx context.Context, view domain.MediaView) error
+}
+
+type MediaCascadeInteractor interface {
+	Cascade(ctx context.Context, userID, action string, dryRun bool) (*domain.CascadeReport, error)
+	GetReport(ctx context.Context, userID string) (*domain.CascadeReport, error)
 }
@@ -48,6 +48,37 @@ func WrapMediaInstrumentation(MediaUseCase usecase.MediaInteractor, logger log.L
 	return svc
 }
 
+// WrapSeriesInstrumentation Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapSeriesInstrumentation(seriesUseCase usecase.SeriesInteractor, logger log.Logger) usecase.SeriesInteractor {
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "series_request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "series_request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.SeriesInteractor
+	svc = seriesUseCase
+	svc = middleware.LoggingSeriesMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricSeriesMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
+
 // WrapMediaSAGAInstrumentation Inject middleware (metrics and logging) to bounded context's edge
 // using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
 func WrapMediaSAGAInstrumentation(MediaUseCase usecase.MediaSAGAInteractor, logger log.Logger) usecase.MediaSAGAInteractor {
@@ -87,3 +118,95 @@ func WrapMediaSAGAInstrumentation(MediaUseCase usecase.MediaSAGAInteractor, logg
 
 	return svc
 }
+
+// WrapMediaViewInstrumentation Inject metrics to the view aggregator, views are not logged
+// since every read produces one, failed batches are logged by the aggregator itself
+func WrapMediaViewInstrumentation(aggregator usecase.MediaViewAggregator) usecase.MediaViewAggregator {
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "view_request_count",
+		Help:        "number of views folded",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "view_request_latency",
+		Help:        "total duration of view folding in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.MediaViewAggregator
+	svc = aggregator
+	svc = middleware.MetricMediaViewMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
+
+// WrapMediaCascadeInstrumentation Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapMediaCascadeInstrumentation(cascadeUseCase usecase.MediaCascadeInteractor, logger log.Logger) usecase.MediaCascadeInteractor {
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "cascade_request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "cascade_request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.MediaCascadeInteractor
+	svc = cascadeUseCase
+	svc = middleware.LoggingMediaCascadeMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricMediaCascadeMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
+
+// WrapPodcastInstrumentation Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapPodcastInstrumentation(podcastUseCase usecase.PodcastInteractor, logger log.Logger) usecase.PodcastInteractor {
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "podcast_request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "podcast_request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.PodcastInteractor
+	svc = podcastUseCase
+	svc = middleware.LoggingPodcastMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricPodcastMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
@@ -0,0 +1,58 @@
+package bind
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pb"
+)
+
+// decodeCreditsHTTP returns the credits of the authors field (JSON array of author_id and role), repeated
+// author_id and role fields are also accepted (e.g. author_id=1&role=author&author_id=2&role=translator)
+func decodeCreditsHTTP(r *http.Request) ([]*domain.Credit, error) {
+	if authors := r.FormValue("authors"); authors != "" {
+		credits := make([]*domain.Credit, 0)
+		if err := json.Unmarshal([]byte(authors), &credits); err != nil {
+			return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf(exception.InvalidFieldFormatString, "authors", "[{author_id, role}]"))
+		}
+		return credits, nil
+	}
+
+	ids := r.Form["author_id"]
+	roles := r.Form["role"]
+	credits := make([]*domain.Credit, 0, len(ids))
+	for i, id := range ids {
+		role := ""
+		if i < len(roles) {
+			role = roles[i]
+		}
+		credits = append(credits, domain.NewCredit(id, role))
+	}
+
+	return credits, nil
+}
+
+func unmarshalCreditsRPC(creditsRPC []*pb.MediaCredit) []*domain.Credit {
+	credits := make([]*domain.Credit, 0, len(creditsRPC))
+	for _, credit := range creditsRPC {
+		credits = append(credits, domain.NewCredit(credit.AuthorID, credit.Role))
+	}
+
+	return credits
+}
+
+func marshalCreditsRPC(credits []*domain.Credit) []*pb.MediaCredit {
+	creditsRPC := make([]*pb.MediaCredit, 0, len(credits))
+	for _, credit := range credits {
+		creditsRPC = append(creditsRPC, &pb.MediaCredit{
+			AuthorID: credit.AuthorID,
+			Role:     credit.Role,
+		})
+	}
+
+	return creditsRPC
+}
@@ -0,0 +1,39 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/grpcutil"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// responseErrJSON extends httputil.ResponseErrJSON, forbidden errors are written as 403
+func responseErrJSON(ctx context.Context, err error, w http.ResponseWriter) {
+	if !errors.Is(err, domain.ErrForbidden) {
+		httputil.ResponseErrJSON(ctx, err, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusForbidden)
+	_ = json.NewEncoder(w).Encode(&httputil.GenericResponse{
+		Message: exception.GetErrorDescription(err),
+		Code:    http.StatusForbidden,
+	})
+}
+
+// responseRPCErr extends grpcutil.ResponseErr, forbidden errors are returned as PermissionDenied
+func responseRPCErr(err error) error {
+	if errors.Is(err, domain.ErrForbidden) {
+		return status.Error(codes.PermissionDenied, exception.GetErrorDescription(err))
+	}
+
+	return grpcutil.ResponseErr(err)
+}
@@ -3,9 +3,15 @@ package bind
 import (
 	"context"
 	"github.com/maestre3d/alexandria/media-service/pb"
+	"github.com/maestre3d/alexandria/shared/auth"
 	"google.golang.org/grpc"
 )
 
+// HealthRPCPolicy health checks are public
+var HealthRPCPolicy = auth.Policy{
+	"/pb.Health/Check": auth.Public,
+}
+
 type HealthRPCServer struct {
 	srv pb.HealthServer
 }
@@ -10,23 +10,39 @@ import (
 	"github.com/go-kit/kit/log/level"
 	"github.com/maestre3d/alexandria/media-service/internal/domain"
 	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	"github.com/maestre3d/alexandria/shared/messaging"
 	"github.com/sony/gobreaker"
 	"go.opencensus.io/trace"
 	"gocloud.dev/pubsub"
+	"strconv"
 	"time"
 )
 
 type MediaEventConsumer struct {
-	svc    usecase.MediaSAGAInteractor
-	logger log.Logger
-	cfg    *config.Kernel
+	svc      usecase.MediaSAGAInteractor
+	views    usecase.MediaViewAggregator
+	cascade  usecase.MediaCascadeInteractor
+	podcasts usecase.PodcastInteractor
+	logger   log.Logger
+	cfg      *config.Kernel
+	guard    *messaging.Guard
+	dlq      *messaging.DeadLetter
+	saga     *sagaRecorder
 }
 
-func NewMediaEventConsumer(svc usecase.MediaSAGAInteractor, logger log.Logger, cfg *config.Kernel) *MediaEventConsumer {
+func NewMediaEventConsumer(svc usecase.MediaSAGAInteractor, views usecase.MediaViewAggregator,
+	cascade usecase.MediaCascadeInteractor, podcasts usecase.PodcastInteractor, logger log.Logger, cfg *config.Kernel,
+	store messaging.Store, transactions domain.SAGATransactionRepository) *MediaEventConsumer {
 	return &MediaEventConsumer{
-		svc:    svc,
-		logger: logger,
-		cfg:    cfg,
+		svc:      svc,
+		views:    views,
+		cascade:  cascade,
+		podcasts: podcasts,
+		logger:   logger,
+		cfg:      cfg,
+		guard:    messaging.NewGuard(store, "media", logger),
+		dlq:      messaging.NewDeadLetter("media", logger),
+		saga:     newSAGARecorder(transactions, logger),
 	}
 }
 
@@ -100,12 +116,36 @@ func (c *MediaEventConsumer) SetBinders(s *eventbus.Server, ctx context.Context,
 		return err
 	}
 
+	viewed, err := c.bindMediaViewed(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	updated, err := c.bindMediaUpdated(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	userRemoved, err := c.bindUserRemoved(ctx, service)
+	if err != nil {
+		return err
+	}
+
+	userHardRemoved, err := c.bindUserHardRemoved(ctx, service)
+	if err != nil {
+		return err
+	}
+
 	s.AddConsumer(verifyBind)
 	s.AddConsumer(failedBind)
 	s.AddConsumer(aVerify)
 	s.AddConsumer(aFailed)
 	s.AddConsumer(blobUp)
 	s.AddConsumer(blobR)
+	s.AddConsumer(viewed)
+	s.AddConsumer(updated)
+	s.AddConsumer(userRemoved)
+	s.AddConsumer(userHardRemoved)
 
 	return nil
 }
@@ -127,7 +167,7 @@ func (c *MediaEventConsumer) bindOwnerVerified(ctx context.Context, service stri
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onOwnerVerified,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.OwnerVerified, c.onOwnerVerified),
 	}, nil
 }
 
@@ -147,7 +187,7 @@ func (c *MediaEventConsumer) bindOwnerFailed(ctx context.Context, service string
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onMediaFailed,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.OwnerFailed, c.onMediaFailed),
 	}, nil
 }
 
@@ -167,7 +207,7 @@ func (c *MediaEventConsumer) bindAuthorVerified(ctx context.Context, service str
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onAuthorVerified,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.AuthorVerified, c.onAuthorVerified),
 	}, nil
 }
 
@@ -187,7 +227,7 @@ func (c *MediaEventConsumer) bindAuthorFailed(ctx context.Context, service strin
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onMediaFailed,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.AuthorFailed, c.onMediaFailed),
 	}, nil
 }
 
@@ -207,7 +247,7 @@ func (c *MediaEventConsumer) bindBlobUploaded(ctx context.Context, service strin
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onBlobUploaded,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.BlobUploaded, c.onBlobUploaded),
 	}, nil
 }
 
@@ -227,14 +267,100 @@ func (c *MediaEventConsumer) bindBlobRemoved(ctx context.Context, service string
 	return &eventbus.Consumer{
 		MaxHandler: 10,
 		Consumer:   sub.(*pubsub.Subscription),
-		Handler:    c.onBlobRemoved,
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.BlobRemoved, c.onBlobRemoved),
 	}, nil
 }
 
 // Hooks / Handlers
+func (c *MediaEventConsumer) bindMediaViewed(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("media_viewed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.MediaViewed)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		// Handlers wait for their batch to be stored, must be able to fill a whole batch
+		MaxHandler: 100,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.MediaViewed, c.onMediaViewed),
+	}, nil
+}
+
+// Own side-effect listener, podcast feeds of updated media are invalidated
+func (c *MediaEventConsumer) bindMediaUpdated(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("media_updated").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.MediaUpdated)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		MaxHandler: 10,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.MediaUpdated, c.onMediaUpdated),
+	}, nil
+}
+
+// User removal listeners, media of removed users is cascaded using the configured policy
+func (c *MediaEventConsumer) bindUserRemoved(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("user_removed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.UserRemoved)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		MaxHandler: 10,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.UserRemoved, c.onUserRemoved),
+	}, nil
+}
+
+func (c *MediaEventConsumer) bindUserHardRemoved(ctx context.Context, service string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker("user_hard_removed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, domain.UserHardRemoved)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		MaxHandler: 10,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler:    c.dlq.Bind(sub.(*pubsub.Subscription), service, domain.UserHardRemoved, c.onUserHardRemoved),
+	}, nil
+}
+
 func (c *MediaEventConsumer) onOwnerVerified(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_owner_verified") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -256,26 +382,34 @@ func (c *MediaEventConsumer) onOwnerVerified(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.OwnerVerified))
 
 	// After owner validation, send AUTHOR_VERIFY event to validate authors now
+	c.saga.record(r.Context, ec, domain.OwnerVerified)
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
-	err = c.svc.VerifyAuthor(ctxU, ec.Transaction.RootID)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.VerifyAuthor(ctxU, ec.Transaction.RootID)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
-		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code != 500 {
+			c.saga.fail(r.Context, ec, domain.OwnerVerified, err.Error())
+		} else {
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
 	// Always send acknowledge if operation succeed
-	r.Message.Ack()
+	c.guard.Ack(r, ec)
 }
 
 func (c *MediaEventConsumer) onAuthorVerified(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_author_verified") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -296,26 +430,36 @@ func (c *MediaEventConsumer) onAuthorVerified(r *eventbus.Request) {
 	})
 	span.AddAttributes(trace.StringAttribute("event.name", domain.AuthorVerified))
 
+	c.saga.record(r.Context, ec, domain.AuthorVerified)
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
-	err = c.svc.Done(ctxU, ec.Transaction.RootID, ec.Transaction.Operation)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.Done(ctxU, ec.Transaction.RootID, ec.Transaction.ID, ec.Transaction.Operation)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
-		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code != 500 {
+			c.saga.fail(r.Context, ec, domain.AuthorVerified, err.Error())
+		} else {
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
+	} else {
+		c.saga.finish(r.Context, ec, domain.AuthorVerified)
 	}
 
 	// Always send acknowledge if operation succeed
-	r.Message.Ack()
+	c.guard.Ack(r, ec)
 }
 
 func (c *MediaEventConsumer) onMediaFailed(r *eventbus.Request) {
 	// Wrap whole event for context propagation / OpenTracing-like
 	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_media_failed") {
+		return
+	}
 
 	// Get span context from message
 	var traceCtx trace.SpanContext
@@ -336,24 +480,32 @@ func (c *MediaEventConsumer) onMediaFailed(r *eventbus.Request) {
 	})
 	span.AddAttributes(trace.StringAttribute("event.name", ec.Transaction.Operation))
 
+	// Keep failure reason, foreign services send it as the message body
+	c.saga.fail(r.Context, ec, messaging.BoundTopic(r.Context), string(ec.Event.Content))
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
-	err = c.svc.Failed(ctxU, ec.Transaction.RootID, ec.Transaction.Operation, ec.Transaction.Snapshot)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.Failed(ctxU, ec.Transaction.RootID, ec.Transaction.ID, ec.Transaction.Operation,
+			ec.Transaction.Snapshot)
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, ec)
 }
 
 func (c *MediaEventConsumer) onBlobUploaded(r *eventbus.Request) {
 	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_blob_uploaded") {
+		return
+	}
 
 	var traceCtx trace.SpanContext
 	err := json.Unmarshal([]byte(ec.Event.TracingContext), &traceCtx)
@@ -373,24 +525,35 @@ func (c *MediaEventConsumer) onBlobUploaded(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.BlobUploaded))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
-	err = c.svc.UpdateStatic(ctxU, ec.Transaction.RootID, ec.Event.Content)
-	if err != nil {
+	size, _ := strconv.ParseInt(r.Message.Metadata["size"], 10, 64)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.UpdateStatic(ctxU, ec.Transaction.RootID, ec.Event.Content, domain.BlobMetadata{
+			ContentType: r.Message.Metadata["content_type"],
+			Size:        size,
+		})
+	})
+	if err == nil {
+		c.invalidateFeeds(ctxU, ec.Transaction.RootID)
+	} else {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, ec)
 }
 
 func (c *MediaEventConsumer) onBlobRemoved(r *eventbus.Request) {
 	// Domain event (side-effects) does not use transactions
 	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_blob_removed") {
+		return
+	}
 
 	var traceCtx trace.SpanContext
 	err := json.Unmarshal([]byte(ec.Event.TracingContext), &traceCtx)
@@ -410,17 +573,169 @@ func (c *MediaEventConsumer) onBlobRemoved(r *eventbus.Request) {
 	span.AddAttributes(trace.StringAttribute("event.name", domain.BlobRemoved))
 
 	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
-	err = c.svc.RemoveStatic(ctxU, ec.Event.Content)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.RemoveStatic(ctxU, ec.Event.Content)
+	})
+	if err == nil {
+		// Content was already validated by RemoveStatic
+		rootPool := make([]string, 0)
+		_ = json.Unmarshal(ec.Event.Content, &rootPool)
+		c.invalidateFeeds(ctxU, rootPool[0])
+	} else {
+		_ = level.Error(c.logger).Log("err", err)
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code == 500 {
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
+		}
+	}
+
+	c.guard.Ack(r, ec)
+}
+
+func (c *MediaEventConsumer) onMediaViewed(r *eventbus.Request) {
+	// Domain event (side-effects) does not use transactions
+	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_media_viewed") {
+		return
+	}
+
+	view := domain.MediaView{}
+	if err := json.Unmarshal(ec.Event.Content, &view); err != nil || view.ID == "" {
+		// Malformed views are never counted, retrying them is pointless
+		_ = level.Error(c.logger).Log("err", "malformed media view", "event_id", ec.Event.ID)
+		c.guard.Ack(r, ec)
+		return
+	}
+
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(ec.Event.TracingContext), &traceCtx)
+	if err != nil {
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, "media: media_viewed", traceCtx)
+	defer span.End()
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaViewed))
+
+	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.views.Fold(ctxU, view)
+	})
+	if err != nil {
+		_ = level.Error(c.logger).Log("err", err)
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code == 500 {
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
+		}
+	}
+
+	c.guard.Ack(r, ec)
+}
+
+func (c *MediaEventConsumer) onMediaUpdated(r *eventbus.Request) {
+	// Domain event (side-effects) does not use transactions
+	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_media_updated") {
+		return
+	}
+
+	media := domain.Media{}
+	if err := json.Unmarshal(ec.Event.Content, &media); err != nil || media.ExternalID == "" {
+		_ = level.Error(c.logger).Log("err", "malformed media", "event_id", ec.Event.ID)
+		c.guard.Ack(r, ec)
+		return
+	}
+
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(ec.Event.TracingContext), &traceCtx)
+	if err != nil {
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, "media: media_updated", traceCtx)
+	defer span.End()
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", domain.MediaUpdated))
+
+	c.invalidateFeeds(context.WithValue(ctxT, eventbus.EventContextKey("event"), ec), media.ExternalID)
+	c.guard.Ack(r, ec)
+}
+
+// invalidateFeeds removes the podcast feeds listing the given media, feeds expire anyway so failures are only logged
+func (c *MediaEventConsumer) invalidateFeeds(ctx context.Context, mediaID string) {
+	if err := c.podcasts.Invalidate(ctx, mediaID); err != nil {
+		_ = level.Error(c.logger).Log("method", "media.consumer.invalidate_feeds", "err", err)
+	}
+}
+
+func (c *MediaEventConsumer) onUserRemoved(r *eventbus.Request) {
+	c.onUserCascade(r, "on_user_removed", "media: user_removed", domain.UserRemoved)
+}
+
+func (c *MediaEventConsumer) onUserHardRemoved(r *eventbus.Request) {
+	c.onUserCascade(r, "on_user_hard_removed", "media: user_hard_removed", domain.UserHardRemoved)
+}
+
+// onUserCascade cascades the media of the user removed by the given event, event's body is the user id.
+// Partially applied cascades are retried as a whole, already cascaded media is skipped
+func (c *MediaEventConsumer) onUserCascade(r *eventbus.Request, handler, spanName, eventName string) {
+	// Domain event (side-effects) does not use transactions
+	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, handler) {
+		return
+	}
+
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(ec.Event.TracingContext), &traceCtx)
+	if err != nil {
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, spanName, traceCtx)
+	defer span.End()
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", eventName))
+
+	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
+	err = c.dlq.Execute(ctxU, func() error {
+		_, err := c.cascade.Cascade(ctxU, string(ec.Event.Content), "", false)
+		return err
+	})
 	if err != nil {
 		_ = level.Error(c.logger).Log("err", err)
-		// If internal error, do nack
+		// If internal error after every attempt, route message to dead-letter topic
 		if code := httputil.ErrorToCode(err); code == 500 {
-			if r.Message.Nackable() {
-				r.Message.Nack()
-				return
-			}
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
 		}
 	}
 
-	r.Message.Ack()
+	c.guard.Ack(r, ec)
 }
@@ -46,7 +46,7 @@ func NewMediaHTTP(svc usecase.MediaInteractor, logger log.Logger, tracer stdopen
 	}, []string{"method", "success"})
 
 	options := []httptransport.ServerOption{
-		httptransport.ServerErrorEncoder(httputil.ResponseErrJSON),
+		httptransport.ServerErrorEncoder(responseErrJSON),
 		kitoc.HTTPServerTrace(),
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
 	}
@@ -81,6 +81,7 @@ func (h *MediaHandler) SetRoutes(public, private, admin *mux.Router) {
 	pRouter.Path("/{id}").Methods(http.MethodPatch, http.MethodPut).Handler(h.Update())
 	pRouter.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
 	pRouter.Path("/{id}/restore").Methods(http.MethodPatch).Handler(h.Restore())
+	pRouter.Path("/{id}/transactions").Methods(http.MethodGet).Handler(h.ListTransactions())
 	pRouter.Use(mux.CORSMethodMiddleware(arouter))
 
 	// Public routing
@@ -90,6 +91,7 @@ func (h *MediaHandler) SetRoutes(public, private, admin *mux.Router) {
 	r.Path("/").Methods(http.MethodGet).Handler(h.List())
 
 	r.Path("/{id}").Methods(http.MethodGet).Handler(h.Get())
+	r.Path("/{id}").Methods(http.MethodHead).Handler(h.Head())
 	r.Use(mux.CORSMethodMiddleware(r))
 }
 
@@ -116,7 +118,17 @@ func (h *MediaHandler) Get() *httptransport.Server {
 		action.MakeGetMediaEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
 		decodeGetRequest,
 		encodeGetResponse,
-		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger)))...,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get", h.logger),
+			viewerHTTPToContext))...,
+	)
+}
+
+func (h *MediaHandler) Head() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeHeadMediaEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeHeadRequest,
+		encodeHeadResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Head", h.logger)))...,
 	)
 }
 
@@ -156,18 +168,35 @@ func (h *MediaHandler) HardDelete() *httptransport.Server {
 	)
 }
 
+func (h *MediaHandler) ListTransactions() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeListTransactionsMediaEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeListTransactionsRequest,
+		encodeListTransactionsResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "List_Transactions", h.logger)))...,
+	)
+}
+
 /* Decode HTTP Request */
 
 func decodeCreateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	authors, err := decodeCreditsHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return action.CreateRequest{
 		Title:        r.FormValue("title"),
 		DisplayName:  r.FormValue("display_name"),
 		Description:  r.FormValue("description"),
 		LanguageCode: r.FormValue("language_code"),
 		PublisherID:  r.FormValue("publisher_id"),
-		AuthorID:     r.FormValue("author_id"),
+		Authors:      authors,
 		PublishDate:  r.FormValue("publish_date"),
 		MediaType:    r.FormValue("media_type"),
+		Visibility:   r.FormValue("visibility"),
+		Duration:     r.FormValue("duration"),
+		Explicit:     r.FormValue("explicit"),
 	}, nil
 }
 
@@ -177,6 +206,7 @@ func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error)
 		PageSize:  r.URL.Query().Get("page_size"),
 		FilterParams: core.FilterParams{
 			"query":         r.URL.Query().Get("query"),
+			"search_mode":   r.URL.Query().Get("search_mode"),
 			"filter_by":     r.URL.Query().Get("filter_by"),
 			"sort":          r.URL.Query().Get("sort"),
 			"show_disabled": r.URL.Query().Get("show_disabled"),
@@ -184,6 +214,7 @@ func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error)
 			"publisher":     r.URL.Query().Get("publisher"),
 			"author":        r.URL.Query().Get("author"),
 			"media_type":    r.URL.Query().Get("media_type"),
+			"series":        r.URL.Query().Get("series"),
 		},
 	}, nil
 }
@@ -192,6 +223,10 @@ func decodeGetRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	return action.GetRequest{ID: mux.Vars(r)["id"]}, nil
 }
 
+func decodeHeadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.HeadRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
 func decodeUpdateRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	if strings.Contains(r.Header.Get("Content-Type"), "json") {
 		var bodyJSON action.UpdateRequest
@@ -201,6 +236,11 @@ func decodeUpdateRequest(_ context.Context, r *http.Request) (interface{}, error
 		}
 	}
 
+	authors, err := decodeCreditsHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
 	return action.UpdateRequest{
 		ID:           mux.Vars(r)["id"],
 		Title:        r.FormValue("title"),
@@ -208,10 +248,13 @@ func decodeUpdateRequest(_ context.Context, r *http.Request) (interface{}, error
 		Description:  r.FormValue("description"),
 		LanguageCode: r.FormValue("language_code"),
 		PublisherID:  r.FormValue("publisher_id"),
-		AuthorID:     r.FormValue("author_id"),
+		Authors:      authors,
 		PublishDate:  r.FormValue("publish_date"),
 		MediaType:    r.FormValue("media_type"),
 		URL:          r.FormValue("url"),
+		Visibility:   r.FormValue("visibility"),
+		Duration:     r.FormValue("duration"),
+		Explicit:     r.FormValue("explicit"),
 	}, nil
 }
 
@@ -227,13 +270,17 @@ func decodeHardDeleteRequest(_ context.Context, r *http.Request) (interface{}, e
 	return action.HardDeleteRequest{ID: mux.Vars(r)["id"]}, nil
 }
 
+func decodeListTransactionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.ListTransactionsRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
 /* Encode HTTP Response */
 
 func encodeCreateResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	r := response.(action.CreateResponse)
 	if r.Err != nil {
-		httputil.ResponseErrJSON(ctx, r.Err, w)
+		responseErrJSON(ctx, r.Err, w)
 		return nil
 	}
 
@@ -245,7 +292,7 @@ func encodeListResponse(ctx context.Context, w http.ResponseWriter, response int
 	r, ok := response.(action.ListResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		} else if r.Err == nil && len(r.Medias) == 0 {
 			w.WriteHeader(http.StatusNotFound)
@@ -264,7 +311,7 @@ func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response inte
 	r, ok := response.(action.GetResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		} else if r.Err == nil && r.Media == nil {
 			w.WriteHeader(http.StatusNotFound)
@@ -278,12 +325,25 @@ func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response inte
 	return json.NewEncoder(w).Encode(r)
 }
 
+// encodeHeadResponse writes the status code Get would respond with, HEAD responses hold no body
+func encodeHeadResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.HeadResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
 func encodeUpdateResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	r, ok := response.(action.UpdateResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
@@ -296,7 +356,7 @@ func encodeDeleteResponse(ctx context.Context, w http.ResponseWriter, response i
 	r, ok := response.(action.DeleteResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
@@ -309,7 +369,7 @@ func encodeRestoreResponse(ctx context.Context, w http.ResponseWriter, response
 	r, ok := response.(action.RestoreResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
 			return nil
 		}
 	}
@@ -322,8 +382,27 @@ func encodeHardDeleteResponse(ctx context.Context, w http.ResponseWriter, respon
 	r, ok := response.(action.HardDeleteResponse)
 	if ok {
 		if r.Err != nil {
-			httputil.ResponseErrJSON(ctx, r.Err, w)
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeListTransactionsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.ListTransactionsResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
 			return nil
+		} else if r.Err == nil && len(r.Transactions) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntitiesNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
 		}
 	}
 
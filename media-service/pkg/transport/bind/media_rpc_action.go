@@ -3,7 +3,6 @@ package bind
 import (
 	"context"
 	"github.com/alexandria-oss/core/exception"
-	"github.com/alexandria-oss/core/grpcutil"
 	"github.com/go-kit/kit/log"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	"github.com/go-kit/kit/tracing/opentracing"
@@ -13,6 +12,7 @@ import (
 	"github.com/maestre3d/alexandria/media-service/pb"
 	"github.com/maestre3d/alexandria/media-service/pkg/media/action"
 	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	"github.com/maestre3d/alexandria/shared/auth"
 	stdopentracing "github.com/opentracing/opentracing-go"
 	stdzipkin "github.com/openzipkin/zipkin-go"
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,6 +21,18 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// MediaRPCPolicy access level of each Media method, mirrors the HTTP public, private and admin routes
+var MediaRPCPolicy = auth.Policy{
+	"/pb.Media/Create":           auth.Private,
+	"/pb.Media/List":             auth.Optional,
+	"/pb.Media/Get":              auth.Optional,
+	"/pb.Media/Update":           auth.Private,
+	"/pb.Media/Delete":           auth.Private,
+	"/pb.Media/Restore":          auth.Private,
+	"/pb.Media/HardDelete":       auth.Admin,
+	"/pb.Media/ListTransactions": auth.Private,
+}
+
 type MediaRPCServer struct {
 	srv pb.MediaServer
 }
@@ -34,6 +46,7 @@ type mediaRPCImp struct {
 	delete     grpctransport.Handler
 	restore    grpctransport.Handler
 	hardDelete grpctransport.Handler
+	listTx     grpctransport.Handler
 }
 
 func NewMediaRPC(svc usecase.MediaInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *MediaRPCServer {
@@ -80,7 +93,8 @@ func NewMediaRPC(svc usecase.MediaInteractor, logger log.Logger, tracer stdopent
 			action.MakeGetMediaEndpoint(svc, logger, duration, tracer, zipkinTracer),
 			decodeRPCGetRequest,
 			encodeRPCGetResponse,
-			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Get", logger)))...,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "Get", logger),
+				viewerGRPCToContext))...,
 		),
 		update: grpctransport.NewServer(
 			action.MakeUpdateMediaEndpoint(svc, logger, duration, tracer, zipkinTracer),
@@ -106,6 +120,12 @@ func NewMediaRPC(svc usecase.MediaInteractor, logger log.Logger, tracer stdopent
 			encodeRPCHardDeleteResponse,
 			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "HardDelete", logger)))...,
 		),
+		listTx: grpctransport.NewServer(
+			action.MakeListTransactionsMediaEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCListTransactionsRequest,
+			encodeRPCListTransactionsResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "ListTransactions", logger)))...,
+		),
 	}
 
 	return &MediaRPCServer{srv}
@@ -120,7 +140,7 @@ func (a MediaRPCServer) SetRoutes(srv *grpc.Server) {
 func (a mediaRPCImp) Create(ctx context.Context, req *pb.MediaCreateRequest) (*pb.MediaMessage, error) {
 	_, rep, err := a.create.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.MediaMessage), nil
 }
@@ -128,7 +148,7 @@ func (a mediaRPCImp) Create(ctx context.Context, req *pb.MediaCreateRequest) (*p
 func (a mediaRPCImp) List(ctx context.Context, req *pb.ListRequest) (*pb.MediaListResponse, error) {
 	_, rep, err := a.list.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.MediaListResponse), nil
 }
@@ -136,7 +156,7 @@ func (a mediaRPCImp) List(ctx context.Context, req *pb.ListRequest) (*pb.MediaLi
 func (a mediaRPCImp) Get(ctx context.Context, req *pb.IDRequest) (*pb.MediaMessage, error) {
 	_, rep, err := a.get.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.MediaMessage), nil
 }
@@ -144,7 +164,7 @@ func (a mediaRPCImp) Get(ctx context.Context, req *pb.IDRequest) (*pb.MediaMessa
 func (a mediaRPCImp) Update(ctx context.Context, req *pb.MediaUpdateRequest) (*pb.MediaMessage, error) {
 	_, rep, err := a.update.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.MediaMessage), nil
 }
@@ -152,7 +172,7 @@ func (a mediaRPCImp) Update(ctx context.Context, req *pb.MediaUpdateRequest) (*p
 func (a mediaRPCImp) Delete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
 	_, rep, err := a.delete.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.Empty), nil
 }
@@ -160,7 +180,7 @@ func (a mediaRPCImp) Delete(ctx context.Context, req *pb.IDRequest) (*pb.Empty,
 func (a mediaRPCImp) Restore(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
 	_, rep, err := a.restore.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.Empty), nil
 }
@@ -168,11 +188,19 @@ func (a mediaRPCImp) Restore(ctx context.Context, req *pb.IDRequest) (*pb.Empty,
 func (a mediaRPCImp) HardDelete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
 	_, rep, err := a.hardDelete.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, grpcutil.ResponseErr(err)
+		return nil, responseRPCErr(err)
 	}
 	return rep.(*pb.Empty), nil
 }
 
+func (a mediaRPCImp) ListTransactions(ctx context.Context, req *pb.IDRequest) (*pb.TransactionListResponse, error) {
+	_, rep, err := a.listTx.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.TransactionListResponse), nil
+}
+
 /* Decoders */
 func decodeRPCCreateRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
 	req := rpcReq.(*pb.MediaCreateRequest)
@@ -182,9 +210,12 @@ func decodeRPCCreateRequest(_ context.Context, rpcReq interface{}) (interface{},
 		Description:  req.Description,
 		LanguageCode: req.LanguageCode,
 		PublisherID:  req.PublisherID,
-		AuthorID:     req.AuthorID,
+		Authors:      unmarshalCreditsRPC(req.Authors),
 		PublishDate:  req.PublishDate,
 		MediaType:    req.MediaType,
+		Visibility:   req.Visibility,
+		Duration:     req.Duration,
+		Explicit:     req.Explicit,
 	}, nil
 }
 
@@ -211,10 +242,13 @@ func decodeRPCUpdateRequest(_ context.Context, rpcReq interface{}) (interface{},
 		Description:  req.Description,
 		LanguageCode: req.LanguageCode,
 		PublisherID:  req.PublisherID,
-		AuthorID:     req.AuthorID,
+		Authors:      unmarshalCreditsRPC(req.Authors),
 		PublishDate:  req.PublishDate,
 		MediaType:    req.MediaType,
 		URL:          req.ContentURL,
+		Visibility:   req.Visibility,
+		Duration:     req.Duration,
+		Explicit:     req.Explicit,
 	}, nil
 }
 
@@ -233,6 +267,11 @@ func decodeRPCHardDeleteRequest(_ context.Context, rpcReq interface{}) (interfac
 	return action.HardDeleteRequest{ID: req.ID}, nil
 }
 
+func decodeRPCListTransactionsRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.ListTransactionsRequest{ID: req.Id}, nil
+}
+
 /* Encoders */
 
 func encodeRPCCreateResponse(_ context.Context, response interface{}) (interface{}, error) {
@@ -246,22 +285,27 @@ func encodeRPCCreateResponse(_ context.Context, response interface{}) (interface
 	}
 
 	return &pb.MediaMessage{
-		Id:           res.Media.ExternalID,
-		Title:        res.Media.Title,
-		DisplayName:  res.Media.DisplayName,
-		Description:  res.Media.Description,
-		LanguageCode: res.Media.LanguageCode,
-		PublisherID:  res.Media.PublisherID,
-		AuthorID:     res.Media.AuthorID,
-		PublishDate:  res.Media.PublishDate.String(),
-		MediaType:    res.Media.MediaType,
-		CreateTime:   res.Media.CreateTime.String(),
-		UpdateTime:   res.Media.UpdateTime.String(),
-		DeleteTime:   res.Media.DeleteTime.String(),
-		Active:       res.Media.Active,
-		ContentURL:   *res.Media.ContentURL,
-		TotalViews:   res.Media.TotalViews,
-		Status:       res.Media.Status,
+		Id:            res.Media.ExternalID,
+		Title:         res.Media.Title,
+		DisplayName:   res.Media.DisplayName,
+		Description:   res.Media.Description,
+		LanguageCode:  res.Media.LanguageCode,
+		PublisherID:   res.Media.PublisherID,
+		Authors:       marshalCreditsRPC(res.Media.Authors),
+		PublishDate:   res.Media.PublishDate.String(),
+		MediaType:     res.Media.MediaType,
+		CreateTime:    res.Media.CreateTime.String(),
+		UpdateTime:    res.Media.UpdateTime.String(),
+		DeleteTime:    res.Media.DeleteTime.String(),
+		Active:        res.Media.Active,
+		ContentURL:    *res.Media.ContentURL,
+		TotalViews:    res.Media.TotalViews,
+		Status:        res.Media.Status,
+		Visibility:    res.Media.Visibility,
+		Duration:      int64(res.Media.Duration),
+		Explicit:      res.Media.Explicit,
+		ContentType:   res.Media.ContentType,
+		ContentLength: res.Media.ContentLength,
 	}, nil
 }
 
@@ -278,22 +322,28 @@ func encodeRPCListResponse(_ context.Context, response interface{}) (interface{}
 	MediasRPC := make([]*pb.MediaMessage, 0)
 	for _, Media := range res.Medias {
 		MediaRPC := &pb.MediaMessage{
-			Id:           Media.ExternalID,
-			Title:        Media.Title,
-			DisplayName:  Media.DisplayName,
-			Description:  Media.Description,
-			LanguageCode: Media.LanguageCode,
-			PublisherID:  Media.PublisherID,
-			AuthorID:     Media.AuthorID,
-			PublishDate:  Media.PublishDate.String(),
-			MediaType:    Media.MediaType,
-			CreateTime:   Media.CreateTime.String(),
-			UpdateTime:   Media.UpdateTime.String(),
-			DeleteTime:   Media.DeleteTime.String(),
-			Active:       Media.Active,
-			ContentURL:   *Media.ContentURL,
-			TotalViews:   Media.TotalViews,
-			Status:       Media.Status,
+			Id:            Media.ExternalID,
+			Title:         Media.Title,
+			DisplayName:   Media.DisplayName,
+			Description:   Media.Description,
+			LanguageCode:  Media.LanguageCode,
+			PublisherID:   Media.PublisherID,
+			Authors:       marshalCreditsRPC(Media.Authors),
+			PublishDate:   Media.PublishDate.String(),
+			MediaType:     Media.MediaType,
+			CreateTime:    Media.CreateTime.String(),
+			UpdateTime:    Media.UpdateTime.String(),
+			DeleteTime:    Media.DeleteTime.String(),
+			Active:        Media.Active,
+			ContentURL:    *Media.ContentURL,
+			TotalViews:    Media.TotalViews,
+			Status:        Media.Status,
+			Visibility:    Media.Visibility,
+			Duration:      int64(Media.Duration),
+			Explicit:      Media.Explicit,
+			ContentType:   Media.ContentType,
+			ContentLength: Media.ContentLength,
+			Episode:       marshalEpisodeRPC(Media.Episode),
 		}
 		MediasRPC = append(MediasRPC, MediaRPC)
 	}
@@ -301,6 +351,7 @@ func encodeRPCListResponse(_ context.Context, response interface{}) (interface{}
 	return &pb.MediaListResponse{
 		Media:         MediasRPC,
 		NextPageToken: res.NextPageToken,
+		PrevPageToken: res.PrevPageToken,
 	}, nil
 }
 
@@ -315,22 +366,27 @@ func encodeRPCGetResponse(_ context.Context, response interface{}) (interface{},
 	}
 
 	return &pb.MediaMessage{
-		Id:           res.Media.ExternalID,
-		Title:        res.Media.Title,
-		DisplayName:  res.Media.DisplayName,
-		Description:  res.Media.Description,
-		LanguageCode: res.Media.LanguageCode,
-		PublisherID:  res.Media.PublisherID,
-		AuthorID:     res.Media.AuthorID,
-		PublishDate:  res.Media.PublishDate.String(),
-		MediaType:    res.Media.MediaType,
-		CreateTime:   res.Media.CreateTime.String(),
-		UpdateTime:   res.Media.UpdateTime.String(),
-		DeleteTime:   res.Media.DeleteTime.String(),
-		Active:       res.Media.Active,
-		ContentURL:   *res.Media.ContentURL,
-		TotalViews:   res.Media.TotalViews,
-		Status:       res.Media.Status,
+		Id:            res.Media.ExternalID,
+		Title:         res.Media.Title,
+		DisplayName:   res.Media.DisplayName,
+		Description:   res.Media.Description,
+		LanguageCode:  res.Media.LanguageCode,
+		PublisherID:   res.Media.PublisherID,
+		Authors:       marshalCreditsRPC(res.Media.Authors),
+		PublishDate:   res.Media.PublishDate.String(),
+		MediaType:     res.Media.MediaType,
+		CreateTime:    res.Media.CreateTime.String(),
+		UpdateTime:    res.Media.UpdateTime.String(),
+		DeleteTime:    res.Media.DeleteTime.String(),
+		Active:        res.Media.Active,
+		ContentURL:    *res.Media.ContentURL,
+		TotalViews:    res.Media.TotalViews,
+		Status:        res.Media.Status,
+		Visibility:    res.Media.Visibility,
+		Duration:      int64(res.Media.Duration),
+		Explicit:      res.Media.Explicit,
+		ContentType:   res.Media.ContentType,
+		ContentLength: res.Media.ContentLength,
 	}, nil
 }
 
@@ -345,22 +401,27 @@ func encodeRPCUpdateResponse(_ context.Context, response interface{}) (interface
 	}
 
 	return &pb.MediaMessage{
-		Id:           res.Media.ExternalID,
-		Title:        res.Media.Title,
-		DisplayName:  res.Media.DisplayName,
-		Description:  res.Media.Description,
-		LanguageCode: res.Media.LanguageCode,
-		PublisherID:  res.Media.PublisherID,
-		AuthorID:     res.Media.AuthorID,
-		PublishDate:  res.Media.PublishDate.String(),
-		MediaType:    res.Media.MediaType,
-		CreateTime:   res.Media.CreateTime.String(),
-		UpdateTime:   res.Media.UpdateTime.String(),
-		DeleteTime:   res.Media.DeleteTime.String(),
-		Active:       res.Media.Active,
-		ContentURL:   *res.Media.ContentURL,
-		TotalViews:   res.Media.TotalViews,
-		Status:       res.Media.Status,
+		Id:            res.Media.ExternalID,
+		Title:         res.Media.Title,
+		DisplayName:   res.Media.DisplayName,
+		Description:   res.Media.Description,
+		LanguageCode:  res.Media.LanguageCode,
+		PublisherID:   res.Media.PublisherID,
+		Authors:       marshalCreditsRPC(res.Media.Authors),
+		PublishDate:   res.Media.PublishDate.String(),
+		MediaType:     res.Media.MediaType,
+		CreateTime:    res.Media.CreateTime.String(),
+		UpdateTime:    res.Media.UpdateTime.String(),
+		DeleteTime:    res.Media.DeleteTime.String(),
+		Active:        res.Media.Active,
+		ContentURL:    *res.Media.ContentURL,
+		TotalViews:    res.Media.TotalViews,
+		Status:        res.Media.Status,
+		Visibility:    res.Media.Visibility,
+		Duration:      int64(res.Media.Duration),
+		Explicit:      res.Media.Explicit,
+		ContentType:   res.Media.ContentType,
+		ContentLength: res.Media.ContentLength,
 	}, nil
 }
 
@@ -388,3 +449,50 @@ func encodeRPCHardDeleteResponse(_ context.Context, response interface{}) (inter
 	}
 	return nil, nil
 }
+
+func encodeRPCListTransactionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.ListTransactionsResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if len(res.Transactions) == 0 {
+		return nil, status.Error(codes.NotFound, exception.EntitiesNotFound.Error())
+	}
+
+	transactionsRPC := make([]*pb.TransactionMessage, 0)
+	for _, tx := range res.Transactions {
+		stepsRPC := make([]*pb.TransactionStepMessage, 0)
+		for _, step := range tx.Steps {
+			stepRPC := &pb.TransactionStepMessage{
+				Step:   step.Name,
+				Status: step.Status,
+				Time:   step.Time.String(),
+			}
+			if step.Message != nil {
+				stepRPC.Message = *step.Message
+			}
+			stepsRPC = append(stepsRPC, stepRPC)
+		}
+
+		transactionRPC := &pb.TransactionMessage{
+			Id:         tx.ID,
+			RootID:     tx.RootID,
+			Operation:  tx.Operation,
+			Step:       tx.Step,
+			Status:     tx.Status,
+			Snapshot:   tx.Snapshot,
+			Steps:      stepsRPC,
+			CreateTime: tx.CreateTime.String(),
+			UpdateTime: tx.UpdateTime.String(),
+		}
+		if tx.FailureMessage != nil {
+			transactionRPC.FailureMessage = *tx.FailureMessage
+		}
+		transactionsRPC = append(transactionsRPC, transactionRPC)
+	}
+
+	return &pb.TransactionListResponse{
+		Transactions: transactionsRPC,
+	}, nil
+}
@@ -0,0 +1,467 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+)
+
+const (
+	opdsNavigationType  = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	opdsAcquisitionType = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+	opdsJSONType        = "application/opds+json"
+	openSearchType      = "application/opensearchdescription+xml"
+	opdsAcquisitionRel  = "http://opds-spec.org/acquisition/open-access"
+
+	// opdsMediaTypes media served by catalogs, e-readers only read books and docs
+	opdsMediaTypes = "book,doc"
+	// opdsPath catalog root path
+	opdsPath = core.PublicAPI + "/opds"
+)
+
+// contentTypes MIME type of common e-book and audio extensions, mime.TypeByExtension does not know most of them
+var contentTypes = map[string]string{
+	".epub": "application/epub+zip",
+	".pdf":  "application/pdf",
+	".mobi": "application/x-mobipocket-ebook",
+	".azw3": "application/vnd.amazon.ebook",
+	".cbz":  "application/vnd.comicbook+zip",
+	".cbr":  "application/vnd.comicbook-rar",
+	".fb2":  "application/x-fictionbook+xml",
+	".djvu": "image/vnd.djvu",
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+	".ogg":  "audio/ogg",
+	".opus": "audio/opus",
+}
+
+// opdsNavigation entries of the catalog root, relative to opdsPath
+var opdsNavigation = []opdsLink{
+	{Href: "/new", Title: "Newest", Rel: "http://opds-spec.org/sort/new"},
+	{Href: "/books", Title: "Books", Rel: "subsection"},
+	{Href: "/docs", Title: "Documents", Rel: "subsection"},
+}
+
+// opdsCatalog acquisition feed served by a route
+type opdsCatalog struct {
+	ID     string
+	Title  string
+	Filter core.FilterParams
+}
+
+// opdsCatalogFunc returns the catalog of the given request
+type opdsCatalogFunc func(r *http.Request) opdsCatalog
+
+// opdsFeed OPDS feed of a request, rendered as Atom (OPDS 1.2) or as JSON (OPDS 2.0) if the client accepts it
+type opdsFeed struct {
+	opdsCatalog
+	Path  string
+	Query url.Values
+	Token string
+	JSON  bool
+}
+
+type opdsLink struct {
+	Rel       string
+	Href      string
+	Type      string
+	Title     string
+	Templated bool
+}
+
+type opdsFeedContextKey struct{}
+
+// opdsFeedHTTPToContext propagates the feed of the requested catalog
+func opdsFeedHTTPToContext(catalog opdsCatalogFunc) func(context.Context, *http.Request) context.Context {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		return context.WithValue(ctx, opdsFeedContextKey{}, newOPDSFeed(r, catalog(r)))
+	}
+}
+
+func opdsFeedFromContext(ctx context.Context) (*opdsFeed, bool) {
+	feed, ok := ctx.Value(opdsFeedContextKey{}).(*opdsFeed)
+	return feed, ok
+}
+
+func newOPDSFeed(r *http.Request, catalog opdsCatalog) *opdsFeed {
+	query := url.Values{}
+	for _, key := range []string{"query", "page_size"} {
+		if v := r.URL.Query().Get(key); v != "" {
+			query.Set(key, v)
+		}
+	}
+
+	return &opdsFeed{
+		opdsCatalog: catalog,
+		Path:        r.URL.Path,
+		Query:       query,
+		Token:       r.URL.Query().Get("page_token"),
+		JSON:        strings.Contains(r.Header.Get("Accept"), opdsJSONType),
+	}
+}
+
+// href returns the feed URL of the given page, the first page if token is empty
+func (f opdsFeed) href(token string) string {
+	query := url.Values{}
+	for k, v := range f.Query {
+		query[k] = v
+	}
+	if token != "" {
+		query.Set("page_token", token)
+	}
+	if len(query) == 0 {
+		return f.Path
+	}
+
+	return f.Path + "?" + query.Encode()
+}
+
+// links returns the feed links, page links are only set if their token is not empty
+func (f opdsFeed) links(kind, nextToken, prevToken string) []opdsLink {
+	self, start := opdsAcquisitionType, opdsNavigationType
+	if f.JSON {
+		self, start = opdsJSONType, opdsJSONType
+	}
+	if kind == "navigation" {
+		self = start
+	}
+
+	links := []opdsLink{
+		{Rel: "self", Href: f.href(f.Token), Type: self},
+		{Rel: "start", Href: opdsPath, Type: start},
+	}
+	if f.Path != opdsPath {
+		links = append(links, opdsLink{Rel: "up", Href: opdsPath, Type: start})
+	}
+	if f.JSON {
+		links = append(links, opdsLink{Rel: "search", Href: opdsPath + "/search{?query}", Type: opdsJSONType,
+			Templated: true})
+	} else {
+		links = append(links, opdsLink{Rel: "search", Href: opdsPath + "/search.xml", Type: openSearchType})
+	}
+	if nextToken != "" {
+		links = append(links, opdsLink{Rel: "next", Href: f.href(nextToken), Type: self})
+	}
+	if prevToken != "" {
+		links = append(links, opdsLink{Rel: "previous", Href: f.href(prevToken), Type: self})
+	}
+
+	return links
+}
+
+// mediaContentType returns the MIME type of a content URL using its extension, application/octet-stream if unknown
+func mediaContentType(contentURL string) string {
+	if u, err := url.Parse(contentURL); err == nil {
+		contentURL = u.Path
+	}
+
+	ext := strings.ToLower(path.Ext(contentURL))
+	if t, ok := contentTypes[ext]; ok {
+		return t
+	} else if t = mime.TypeByExtension(ext); t != "" && ext != "" {
+		return t
+	}
+
+	return "application/octet-stream"
+}
+
+// opdsPublications returns the media that can be acquired, media without content is skipped
+func opdsPublications(medias []*domain.Media) []*domain.Media {
+	publications := make([]*domain.Media, 0, len(medias))
+	for _, media := range medias {
+		if media.ContentURL == nil || *media.ContentURL == "" {
+			continue
+		}
+		publications = append(publications, media)
+	}
+
+	return publications
+}
+
+// mediaLinks returns the acquisition link of a media followed by its author and language feeds
+func mediaLinks(media *domain.Media, feedType string) []opdsLink {
+	links := []opdsLink{{Rel: opdsAcquisitionRel, Href: *media.ContentURL, Type: mediaContentType(*media.ContentURL)}}
+	for _, author := range media.AuthorPool() {
+		links = append(links, opdsLink{Rel: "related", Href: opdsPath + "/authors/" + url.PathEscape(author),
+			Type: feedType, Title: "More from this author"})
+	}
+	if media.LanguageCode != "" {
+		links = append(links, opdsLink{Rel: "related", Href: opdsPath + "/languages/" + url.PathEscape(media.LanguageCode),
+			Type: feedType, Title: "More in this language"})
+	}
+
+	return links
+}
+
+// feedUpdateTime returns the latest update time of the given media, now if empty
+func feedUpdateTime(medias []*domain.Media) time.Time {
+	updated := time.Time{}
+	for _, media := range medias {
+		if media.UpdateTime.After(updated) {
+			updated = media.UpdateTime
+		}
+	}
+	if updated.IsZero() {
+		return time.Now().UTC()
+	}
+
+	return updated.UTC()
+}
+
+// encodeOPDSFeed writes an acquisition feed of the given media page
+func encodeOPDSFeed(w http.ResponseWriter, feed *opdsFeed, medias []*domain.Media, nextToken, prevToken string) error {
+	w.Header().Set("Vary", "Accept")
+	medias = opdsPublications(medias)
+	if feed.JSON {
+		w.Header().Set("Content-Type", opdsJSONType+"; charset=utf-8")
+		return json.NewEncoder(w).Encode(newOPDSJSONFeed(feed, "acquisition", medias, nextToken, prevToken))
+	}
+
+	w.Header().Set("Content-Type", opdsAcquisitionType+"; charset=utf-8")
+	return encodeXML(w, newAtomFeed(feed, "acquisition", medias, nextToken, prevToken))
+}
+
+// encodeOPDSNavigation writes the catalog root navigation feed
+func encodeOPDSNavigation(w http.ResponseWriter, feed *opdsFeed) error {
+	w.Header().Set("Vary", "Accept")
+	if feed.JSON {
+		w.Header().Set("Content-Type", opdsJSONType+"; charset=utf-8")
+		return json.NewEncoder(w).Encode(newOPDSJSONFeed(feed, "navigation", nil, "", ""))
+	}
+
+	w.Header().Set("Content-Type", opdsNavigationType+"; charset=utf-8")
+	return encodeXML(w, newAtomFeed(feed, "navigation", nil, "", ""))
+}
+
+func encodeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	return xml.NewEncoder(w).Encode(v)
+}
+
+/* OPDS 1.2 */
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	DC      string      `xml:"xmlns:dc,attr"`
+	OPDS    string      `xml:"xmlns:opds,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomLink struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Updated   string     `xml:"updated"`
+	Published string     `xml:"published,omitempty"`
+	Language  string     `xml:"dc:language,omitempty"`
+	Issued    string     `xml:"dc:issued,omitempty"`
+	Summary   *atomText  `xml:"summary,omitempty"`
+	Content   *atomText  `xml:"content,omitempty"`
+	Links     []atomLink `xml:"link"`
+}
+
+func newAtomLinks(links []opdsLink) []atomLink {
+	atomLinks := make([]atomLink, 0, len(links))
+	for _, link := range links {
+		atomLinks = append(atomLinks, atomLink{Rel: link.Rel, Href: link.Href, Type: link.Type, Title: link.Title})
+	}
+
+	return atomLinks
+}
+
+func newAtomFeed(feed *opdsFeed, kind string, medias []*domain.Media, nextToken, prevToken string) *atomFeed {
+	updated := feedUpdateTime(medias).Format(time.RFC3339)
+	atom := &atomFeed{
+		XMLNS:   "http://www.w3.org/2005/Atom",
+		DC:      "http://purl.org/dc/terms/",
+		OPDS:    "http://opds-spec.org/2010/catalog",
+		ID:      feed.ID,
+		Title:   feed.Title,
+		Updated: updated,
+		Author:  atomAuthor{Name: "Alexandria", URI: opdsPath},
+		Links:   newAtomLinks(feed.links(kind, nextToken, prevToken)),
+		Entries: make([]atomEntry, 0, len(medias)),
+	}
+
+	if kind == "navigation" {
+		for _, nav := range opdsNavigation {
+			atom.Entries = append(atom.Entries, atomEntry{
+				ID:      "urn:alexandria:opds" + strings.ReplaceAll(nav.Href, "/", ":"),
+				Title:   nav.Title,
+				Updated: updated,
+				Content: &atomText{Type: "text", Body: nav.Title},
+				Links:   []atomLink{{Rel: nav.Rel, Href: opdsPath + nav.Href, Type: opdsAcquisitionType}},
+			})
+		}
+		return atom
+	}
+
+	for _, media := range medias {
+		entry := atomEntry{
+			ID:        "urn:alexandria:media:" + media.ExternalID,
+			Title:     media.Title,
+			Updated:   media.UpdateTime.UTC().Format(time.RFC3339),
+			Published: media.CreateTime.UTC().Format(time.RFC3339),
+			Language:  media.LanguageCode,
+			Links:     newAtomLinks(mediaLinks(media, opdsAcquisitionType)),
+		}
+		if !media.PublishDate.IsZero() {
+			entry.Issued = media.PublishDate.Format("2006-01-02")
+		}
+		if media.Description != "" {
+			entry.Summary = &atomText{Type: "text", Body: media.Description}
+		}
+		atom.Entries = append(atom.Entries, entry)
+	}
+
+	return atom
+}
+
+/* OPDS 2.0 */
+
+type opdsJSONFeed struct {
+	Metadata     opdsJSONMetadata      `json:"metadata"`
+	Links        []opdsJSONLink        `json:"links"`
+	Navigation   []opdsJSONLink        `json:"navigation,omitempty"`
+	Publications []opdsJSONPublication `json:"publications,omitempty"`
+}
+
+type opdsJSONMetadata struct {
+	Type        string `json:"@type,omitempty"`
+	Identifier  string `json:"identifier,omitempty"`
+	Title       string `json:"title"`
+	Modified    string `json:"modified,omitempty"`
+	Published   string `json:"published,omitempty"`
+	Language    string `json:"language,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type opdsJSONLink struct {
+	Rel       string `json:"rel,omitempty"`
+	Href      string `json:"href"`
+	Type      string `json:"type,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Templated bool   `json:"templated,omitempty"`
+}
+
+type opdsJSONPublication struct {
+	Metadata opdsJSONMetadata `json:"metadata"`
+	Links    []opdsJSONLink   `json:"links"`
+}
+
+func newOPDSJSONLinks(links []opdsLink) []opdsJSONLink {
+	jsonLinks := make([]opdsJSONLink, 0, len(links))
+	for _, link := range links {
+		jsonLinks = append(jsonLinks, opdsJSONLink(link))
+	}
+
+	return jsonLinks
+}
+
+func newOPDSJSONFeed(feed *opdsFeed, kind string, medias []*domain.Media, nextToken, prevToken string) *opdsJSONFeed {
+	opds := &opdsJSONFeed{
+		Metadata: opdsJSONMetadata{
+			Title:    feed.Title,
+			Modified: feedUpdateTime(medias).Format(time.RFC3339),
+		},
+		Links: newOPDSJSONLinks(feed.links(kind, nextToken, prevToken)),
+	}
+
+	if kind == "navigation" {
+		for _, nav := range opdsNavigation {
+			opds.Navigation = append(opds.Navigation, opdsJSONLink{Rel: nav.Rel, Href: opdsPath + nav.Href,
+				Type: opdsJSONType, Title: nav.Title})
+		}
+		return opds
+	}
+
+	// OPDS 2.0 feeds must always hold a publications collection
+	opds.Publications = make([]opdsJSONPublication, 0, len(medias))
+	for _, media := range medias {
+		metadata := opdsJSONMetadata{
+			Type:        "http://schema.org/Book",
+			Identifier:  "urn:alexandria:media:" + media.ExternalID,
+			Title:       media.Title,
+			Modified:    media.UpdateTime.UTC().Format(time.RFC3339),
+			Language:    media.LanguageCode,
+			Description: media.Description,
+		}
+		if media.MediaType == domain.Doc {
+			metadata.Type = "http://schema.org/DigitalDocument"
+		}
+		if !media.PublishDate.IsZero() {
+			metadata.Published = media.PublishDate.Format("2006-01-02")
+		}
+		opds.Publications = append(opds.Publications, opdsJSONPublication{
+			Metadata: metadata,
+			Links:    newOPDSJSONLinks(mediaLinks(media, opdsJSONType)),
+		})
+	}
+
+	return opds
+}
+
+/* OpenSearch */
+
+type openSearchDescription struct {
+	XMLName        xml.Name      `xml:"OpenSearchDescription"`
+	XMLNS          string        `xml:"xmlns,attr"`
+	ShortName      string        `xml:"ShortName"`
+	Description    string        `xml:"Description"`
+	InputEncoding  string        `xml:"InputEncoding"`
+	OutputEncoding string        `xml:"OutputEncoding"`
+	URL            openSearchURL `xml:"Url"`
+}
+
+type openSearchURL struct {
+	Type     string `xml:"type,attr"`
+	Template string `xml:"template,attr"`
+}
+
+// encodeOpenSearch writes the OpenSearch description of the catalog search feed
+func encodeOpenSearch(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", openSearchType+"; charset=utf-8")
+	return encodeXML(w, &openSearchDescription{
+		XMLNS:          "http://a9.com/-/spec/opensearch/1.1/",
+		ShortName:      "Alexandria",
+		Description:    "Search Alexandria books and documents",
+		InputEncoding:  "UTF-8",
+		OutputEncoding: "UTF-8",
+		URL:            openSearchURL{Type: opdsAcquisitionType, Template: opdsPath + "/search?query={searchTerms}"},
+	})
+}
@@ -0,0 +1,193 @@
+package bind
+
+import (
+	"context"
+	"errors"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/action"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+)
+
+// OPDSHandler serves the OPDS catalog of books and docs read by e-reader apps
+type OPDSHandler struct {
+	service      usecase.MediaInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewOPDSHTTP(svc usecase.MediaInteractor, logger log.Logger, tracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer) *OPDSHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "opds_request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseOPDSErr),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("media_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &OPDSHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (h *OPDSHandler) SetRoutes(public, private, admin *mux.Router) {
+	// Public routing
+	r := public.PathPrefix("/opds").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("").Methods(http.MethodGet).HandlerFunc(h.Navigation)
+	r.Path("/").Methods(http.MethodGet).HandlerFunc(h.Navigation)
+	r.Path("/search.xml").Methods(http.MethodGet).HandlerFunc(h.OpenSearch)
+
+	r.Path("/new").Methods(http.MethodGet).Handler(h.Feed("New", func(_ *http.Request) opdsCatalog {
+		return opdsCatalog{
+			ID:     "urn:alexandria:opds:new",
+			Title:  "Newest",
+			Filter: core.FilterParams{"filter_by": "create_time", "sort": "desc"},
+		}
+	}))
+	r.Path("/books").Methods(http.MethodGet).Handler(h.Feed("Books", func(_ *http.Request) opdsCatalog {
+		return opdsCatalog{
+			ID:     "urn:alexandria:opds:books",
+			Title:  "Books",
+			Filter: core.FilterParams{"media_type": "book"},
+		}
+	}))
+	r.Path("/docs").Methods(http.MethodGet).Handler(h.Feed("Docs", func(_ *http.Request) opdsCatalog {
+		return opdsCatalog{
+			ID:     "urn:alexandria:opds:docs",
+			Title:  "Documents",
+			Filter: core.FilterParams{"media_type": "doc"},
+		}
+	}))
+	r.Path("/search").Methods(http.MethodGet).Handler(h.Feed("Search", func(r *http.Request) opdsCatalog {
+		return opdsCatalog{
+			ID:     "urn:alexandria:opds:search",
+			Title:  "Search results",
+			Filter: core.FilterParams{"query": r.URL.Query().Get("query"), "search_mode": "fulltext"},
+		}
+	}))
+	r.Path("/authors/{id}").Methods(http.MethodGet).Handler(h.Feed("Author", func(r *http.Request) opdsCatalog {
+		id := mux.Vars(r)["id"]
+		return opdsCatalog{
+			ID:     "urn:alexandria:opds:authors:" + id,
+			Title:  "Author " + id,
+			Filter: core.FilterParams{"author": id, "filter_by": "create_time", "sort": "desc"},
+		}
+	}))
+	r.Path("/languages/{lang}").Methods(http.MethodGet).Handler(h.Feed("Language", func(r *http.Request) opdsCatalog {
+		lang := mux.Vars(r)["lang"]
+		return opdsCatalog{
+			ID:     "urn:alexandria:opds:languages:" + lang,
+			Title:  "Language " + lang,
+			Filter: core.FilterParams{"lang": lang, "filter_by": "create_time", "sort": "desc"},
+		}
+	}))
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+// Feed returns an acquisition feed of the given catalog, listed using the media List endpoint
+func (h *OPDSHandler) Feed(name string, catalog opdsCatalogFunc) *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeListMediaEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeOPDSFeedRequest,
+		encodeOPDSFeedResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "OPDS_"+name, h.logger),
+			opdsFeedHTTPToContext(catalog)))...,
+	)
+}
+
+// Navigation writes the catalog root, the root is static so it never reaches the service
+func (h *OPDSHandler) Navigation(w http.ResponseWriter, r *http.Request) {
+	feed := newOPDSFeed(r, opdsCatalog{ID: "urn:alexandria:opds", Title: "Alexandria"})
+	if err := encodeOPDSNavigation(w, feed); err != nil {
+		_ = h.logger.Log("method", "opds.navigation", "err", err)
+	}
+}
+
+// OpenSearch writes the OpenSearch description of the search feed
+func (h *OPDSHandler) OpenSearch(w http.ResponseWriter, _ *http.Request) {
+	if err := encodeOpenSearch(w); err != nil {
+		_ = h.logger.Log("method", "opds.open_search", "err", err)
+	}
+}
+
+/* Decode HTTP Request */
+
+func decodeOPDSFeedRequest(ctx context.Context, r *http.Request) (interface{}, error) {
+	feed, ok := opdsFeedFromContext(ctx)
+	if !ok {
+		return nil, exception.NewErrorDescription(exception.InvalidFieldFormat, "missing opds catalog")
+	}
+
+	filter := core.FilterParams{}
+	for k, v := range feed.Filter {
+		filter[k] = v
+	}
+	if filter["media_type"] == "" {
+		filter["media_type"] = opdsMediaTypes
+	}
+
+	return action.ListRequest{
+		PageToken:    r.URL.Query().Get("page_token"),
+		PageSize:     r.URL.Query().Get("page_size"),
+		FilterParams: filter,
+	}, nil
+}
+
+/* Encode HTTP Response */
+
+func encodeOPDSFeedResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	r, ok := response.(action.ListResponse)
+	if ok && r.Err != nil {
+		responseOPDSErr(ctx, r.Err, w)
+		return nil
+	}
+
+	feed, ok := opdsFeedFromContext(ctx)
+	if !ok {
+		responseErrJSON(ctx, exception.EmptyBody, w)
+		return nil
+	}
+
+	return encodeOPDSFeed(w, feed, r.Medias, r.NextPageToken, r.PrevPageToken)
+}
+
+// responseOPDSErr extends responseErrJSON, catalogs without media are written as empty feeds
+func responseOPDSErr(ctx context.Context, err error, w http.ResponseWriter) {
+	if feed, ok := opdsFeedFromContext(ctx); ok && errors.Is(err, exception.EntitiesNotFound) {
+		_ = encodeOPDSFeed(w, feed, nil, "", "")
+		return
+	}
+
+	responseErrJSON(ctx, err, w)
+}
@@ -0,0 +1,92 @@
+package bind
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaContentType(t *testing.T) {
+	assert.Equal(t, "application/epub+zip", mediaContentType("https://cdn.example.com/media/dune.EPUB?sig=abc"))
+	assert.Equal(t, "application/pdf", mediaContentType("https://cdn.example.com/media/dune.pdf"))
+	assert.Equal(t, "application/x-mobipocket-ebook", mediaContentType("dune.mobi"))
+	assert.Equal(t, "application/octet-stream", mediaContentType("https://cdn.example.com/media/dune"))
+}
+
+func newOPDSTestMedia() []*domain.Media {
+	epub, empty := "https://cdn.example.com/media/dune.epub", ""
+	return []*domain.Media{
+		{
+			ExternalID:   "dune",
+			Title:        "Dune",
+			Description:  "Spice & sand",
+			LanguageCode: "eng",
+			Authors:      []*domain.Credit{{AuthorID: "frank", Role: domain.RoleAuthor}},
+			MediaType:    domain.Book,
+			PublishDate:  time.Date(1965, 8, 1, 0, 0, 0, 0, time.UTC),
+			UpdateTime:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+			ContentURL:   &epub,
+		},
+		{ExternalID: "pending", Title: "Pending", MediaType: domain.Doc, ContentURL: &empty},
+	}
+}
+
+func TestEncodeOPDSFeed(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/opds/new?page_size=1&page_token=abc", nil)
+	feed := newOPDSFeed(r, opdsCatalog{ID: "urn:alexandria:opds:new", Title: "Newest", Filter: core.FilterParams{}})
+
+	w := httptest.NewRecorder()
+	assert.Nil(t, encodeOPDSFeed(w, feed, newOPDSTestMedia(), "next", ""))
+	assert.Equal(t, opdsAcquisitionType+"; charset=utf-8", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	assert.Contains(t, body, `<id>urn:alexandria:media:dune</id>`)
+	assert.Contains(t, body, `<link rel="http://opds-spec.org/acquisition/open-access" href="https://cdn.example.com/media/dune.epub" type="application/epub+zip">`)
+	assert.Contains(t, body, `<link rel="self" href="/v1/opds/new?page_size=1&amp;page_token=abc"`)
+	assert.Contains(t, body, `<link rel="next" href="/v1/opds/new?page_size=1&amp;page_token=next"`)
+	assert.Contains(t, body, `<link rel="related" href="/v1/opds/authors/frank"`)
+	assert.Contains(t, body, `<dc:language>eng</dc:language>`)
+	assert.Contains(t, body, `<dc:issued>1965-08-01</dc:issued>`)
+	assert.Contains(t, body, `<summary type="text">Spice &amp; sand</summary>`)
+	assert.NotContains(t, body, "previous")
+	// Media without content cannot be acquired
+	assert.NotContains(t, body, "urn:alexandria:media:pending")
+}
+
+func TestEncodeOPDSFeed_JSON(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/opds/languages/eng", nil)
+	r.Header.Set("Accept", "application/opds+json, application/atom+xml;q=0.9")
+	feed := newOPDSFeed(r, opdsCatalog{ID: "urn:alexandria:opds:languages:eng", Title: "Language eng"})
+
+	w := httptest.NewRecorder()
+	assert.Nil(t, encodeOPDSFeed(w, feed, newOPDSTestMedia(), "", "prev"))
+	assert.Equal(t, opdsJSONType+"; charset=utf-8", w.Header().Get("Content-Type"))
+
+	opds := new(opdsJSONFeed)
+	assert.Nil(t, json.NewDecoder(w.Body).Decode(opds))
+	assert.Equal(t, "Language eng", opds.Metadata.Title)
+	assert.Len(t, opds.Publications, 1)
+	assert.Equal(t, "http://schema.org/Book", opds.Publications[0].Metadata.Type)
+	assert.Equal(t, "application/epub+zip", opds.Publications[0].Links[0].Type)
+
+	rels := make([]string, 0)
+	for _, link := range opds.Links {
+		rels = append(rels, link.Rel)
+	}
+	assert.Equal(t, []string{"self", "start", "up", "search", "previous"}, rels)
+}
+
+func TestEncodeOPDSNavigation(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/opds", nil)
+	w := httptest.NewRecorder()
+	assert.Nil(t, encodeOPDSNavigation(w, newOPDSFeed(r, opdsCatalog{ID: "urn:alexandria:opds", Title: "Alexandria"})))
+	assert.Equal(t, opdsNavigationType+"; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, len(opdsNavigation), strings.Count(w.Body.String(), "<entry>"))
+	assert.NotContains(t, w.Body.String(), `rel="up"`)
+}
@@ -0,0 +1,173 @@
+package bind
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+)
+
+const rssType = "application/rss+xml"
+
+type conditionalContextKey struct{}
+
+// conditional HTTP conditional request headers
+type conditional struct {
+	IfNoneMatch     string
+	IfModifiedSince string
+}
+
+// conditionalHTTPToContext propagates the If-None-Match and If-Modified-Since headers
+func conditionalHTTPToContext(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, conditionalContextKey{}, conditional{
+		IfNoneMatch:     r.Header.Get("If-None-Match"),
+		IfModifiedSince: r.Header.Get("If-Modified-Since"),
+	})
+}
+
+// notModified returns true if the client's copy is up to date, If-Modified-Since is ignored if If-None-Match is given
+func notModified(ctx context.Context, etag string, lastModified time.Time) bool {
+	c, _ := ctx.Value(conditionalContextKey{}).(conditional)
+	if c.IfNoneMatch != "" {
+		for _, tag := range strings.Split(c.IfNoneMatch, ",") {
+			if tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/"); tag == etag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if c.IfModifiedSince == "" || lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(c.IfModifiedSince)
+	return err == nil && !lastModified.After(since)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	ITunes  string     `xml:"xmlns:itunes,attr"`
+	Podcast string     `xml:"xmlns:podcast,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Generator     string    `xml:"generator"`
+	Self          atomLink  `xml:"atom:link"`
+	Author        string    `xml:"itunes:author,omitempty"`
+	Image         *rssImage `xml:"itunes:image,omitempty"`
+	Explicit      string    `xml:"itunes:explicit"`
+	Type          string    `xml:"itunes:type"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssImage struct {
+	Href string `xml:"href,attr"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title          string       `xml:"title"`
+	Description    string       `xml:"description,omitempty"`
+	GUID           rssGUID      `xml:"guid"`
+	PubDate        string       `xml:"pubDate"`
+	Enclosure      rssEnclosure `xml:"enclosure"`
+	Duration       int          `xml:"itunes:duration,omitempty"`
+	Explicit       string       `xml:"itunes:explicit"`
+	Season         int          `xml:"itunes:season,omitempty"`
+	Episode        int          `xml:"itunes:episode,omitempty"`
+	PodcastSeason  int          `xml:"podcast:season,omitempty"`
+	PodcastEpisode int          `xml:"podcast:episode,omitempty"`
+}
+
+// newRSSFeed returns the RSS 2.0 document of a podcast feed served at the given path
+func newRSSFeed(feed *domain.PodcastFeed, path string) *rssFeed {
+	channel := rssChannel{
+		Title:       feed.Title,
+		Link:        path,
+		Description: feed.Description,
+		Language:    feed.Language,
+		Generator:   "Alexandria",
+		Self:        atomLink{Rel: "self", Href: path, Type: rssType},
+		Explicit:    strconv.FormatBool(feed.Explicit),
+		Type:        "episodic",
+		Items:       make([]rssItem, 0, len(feed.Episodes)),
+	}
+	if channel.Description == "" {
+		channel.Description = feed.Title
+	}
+	if !feed.LastModified.IsZero() {
+		channel.LastBuildDate = feed.LastModified.Format(time.RFC1123Z)
+	}
+	if feed.Kind == domain.PodcastByAuthor {
+		channel.Author = feed.ID
+	}
+	if feed.ImageURL != nil && *feed.ImageURL != "" {
+		channel.Image = &rssImage{Href: *feed.ImageURL}
+	}
+	// Series episodes are meant to be listened in order
+	if feed.Kind == domain.PodcastBySeries {
+		channel.Type = "serial"
+	}
+
+	for _, episode := range feed.Episodes {
+		channel.Items = append(channel.Items, newRSSItem(episode))
+	}
+
+	return &rssFeed{
+		Version: "2.0",
+		ITunes:  "http://www.itunes.com/dtds/podcast-1.0.dtd",
+		Podcast: "https://podcastindex.org/namespace/1.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: channel,
+	}
+}
+
+func newRSSItem(episode *domain.Media) rssItem {
+	published := episode.PublishDate
+	if published.IsZero() {
+		published = episode.CreateTime
+	}
+
+	contentType := episode.ContentType
+	if contentType == "" {
+		contentType = mediaContentType(*episode.ContentURL)
+	}
+
+	item := rssItem{
+		Title:       episode.Title,
+		Description: episode.Description,
+		GUID:        rssGUID{IsPermaLink: "false", Value: episode.ExternalID},
+		PubDate:     published.UTC().Format(time.RFC1123Z),
+		Enclosure:   rssEnclosure{URL: *episode.ContentURL, Length: episode.ContentLength, Type: contentType},
+		Duration:    episode.Duration,
+		Explicit:    strconv.FormatBool(episode.Explicit),
+	}
+	if episode.Episode != nil {
+		item.Season, item.Episode = episode.Episode.Season, episode.Episode.Number
+		item.PodcastSeason, item.PodcastEpisode = episode.Episode.Season, episode.Episode.Number
+	}
+
+	return item
+}
@@ -0,0 +1,117 @@
+package bind
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/action"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+)
+
+// PodcastHandler serves the RSS feeds podcast apps subscribe to
+type PodcastHandler struct {
+	service      usecase.PodcastInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewPodcastHTTP(svc usecase.PodcastInteractor, logger log.Logger, tracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer) *PodcastHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "podcast_request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("media_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &PodcastHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (h *PodcastHandler) SetRoutes(public, private, admin *mux.Router) {
+	// Public routing
+	r := public.PathPrefix("/podcasts").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("/authors/{id}").Methods(http.MethodGet).Handler(h.Get(domain.PodcastByAuthor))
+	r.Path("/series/{id}").Methods(http.MethodGet).Handler(h.Get(domain.PodcastBySeries))
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+func (h *PodcastHandler) Get(kind string) *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeGetPodcastFeedEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeGetPodcastFeedRequest(kind),
+		encodeGetPodcastFeedResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get_Podcast_Feed", h.logger),
+			httptransport.PopulateRequestContext, conditionalHTTPToContext))...,
+	)
+}
+
+/* Decode HTTP Request */
+
+// decodeGetPodcastFeedRequest returns the request decoder of the feeds of the given kind
+func decodeGetPodcastFeedRequest(kind string) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		return action.GetPodcastFeedRequest{Kind: kind, ID: mux.Vars(r)["id"]}, nil
+	}
+}
+
+/* Encode HTTP Response */
+
+// encodeGetPodcastFeedResponse writes the RSS feed, clients holding the current ETag or Last-Modified get a 304
+func encodeGetPodcastFeedResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	r, ok := response.(action.GetPodcastFeedResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	} else if r.Feed == nil {
+		responseErrJSON(ctx, exception.EntityNotFound, w)
+		return nil
+	}
+
+	w.Header().Set("ETag", r.Feed.ETag)
+	if !r.Feed.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", r.Feed.LastModified.Format(http.TimeFormat))
+	}
+	// Clients must revalidate, feeds change as soon as an episode does
+	w.Header().Set("Cache-Control", "no-cache")
+	if notModified(ctx, r.Feed.ETag, r.Feed.LastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	path, _ := ctx.Value(httptransport.ContextKeyRequestPath).(string)
+	w.Header().Set("Content-Type", rssType+"; charset=utf-8")
+	return encodeXML(w, newRSSFeed(r.Feed, path))
+}
@@ -0,0 +1,82 @@
+package bind
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/action"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPodcastTestFeed() *domain.PodcastFeed {
+	url, cover := "https://cdn.example.com/media/ep1.mp3", "https://cdn.example.com/media/show.png"
+	episodes := []*domain.Media{
+		{
+			ExternalID:    "ep1",
+			Title:         "Pilot",
+			LanguageCode:  "eng",
+			ContentURL:    &url,
+			ContentLength: 1024,
+			Duration:      3723,
+			PublishDate:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			UpdateTime:    time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+			Episode:       &domain.Episode{Season: 1, Number: 2},
+		},
+	}
+	return domain.NewPodcastFeed(domain.PodcastBySeries, "show", episodes, &domain.Series{
+		ExternalID: "show",
+		Title:      "The Show",
+		CoverURL:   &cover,
+	})
+}
+
+func TestEncodeGetPodcastFeedResponse(t *testing.T) {
+	feed := newPodcastTestFeed()
+	ctx := context.WithValue(context.Background(), httptransport.ContextKeyRequestPath, "/v1/podcasts/series/show")
+
+	w := httptest.NewRecorder()
+	assert.Nil(t, encodeGetPodcastFeedResponse(ctx, w, action.GetPodcastFeedResponse{Feed: feed}))
+	assert.Equal(t, rssType+"; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, feed.ETag, w.Header().Get("ETag"))
+	assert.Equal(t, "Sun, 18 Oct 2026 12:00:00 GMT", w.Header().Get("Last-Modified"))
+
+	body := w.Body.String()
+	assert.Contains(t, body, `<rss version="2.0"`)
+	assert.Contains(t, body, `<title>The Show</title>`)
+	assert.Contains(t, body, `<atom:link rel="self" href="/v1/podcasts/series/show" type="application/rss+xml"`)
+	assert.Contains(t, body, `<itunes:image href="https://cdn.example.com/media/show.png">`)
+	assert.Contains(t, body, `<itunes:type>serial</itunes:type>`)
+	assert.Contains(t, body, `<guid isPermaLink="false">ep1</guid>`)
+	assert.Contains(t, body, `<pubDate>Thu, 01 Oct 2026 00:00:00 +0000</pubDate>`)
+	assert.Contains(t, body, `<enclosure url="https://cdn.example.com/media/ep1.mp3" length="1024" type="audio/mpeg">`)
+	assert.Contains(t, body, `<itunes:duration>3723</itunes:duration>`)
+	assert.Contains(t, body, `<itunes:episode>2</itunes:episode>`)
+	assert.Contains(t, body, `<podcast:season>1</podcast:season>`)
+}
+
+func TestNotModified(t *testing.T) {
+	feed := newPodcastTestFeed()
+	r := httptest.NewRequest(http.MethodGet, "/v1/podcasts/series/show", nil)
+	r.Header.Set("If-None-Match", `"stale", W/`+feed.ETag)
+	assert.True(t, notModified(conditionalHTTPToContext(context.Background(), r), feed.ETag, feed.LastModified))
+
+	// If-Modified-Since is ignored if If-None-Match is given
+	r.Header.Set("If-None-Match", `"stale"`)
+	r.Header.Set("If-Modified-Since", feed.LastModified.Format(http.TimeFormat))
+	assert.False(t, notModified(conditionalHTTPToContext(context.Background(), r), feed.ETag, feed.LastModified))
+
+	r.Header.Del("If-None-Match")
+	assert.True(t, notModified(conditionalHTTPToContext(context.Background(), r), feed.ETag, feed.LastModified))
+	assert.False(t, notModified(conditionalHTTPToContext(context.Background(), r), feed.ETag, feed.LastModified.Add(time.Second)))
+
+	w := httptest.NewRecorder()
+	ctx := conditionalHTTPToContext(context.Background(), r)
+	assert.Nil(t, encodeGetPodcastFeedResponse(ctx, w, action.GetPodcastFeedResponse{Feed: feed}))
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+}
@@ -0,0 +1,56 @@
+package bind
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+)
+
+// sagaRecorder records the SAGA transaction steps reached by event consumers, recording is best-effort
+// and never blocks a message from being applied
+type sagaRecorder struct {
+	repository domain.SAGATransactionRepository
+	logger     log.Logger
+}
+
+func newSAGARecorder(repository domain.SAGATransactionRepository, logger log.Logger) *sagaRecorder {
+	return &sagaRecorder{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// record sets the given step as reached
+func (s *sagaRecorder) record(ctx context.Context, ec *eventbus.EventContext, step string) {
+	s.save(ctx, ec, step, func(tx *domain.SAGATransaction) {})
+}
+
+// finish sets the given step as reached and the transaction as done
+func (s *sagaRecorder) finish(ctx context.Context, ec *eventbus.EventContext, step string) {
+	s.save(ctx, ec, step, func(tx *domain.SAGATransaction) {
+		tx.Finish()
+	})
+}
+
+// fail sets the given step as reached and the transaction as failed with the given reason
+func (s *sagaRecorder) fail(ctx context.Context, ec *eventbus.EventContext, step, msg string) {
+	s.save(ctx, ec, step, func(tx *domain.SAGATransaction) {
+		tx.Fail(domain.TransactionFailed, msg)
+	})
+}
+
+func (s *sagaRecorder) save(ctx context.Context, ec *eventbus.EventContext, step string, fn func(tx *domain.SAGATransaction)) {
+	if ec.Transaction.ID == "" {
+		return
+	}
+
+	tx := domain.NewSAGATransaction(ec.Transaction.ID, ec.Transaction.RootID, ec.Transaction.Operation,
+		ec.Transaction.Snapshot, step)
+	fn(tx)
+	if err := s.repository.Save(ctx, *tx); err != nil {
+		_ = level.Warn(s.logger).Log("method", "media.transport.event.saga", "err", err.Error(),
+			"transaction_id", ec.Transaction.ID, "step", step)
+	}
+}
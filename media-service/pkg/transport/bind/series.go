@@ -0,0 +1,102 @@
+package bind
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"github.com/maestre3d/alexandria/media-service/pb"
+)
+
+// decodeSeriesItemsHTTP returns the items of the items field (JSON array of media_id, season and number), repeated
+// media_id fields are also accepted and numbered in order (e.g. media_id=1&media_id=2). Returns nil if none was given
+func decodeSeriesItemsHTTP(r *http.Request) ([]*domain.SeriesItem, error) {
+	if items := r.FormValue("items"); items != "" {
+		seriesItems := make([]*domain.SeriesItem, 0)
+		if err := json.Unmarshal([]byte(items), &seriesItems); err != nil {
+			return nil, exception.NewErrorDescription(exception.InvalidFieldFormat,
+				fmt.Sprintf(exception.InvalidFieldFormatString, "items", "[{media_id, season, number}]"))
+		}
+		return seriesItems, nil
+	}
+
+	ids, ok := r.Form["media_id"]
+	if !ok {
+		return nil, nil
+	}
+
+	seriesItems := make([]*domain.SeriesItem, 0, len(ids))
+	for _, id := range ids {
+		seriesItems = append(seriesItems, &domain.SeriesItem{MediaID: id})
+	}
+
+	return seriesItems, nil
+}
+
+func unmarshalSeriesItemsRPC(itemsRPC []*pb.SeriesItem) []*domain.SeriesItem {
+	// Empty RPC lists cannot be told apart from missing ones, they never replace items
+	if len(itemsRPC) == 0 {
+		return nil
+	}
+
+	items := make([]*domain.SeriesItem, 0, len(itemsRPC))
+	for _, item := range itemsRPC {
+		items = append(items, &domain.SeriesItem{
+			MediaID: item.MediaID,
+			Season:  int(item.Season),
+			Number:  int(item.Number),
+		})
+	}
+
+	return items
+}
+
+func marshalSeriesRPC(series *domain.Series) *pb.SeriesMessage {
+	itemsRPC := make([]*pb.SeriesItem, 0, len(series.Items))
+	for _, item := range series.Items {
+		itemsRPC = append(itemsRPC, &pb.SeriesItem{
+			MediaID: item.MediaID,
+			Season:  int32(item.Season),
+			Number:  int32(item.Number),
+		})
+	}
+
+	seriesRPC := &pb.SeriesMessage{
+		Id:          series.ExternalID,
+		OwnerID:     series.OwnerID,
+		Title:       series.Title,
+		Description: series.Description,
+		Items:       itemsRPC,
+		CreateTime:  series.CreateTime.String(),
+		UpdateTime:  series.UpdateTime.String(),
+	}
+	if series.CoverURL != nil {
+		seriesRPC.CoverURL = *series.CoverURL
+	}
+
+	return seriesRPC
+}
+
+// marshalEpisodeRPC returns the series position of a media, nil if the media was not listed by series
+func marshalEpisodeRPC(episode *domain.Episode) *pb.MediaEpisode {
+	if episode == nil {
+		return nil
+	}
+
+	episodeRPC := &pb.MediaEpisode{
+		SeriesID: episode.SeriesID,
+		Season:   int32(episode.Season),
+		Number:   int32(episode.Number),
+		Position: int32(episode.Position),
+	}
+	if episode.PreviousID != nil {
+		episodeRPC.PreviousID = *episode.PreviousID
+	}
+	if episode.NextID != nil {
+		episodeRPC.NextID = *episode.NextID
+	}
+
+	return episodeRPC
+}
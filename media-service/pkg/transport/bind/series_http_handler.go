@@ -0,0 +1,271 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alexandria-oss/core"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/action"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strings"
+)
+
+type SeriesHandler struct {
+	service      usecase.SeriesInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewSeriesHTTP(svc usecase.SeriesInteractor, logger log.Logger, tracer stdopentracing.Tracer,
+	zipkinTracer *stdzipkin.Tracer) *SeriesHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "media_service",
+		Name:        "series_request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(responseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("media_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &SeriesHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (h *SeriesHandler) SetRoutes(public, private, admin *mux.Router) {
+	// Private routing
+	pRouter := private.PathPrefix("/series").Subrouter()
+	pRouter.Methods(http.MethodOptions)
+	pRouter.Path("").Methods(http.MethodPost).Handler(h.Create())
+	pRouter.Path("/").Methods(http.MethodPost).Handler(h.Create())
+
+	pRouter.Path("/{id}").Methods(http.MethodPatch, http.MethodPut).Handler(h.Update())
+	pRouter.Path("/{id}").Methods(http.MethodDelete).Handler(h.Delete())
+	pRouter.Use(mux.CORSMethodMiddleware(pRouter))
+
+	// Public routing
+	r := public.PathPrefix("/series").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("").Methods(http.MethodGet).Handler(h.List())
+	r.Path("/").Methods(http.MethodGet).Handler(h.List())
+
+	r.Path("/{id}").Methods(http.MethodGet).Handler(h.Get())
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+func (h *SeriesHandler) Create() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeCreateSeriesEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeCreateSeriesRequest,
+		encodeCreateSeriesResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Create_Series", h.logger)))...,
+	)
+}
+
+func (h *SeriesHandler) List() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeListSeriesEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeListSeriesRequest,
+		encodeListSeriesResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "List_Series", h.logger)))...,
+	)
+}
+
+func (h *SeriesHandler) Get() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeGetSeriesEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeGetSeriesRequest,
+		encodeGetSeriesResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Get_Series", h.logger)))...,
+	)
+}
+
+func (h *SeriesHandler) Update() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeUpdateSeriesEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeUpdateSeriesRequest,
+		encodeUpdateSeriesResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Update_Series", h.logger)))...,
+	)
+}
+
+func (h *SeriesHandler) Delete() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeDeleteSeriesEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeDeleteSeriesRequest,
+		encodeDeleteSeriesResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "Delete_Series", h.logger)))...,
+	)
+}
+
+/* Decode HTTP Request */
+
+func decodeCreateSeriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		var bodyJSON action.CreateSeriesRequest
+		if err := json.NewDecoder(r.Body).Decode(&bodyJSON); err != nil {
+			return nil, exception.NewErrorDescription(exception.InvalidFieldFormat, "malformed series body")
+		}
+		return bodyJSON, nil
+	}
+
+	items, err := decodeSeriesItemsHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return action.CreateSeriesRequest{
+		OwnerID:     r.FormValue("owner_id"),
+		Title:       r.FormValue("title"),
+		Description: r.FormValue("description"),
+		CoverURL:    r.FormValue("cover_url"),
+		Items:       items,
+	}, nil
+}
+
+func decodeListSeriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.ListSeriesRequest{
+		PageToken: r.URL.Query().Get("page_token"),
+		PageSize:  r.URL.Query().Get("page_size"),
+		FilterParams: core.FilterParams{
+			"query": r.URL.Query().Get("query"),
+			"owner": r.URL.Query().Get("owner"),
+			"media": r.URL.Query().Get("media"),
+		},
+	}, nil
+}
+
+func decodeGetSeriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.GetSeriesRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
+func decodeUpdateSeriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		var bodyJSON action.UpdateSeriesRequest
+		if err := json.NewDecoder(r.Body).Decode(&bodyJSON); err != nil {
+			return nil, exception.NewErrorDescription(exception.InvalidFieldFormat, "malformed series body")
+		}
+		bodyJSON.ID = mux.Vars(r)["id"]
+		return bodyJSON, nil
+	}
+
+	items, err := decodeSeriesItemsHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return action.UpdateSeriesRequest{
+		ID:          mux.Vars(r)["id"],
+		OwnerID:     r.FormValue("owner_id"),
+		Title:       r.FormValue("title"),
+		Description: r.FormValue("description"),
+		CoverURL:    r.FormValue("cover_url"),
+		Items:       items,
+	}, nil
+}
+
+func decodeDeleteSeriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.DeleteSeriesRequest{ID: mux.Vars(r)["id"]}, nil
+}
+
+/* Encode HTTP Response */
+
+func encodeCreateSeriesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.CreateSeriesResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeListSeriesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.ListSeriesResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		} else if len(r.Series) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntitiesNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeGetSeriesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.GetSeriesResponse)
+	if ok {
+		if r.Err != nil {
+			responseErrJSON(ctx, r.Err, w)
+			return nil
+		} else if r.Series == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntityNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeUpdateSeriesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.UpdateSeriesResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
+
+func encodeDeleteSeriesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.DeleteSeriesResponse)
+	if ok && r.Err != nil {
+		responseErrJSON(ctx, r.Err, w)
+		return nil
+	}
+
+	return json.NewEncoder(w).Encode(r)
+}
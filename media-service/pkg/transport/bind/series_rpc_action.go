@@ -0,0 +1,263 @@
+package bind
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/maestre3d/alexandria/media-service/pb"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/action"
+	"github.com/maestre3d/alexandria/media-service/pkg/media/usecase"
+	"github.com/maestre3d/alexandria/shared/auth"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SeriesRPCPolicy access level of each Series method, mirrors the HTTP public and private routes
+var SeriesRPCPolicy = auth.Policy{
+	"/pb.Series/Create": auth.Private,
+	"/pb.Series/List":   auth.Optional,
+	"/pb.Series/Get":    auth.Optional,
+	"/pb.Series/Update": auth.Private,
+	"/pb.Series/Delete": auth.Private,
+}
+
+type SeriesRPCServer struct {
+	srv pb.SeriesServer
+}
+
+// Compile-time RPC implementation
+type seriesRPCImp struct {
+	create grpctransport.Handler
+	list   grpctransport.Handler
+	get    grpctransport.Handler
+	update grpctransport.Handler
+	delete grpctransport.Handler
+}
+
+func NewSeriesRPC(svc usecase.SeriesInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *SeriesRPCServer {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "rpc_media_service",
+		Name:        "series_request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("media_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	srv := seriesRPCImp{
+		create: grpctransport.NewServer(
+			action.MakeCreateSeriesEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCCreateSeriesRequest,
+			encodeRPCCreateSeriesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "CreateSeries", logger)))...,
+		),
+		list: grpctransport.NewServer(
+			action.MakeListSeriesEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCListSeriesRequest,
+			encodeRPCListSeriesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "ListSeries", logger)))...,
+		),
+		get: grpctransport.NewServer(
+			action.MakeGetSeriesEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCGetSeriesRequest,
+			encodeRPCGetSeriesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "GetSeries", logger)))...,
+		),
+		update: grpctransport.NewServer(
+			action.MakeUpdateSeriesEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCUpdateSeriesRequest,
+			encodeRPCUpdateSeriesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "UpdateSeries", logger)))...,
+		),
+		delete: grpctransport.NewServer(
+			action.MakeDeleteSeriesEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCDeleteSeriesRequest,
+			encodeRPCDeleteSeriesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "DeleteSeries", logger)))...,
+		),
+	}
+
+	return &SeriesRPCServer{srv}
+}
+
+func (a SeriesRPCServer) SetRoutes(srv *grpc.Server) {
+	pb.RegisterSeriesServer(srv, a.srv)
+}
+
+/* RPC Action Binding/Implementations */
+
+func (a seriesRPCImp) Create(ctx context.Context, req *pb.SeriesCreateRequest) (*pb.SeriesMessage, error) {
+	_, rep, err := a.create.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.SeriesMessage), nil
+}
+
+func (a seriesRPCImp) List(ctx context.Context, req *pb.ListRequest) (*pb.SeriesListResponse, error) {
+	_, rep, err := a.list.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.SeriesListResponse), nil
+}
+
+func (a seriesRPCImp) Get(ctx context.Context, req *pb.IDRequest) (*pb.SeriesMessage, error) {
+	_, rep, err := a.get.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.SeriesMessage), nil
+}
+
+func (a seriesRPCImp) Update(ctx context.Context, req *pb.SeriesUpdateRequest) (*pb.SeriesMessage, error) {
+	_, rep, err := a.update.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.SeriesMessage), nil
+}
+
+func (a seriesRPCImp) Delete(ctx context.Context, req *pb.IDRequest) (*pb.Empty, error) {
+	_, rep, err := a.delete.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, responseRPCErr(err)
+	}
+	return rep.(*pb.Empty), nil
+}
+
+/* Decoders */
+
+func decodeRPCCreateSeriesRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.SeriesCreateRequest)
+	return action.CreateSeriesRequest{
+		OwnerID:     req.OwnerID,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverURL:    req.CoverURL,
+		Items:       unmarshalSeriesItemsRPC(req.Items),
+	}, nil
+}
+
+func decodeRPCListSeriesRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.ListRequest)
+	return action.ListSeriesRequest{
+		PageToken:    req.PageToken,
+		PageSize:     req.PageSize,
+		FilterParams: req.Filter,
+	}, nil
+}
+
+func decodeRPCGetSeriesRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.GetSeriesRequest{ID: req.Id}, nil
+}
+
+func decodeRPCUpdateSeriesRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.SeriesUpdateRequest)
+	return action.UpdateSeriesRequest{
+		ID:          req.Id,
+		OwnerID:     req.OwnerID,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverURL:    req.CoverURL,
+		Items:       unmarshalSeriesItemsRPC(req.Items),
+	}, nil
+}
+
+func decodeRPCDeleteSeriesRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.IDRequest)
+	return action.DeleteSeriesRequest{ID: req.Id}, nil
+}
+
+/* Encoders */
+
+func encodeRPCCreateSeriesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.CreateSeriesResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.Series == nil {
+		return nil, exception.EmptyBody
+	}
+
+	return marshalSeriesRPC(res.Series), nil
+}
+
+func encodeRPCListSeriesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.ListSeriesResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if len(res.Series) == 0 {
+		return nil, status.Error(codes.NotFound, exception.EntitiesNotFound.Error())
+	}
+
+	seriesRPC := make([]*pb.SeriesMessage, 0, len(res.Series))
+	for _, series := range res.Series {
+		seriesRPC = append(seriesRPC, marshalSeriesRPC(series))
+	}
+
+	return &pb.SeriesListResponse{
+		Series:        seriesRPC,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func encodeRPCGetSeriesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.GetSeriesResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.Series == nil {
+		return nil, status.Error(codes.NotFound, exception.EntityNotFound.Error())
+	}
+
+	return marshalSeriesRPC(res.Series), nil
+}
+
+func encodeRPCUpdateSeriesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.UpdateSeriesResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.Series == nil {
+		return nil, exception.EmptyBody
+	}
+
+	return marshalSeriesRPC(res.Series), nil
+}
+
+func encodeRPCDeleteSeriesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.DeleteSeriesResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	return &pb.Empty{}, nil
+}
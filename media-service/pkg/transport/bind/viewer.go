@@ -0,0 +1,37 @@
+package bind
+
+import (
+	"context"
+	"github.com/maestre3d/alexandria/media-service/internal/domain"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+)
+
+// viewerHTTPToContext propagates the viewer, the user is taken from the verified identity and the country
+// from the X-Country-Code header, the API gateway strips the header of clients at the edge
+func viewerHTTPToContext(ctx context.Context, r *http.Request) context.Context {
+	return domain.WithViewer(ctx, domain.Viewer{
+		UserID:  viewerID(ctx),
+		Country: r.Header.Get("X-Country-Code"),
+	})
+}
+
+// viewerGRPCToContext propagates the viewer, the user is taken from the verified identity and the country
+// from the x-country-code metadata, the API gateway strips the metadata of clients at the edge
+func viewerGRPCToContext(ctx context.Context, md metadata.MD) context.Context {
+	viewer := domain.Viewer{UserID: viewerID(ctx)}
+	if v := md.Get("x-country-code"); len(v) > 0 {
+		viewer.Country = v[0]
+	}
+
+	return domain.WithViewer(ctx, viewer)
+}
+
+// viewerID returns the subject of the verified identity, anonymous callers have no id
+func viewerID(ctx context.Context) string {
+	if identity, ok := domain.IdentityFromContext(ctx); ok {
+		return identity.Subject
+	}
+
+	return ""
+}
@@ -0,0 +1,40 @@
+/******************************
+**	File:   credit_main.sql
+**	Name:	Database migrations scripts
+**	Desc:	Media credits migrations scripts, media credits an ordered list of authors
+**			with a role each, author_id column is moved into the media_authors table
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+CREATE TYPE alexa1.credit_enum AS ENUM (
+	'author',
+	'editor',
+	'translator',
+	'narrator',
+	'host'
+);
+
+CREATE TABLE IF NOT EXISTS alexa1.media_authors(
+    media_id    varchar(128) NOT NULL REFERENCES alexa1.media(external_id) ON DELETE CASCADE,
+    author_id   varchar(128) NOT NULL,
+	role_type	alexa1.credit_enum NOT NULL DEFAULT 'author',
+	position    smallint NOT NULL DEFAULT 0,
+    PRIMARY KEY(media_id, author_id, role_type)
+);
+
+-- author filter
+CREATE INDEX IF NOT EXISTS media_authors_author_idx ON alexa1.media_authors(author_id);
+
+-- Previous single authors become the first credit
+INSERT INTO alexa1.media_authors(media_id, author_id, role_type, position)
+SELECT external_id, author_id, 'author', 0 FROM alexa1.media ON CONFLICT DO NOTHING;
+
+ALTER TABLE alexa1.media DROP COLUMN IF EXISTS author_id;
+
+-- Data querying
+SELECT * FROM alexa1.media WHERE external_id IN (SELECT media_id FROM alexa1.media_authors
+WHERE author_id = 'WS34YXqskjGZIdyq') AND active = TRUE;
@@ -51,6 +51,11 @@ CREATE TABLE IF NOT EXISTS alexa1.media(
 	content_url     text DEFAULT NULL,
 	total_views     bigint DEFAULT 0,
 	status          alexa1.state_enum NOT NULL DEFAULT 'STATUS_PENDING',
+	visibility      alexa1.visibility_enum NOT NULL DEFAULT 'public',
+	content_type    varchar(255) NOT NULL DEFAULT '',
+	content_length  bigint NOT NULL DEFAULT 0,
+	duration        integer NOT NULL DEFAULT 0,
+	explicit        bool NOT NULL DEFAULT FALSE,
 	PRIMARY KEY(id, external_id)
 );
 
@@ -0,0 +1,39 @@
+/******************************
+**	File:   outbox.sql
+**	Name:	Outbox migrations scripts
+**	Desc:	Transactional outbox for media microservice, events are stored within
+**			the entity transaction and relayed to the event bus afterwards
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+CREATE TABLE IF NOT EXISTS alexa1.outbox(
+	id	 	        bigserial NOT NULL,
+    event_id 	    varchar(128) NOT NULL UNIQUE,
+    topic 			varchar(255) NOT NULL,
+    root_id 	    varchar(128) NOT NULL,
+    body 			bytea DEFAULT NULL,
+    metadata 	    jsonb NOT NULL DEFAULT '{}',
+    attempts 		integer NOT NULL DEFAULT 0,
+    last_error 		text DEFAULT NULL,
+    create_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dispatch_time 	timestamp DEFAULT NULL,
+    park_time 		timestamp DEFAULT NULL,
+    PRIMARY KEY(id)
+);
+
+-- Messages exhausting their attempts are parked, existing outboxes get the column
+ALTER TABLE alexa1.outbox ADD COLUMN IF NOT EXISTS park_time timestamp DEFAULT NULL;
+
+-- Relay only reads pending messages in insertion order
+DROP INDEX IF EXISTS alexa1.outbox_pending_idx;
+DROP INDEX IF EXISTS alexa1.outbox_root_idx;
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON alexa1.outbox(id) WHERE dispatch_time IS NULL AND park_time IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_root_idx ON alexa1.outbox(root_id, id) WHERE dispatch_time IS NULL AND park_time IS NULL;
+
+-- Data querying
+SELECT id, event_id, topic, root_id, attempts, last_error, park_time FROM alexa1.outbox
+WHERE park_time IS NOT NULL ORDER BY id ASC;
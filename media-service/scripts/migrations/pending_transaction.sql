@@ -0,0 +1,25 @@
+/******************************
+**	File:   pending_transaction.sql
+**	Name:	Pending transaction migrations scripts
+**	Desc:	Running SAGA transactions for media microservice, used by the
+**			timeout watchdog to resolve media stuck in STATUS_PENDING
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+CREATE TABLE IF NOT EXISTS alexa1.pending_transaction(
+    root_id 	    varchar(128) NOT NULL,
+    transaction_id 	varchar(128) NOT NULL,
+    operation 		varchar(64) NOT NULL,
+    snapshot 		text NOT NULL DEFAULT '',
+    attempts 		integer NOT NULL DEFAULT 0,
+    create_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(root_id)
+);
+
+-- Watchdog only reads expired transactions
+CREATE INDEX IF NOT EXISTS pending_transaction_update_idx ON alexa1.pending_transaction(update_time);
@@ -0,0 +1,21 @@
+/******************************
+**	File:   podcast.sql
+**	Name:	Media podcast migrations scripts
+**	Desc:	Content metadata (MIME type and size from the blob service), playback duration in seconds and explicit flag
+**			columns used by podcast feeds, existing media keep empty metadata until their content is uploaded again
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+ALTER TABLE alexa1.media ADD COLUMN IF NOT EXISTS content_type varchar(255) NOT NULL DEFAULT '';
+ALTER TABLE alexa1.media ADD COLUMN IF NOT EXISTS content_length bigint NOT NULL DEFAULT 0;
+ALTER TABLE alexa1.media ADD COLUMN IF NOT EXISTS duration integer NOT NULL DEFAULT 0;
+ALTER TABLE alexa1.media ADD COLUMN IF NOT EXISTS explicit bool NOT NULL DEFAULT FALSE;
+
+-- Data querying
+SELECT external_id, title, content_url, content_type, content_length, duration, explicit FROM alexa1.media
+WHERE media_type = 'MEDIA_PODCAST' AND active = TRUE AND status = 'STATUS_DONE' AND visibility = 'public'
+ORDER BY create_time DESC;
@@ -0,0 +1,27 @@
+/******************************
+**	File:   saga_transaction.sql
+**	Name:	SAGA transaction migrations scripts
+**	Desc:	Choreography-SAGA transactions state for media microservice, every
+**			reached step is appended to the transaction steps
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+CREATE TABLE IF NOT EXISTS alexa1.saga_transaction(
+    transaction_id 	varchar(128) NOT NULL,
+    root_id 	    varchar(128) NOT NULL,
+    operation 		varchar(64) NOT NULL,
+    step 			varchar(255) NOT NULL,
+    status 			varchar(64) NOT NULL DEFAULT 'TRANSACTION_PENDING',
+    snapshot 		text NOT NULL DEFAULT '',
+    failure_message text DEFAULT NULL,
+    steps 			jsonb NOT NULL DEFAULT '[]',
+    create_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(transaction_id)
+);
+
+CREATE INDEX IF NOT EXISTS saga_transaction_root_idx ON alexa1.saga_transaction(root_id, create_time DESC);
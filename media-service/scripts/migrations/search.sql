@@ -0,0 +1,46 @@
+/******************************
+**	File:   search.sql
+**	Name:	Full-text search migrations scripts
+**	Desc:	Generated tsvector column for media microservice, documents are parsed
+**			using the text search configuration of its language_code
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+-- Maps ISO 639-1/639-2 language codes into text search configurations, unknown languages are not stemmed
+CREATE OR REPLACE FUNCTION alexa1.search_config(lang varchar) RETURNS regconfig AS $$
+	SELECT CASE LOWER(lang)
+		WHEN 'en' THEN 'english'
+		WHEN 'eng' THEN 'english'
+		WHEN 'es' THEN 'spanish'
+		WHEN 'spa' THEN 'spanish'
+		WHEN 'fr' THEN 'french'
+		WHEN 'fra' THEN 'french'
+		WHEN 'de' THEN 'german'
+		WHEN 'deu' THEN 'german'
+		WHEN 'it' THEN 'italian'
+		WHEN 'ita' THEN 'italian'
+		WHEN 'pt' THEN 'portuguese'
+		WHEN 'por' THEN 'portuguese'
+		ELSE 'simple'
+	END::regconfig
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Stemmed lexemes are weighted by field, unstemmed ones allow searching without a language
+ALTER TABLE alexa1.media ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+	setweight(to_tsvector(alexa1.search_config(language_code), COALESCE(title, '')), 'A') ||
+	setweight(to_tsvector(alexa1.search_config(language_code), COALESCE(display_name, '')), 'A') ||
+	setweight(to_tsvector(alexa1.search_config(language_code), COALESCE(description, '')), 'B') ||
+	setweight(to_tsvector('simple', COALESCE(title, '') || ' ' || COALESCE(display_name, '')), 'C') ||
+	setweight(to_tsvector('simple', COALESCE(description, '')), 'D')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS media_search_idx ON alexa1.media USING GIN(search_vector);
+
+-- Data querying
+SELECT title, ts_rank_cd(search_vector, search_query) AS rank
+FROM alexa1.media, to_tsquery(alexa1.search_config('en'), 'micro:* & serv:*') search_query
+WHERE search_vector @@ search_query ORDER BY rank DESC;
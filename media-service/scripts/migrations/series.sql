@@ -0,0 +1,45 @@
+/******************************
+**	File:   series.sql
+**	Name:	Database migrations scripts
+**	Desc:	Media series migrations scripts, series group media in order (e.g. podcast seasons,
+**			multi-volume books) using a season and an episode/volume number
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+CREATE TABLE IF NOT EXISTS alexa1.series(
+	id	 	        bigserial NOT NULL,
+    external_id 	varchar(128) NOT NULL UNIQUE,
+    owner_id 	    varchar(128) NOT NULL,
+    title 			varchar(255) NOT NULL,
+    description 	text NOT NULL DEFAULT '',
+	cover_url       text DEFAULT NULL,
+	create_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	update_time 	timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY(id, external_id)
+);
+
+-- position is the zero-based index of the item sorted by season and number
+CREATE TABLE IF NOT EXISTS alexa1.series_items(
+    series_id   varchar(128) NOT NULL REFERENCES alexa1.series(external_id) ON DELETE CASCADE,
+    media_id    varchar(128) NOT NULL REFERENCES alexa1.media(external_id) ON DELETE CASCADE,
+	season      smallint NOT NULL DEFAULT 0,
+	number      integer NOT NULL,
+	position    integer NOT NULL,
+    PRIMARY KEY(series_id, media_id),
+	UNIQUE(series_id, season, number)
+);
+
+-- owner filter
+CREATE INDEX IF NOT EXISTS series_owner_idx ON alexa1.series(owner_id);
+-- series listing order
+CREATE INDEX IF NOT EXISTS series_items_position_idx ON alexa1.series_items(series_id, position);
+-- media filter
+CREATE INDEX IF NOT EXISTS series_items_media_idx ON alexa1.series_items(media_id);
+
+-- Data querying
+SELECT media.* FROM alexa1.media JOIN alexa1.series_items ON series_items.media_id = media.external_id
+AND series_items.series_id = 'Xk2s9dPq0bLm4nRt' WHERE active = TRUE ORDER BY position, external_id;
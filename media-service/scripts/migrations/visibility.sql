@@ -0,0 +1,18 @@
+/******************************
+**	File:   visibility.sql
+**	Name:	Media visibility migrations scripts
+**	Desc:	Visibility column for media created before visibility modes, existing media stay public
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+SET search_path TO 'alexandria/media';
+
+ALTER TABLE alexa1.media ADD COLUMN IF NOT EXISTS visibility alexa1.visibility_enum NOT NULL DEFAULT 'public';
+
+-- Listings only fetch public media or the ones of their viewer
+CREATE INDEX IF NOT EXISTS media_visibility_idx ON alexa1.media(visibility, publisher_id);
+
+-- Data querying
+SELECT external_id, title, visibility FROM alexa1.media WHERE visibility = 'public' OR publisher_id = 'af06cc79-1d66-4fb8-820a-07d7d75a9ada';
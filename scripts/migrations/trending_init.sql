@@ -0,0 +1,26 @@
+/******************************
+**	File:   trending_init.sql
+**	Name:	Database migrations scripts
+**	Desc:	Main database migrations scripts for Trending microservice
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+CREATE DATABASE alexandria_trending;
+\c alexandria_trending
+
+CREATE SCHEMA IF NOT EXISTS alexa1;
+
+-- Social interactions (SI) per aggregate, counted in time buckets
+-- Using ISO 3166-1 Alpha-2 country codes, empty if unknown
+CREATE TABLE IF NOT EXISTS alexa1.interaction(
+    aggregate_type  varchar(32) NOT NULL,
+    aggregate_id    varchar(128) NOT NULL,
+    country         varchar(8) NOT NULL DEFAULT '',
+    bucket_time     timestamp NOT NULL,
+    total           bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (aggregate_type, aggregate_id, country, bucket_time)
+);
+
+CREATE INDEX IF NOT EXISTS interaction_window_idx ON alexa1.interaction(aggregate_type, bucket_time, country);
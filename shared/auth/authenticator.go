@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/log"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	viper.SetDefault("alexandria.security.auth.jwks", "")
+	viper.SetDefault("alexandria.security.auth.key_file", "")
+	viper.SetDefault("alexandria.security.auth.issuer", "")
+	viper.SetDefault("alexandria.security.auth.audience", "")
+	viper.SetDefault("alexandria.security.auth.admin_group", "admin")
+	viper.SetDefault("alexandria.security.auth.refresh", "1h")
+}
+
+// Access level required to call an operation
+type Access int
+
+const (
+	// Public anyone may call the operation
+	Public Access = iota
+	// Private only authenticated callers may call the operation
+	Private
+	// Admin only authenticated callers within the admin group may call the operation
+	Admin
+	// Optional anyone may call the operation, callers holding credentials are authenticated
+	Optional
+)
+
+// Policy access level required by each gRPC method (e.g. /pb.Media/Create), unknown methods are private
+type Policy map[string]Access
+
+// Authenticator verifies callers of the private and admin APIs, the caller's identity is
+// propagated through the request context, see IdentityFromContext
+type Authenticator struct {
+	verifier   *Verifier
+	adminGroup string
+	logger     log.Logger
+}
+
+// NewAuthenticator returns an authenticator using the configured key set, the local key file
+// takes precedence over the remote JWKS
+func NewAuthenticator(logger log.Logger) (*Authenticator, error) {
+	var keys KeySet
+	if path := viper.GetString("alexandria.security.auth.key_file"); path != "" {
+		fileKeys, err := NewFileKeySet(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = fileKeys
+	} else if url := viper.GetString("alexandria.security.auth.jwks"); url != "" {
+		keys = NewJWKSKeySet(url, viper.GetDuration("alexandria.security.auth.refresh"))
+	} else {
+		return nil, errors.New("missing alexandria.security.auth.jwks or alexandria.security.auth.key_file")
+	}
+
+	return &Authenticator{
+		verifier: NewVerifier(keys, viper.GetString("alexandria.security.auth.issuer"),
+			viper.GetString("alexandria.security.auth.audience")),
+		adminGroup: viper.GetString("alexandria.security.auth.admin_group"),
+		logger:     logger,
+	}, nil
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy, guards every private and admin route
+func (a *Authenticator) SetRoutes(public, private, admin *mux.Router) {
+	public.Use(a.Middleware(Optional))
+	private.Use(a.Middleware(Private))
+	admin.Use(a.Middleware(Admin))
+}
+
+// Middleware returns an HTTP middleware rejecting callers without the given access level
+func (a *Authenticator) Middleware(access Access) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// CORS preflight requests never hold credentials
+			if access == Public || r.Method == http.MethodOptions ||
+				(access == Optional && r.Header.Get("Authorization") == "") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			identity, err := a.authorize(r.Context(), r.Header.Get("Authorization"), access)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithIdentity(r.Context(), *identity)))
+		})
+	}
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor rejecting callers without the access level of the
+// called method
+func (a *Authenticator) UnaryServerInterceptor(policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		access, ok := policy[info.FullMethod]
+		if !ok {
+			access = Private
+		}
+		if access == Public {
+			return handler(ctx, req)
+		}
+
+		authorization := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get("authorization"); len(v) > 0 {
+				authorization = v[0]
+			}
+		}
+
+		if access == Optional && authorization == "" {
+			return handler(ctx, req)
+		}
+
+		identity, err := a.authorize(ctx, authorization, access)
+		if err != nil {
+			code := codes.Unauthenticated
+			if errors.Is(err, ErrPermissionDenied) {
+				code = codes.PermissionDenied
+			}
+			return nil, status.Error(code, exception.GetErrorDescription(err))
+		}
+
+		return handler(WithIdentity(ctx, *identity), req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor rejecting callers without the access level of the
+// called method, used by proxies where every rpc is handled as a stream
+func (a *Authenticator) StreamServerInterceptor(policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		access, ok := policy[info.FullMethod]
+		if !ok {
+			access = Private
+		}
+		if access == Public {
+			return handler(srv, ss)
+		}
+
+		authorization := ""
+		if md, ok := metadata.FromIncomingContext(ss.Context()); ok {
+			if v := md.Get("authorization"); len(v) > 0 {
+				authorization = v[0]
+			}
+		}
+
+		if access == Optional && authorization == "" {
+			return handler(srv, ss)
+		}
+
+		identity, err := a.authorize(ss.Context(), authorization, access)
+		if err != nil {
+			code := codes.Unauthenticated
+			if errors.Is(err, ErrPermissionDenied) {
+				code = codes.PermissionDenied
+			}
+			return status.Error(code, exception.GetErrorDescription(err))
+		}
+
+		return handler(srv, identityServerStream{ServerStream: ss, ctx: WithIdentity(ss.Context(), *identity)})
+	}
+}
+
+// identityServerStream server stream propagating the caller's identity through its context
+type identityServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s identityServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize returns the identity of the given bearer authorization if it has the required access level
+func (a *Authenticator) authorize(ctx context.Context, authorization string, access Access) (*Identity, error) {
+	parts := strings.SplitN(authorization, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || parts[1] == "" {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "missing bearer token")
+	}
+
+	identity, err := a.verifier.Verify(ctx, parts[1])
+	if err != nil {
+		_ = a.logger.Log("method", "transport.auth.authorize", "err", err.Error())
+		return nil, err
+	}
+
+	identity.Admin = identity.InGroup(a.adminGroup)
+	if access == Admin && !identity.Admin {
+		return nil, exception.NewErrorDescription(ErrPermissionDenied, "caller is not an administrator")
+	}
+
+	return identity, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusUnauthorized
+	if errors.Is(err, ErrPermissionDenied) {
+		code = http.StatusForbidden
+	} else {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="alexandria"`)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(&httputil.GenericResponse{
+		Message: exception.GetErrorDescription(err),
+		Code:    code,
+	})
+}
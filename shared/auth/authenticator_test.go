@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticator(t *testing.T) {
+	key := newTestKey(t)
+	a := &Authenticator{
+		verifier:   NewVerifier(staticKeySet{kid: "kid-1", key: &key.PublicKey}, "", ""),
+		adminGroup: "admin",
+		logger:     log.NewNopLogger(),
+	}
+	token := func(groups ...string) string {
+		return "Bearer " + signTestToken(t, key, "RS256", "kid-1", map[string]interface{}{
+			"sub":            "user-1",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"cognito:groups": groups,
+		})
+	}
+
+	handler := func(access Access) http.Handler {
+		return a.Middleware(access)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, ok := IdentityFromContext(r.Context())
+			assert.True(t, ok)
+			assert.Equal(t, "user-1", identity.Subject)
+		}))
+	}
+
+	tests := []struct {
+		name          string
+		access        Access
+		authorization string
+		code          int
+	}{
+		{"private without token", Private, "", http.StatusUnauthorized},
+		{"private invalid token", Private, "Bearer abc", http.StatusUnauthorized},
+		{"private", Private, token(), http.StatusOK},
+		{"admin as user", Admin, token("users"), http.StatusForbidden},
+		{"admin", Admin, token("admin"), http.StatusOK},
+		{"optional invalid token", Optional, "Bearer abc", http.StatusUnauthorized},
+		{"optional", Optional, token(), http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodDelete, "/v1/admin/media/123", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			handler(tt.access).ServeHTTP(w, r)
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+
+	// Anonymous callers of optional routes hold no identity
+	w := httptest.NewRecorder()
+	a.Middleware(Optional)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, ok := IdentityFromContext(r.Context())
+		assert.False(t, ok)
+	})).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/media/123", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	interceptor := a.UnaryServerInterceptor(Policy{"/pb.Media/Get": Public, "/pb.Media/List": Optional,
+		"/pb.Media/HardDelete": Admin})
+	call := func(method, authorization string) error {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+		return err
+	}
+
+	assert.Nil(t, call("/pb.Media/Get", ""))
+	assert.Nil(t, call("/pb.Media/List", ""))
+	assert.Nil(t, call("/pb.Media/List", token()))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("/pb.Media/List", "Bearer abc")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("/pb.Media/Create", "")))
+	assert.Nil(t, call("/pb.Media/Create", token()))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("/pb.Media/HardDelete", token())))
+	assert.Nil(t, call("/pb.Media/HardDelete", token("admin")))
+}
+
+func TestAuthenticator_StreamServerInterceptor(t *testing.T) {
+	key := newTestKey(t)
+	a := &Authenticator{
+		verifier:   NewVerifier(staticKeySet{kid: "kid-1", key: &key.PublicKey}, "", ""),
+		adminGroup: "admin",
+		logger:     log.NewNopLogger(),
+	}
+	token := func(groups ...string) string {
+		return "Bearer " + signTestToken(t, key, "RS256", "kid-1", map[string]interface{}{
+			"sub":            "user-1",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"cognito:groups": groups,
+		})
+	}
+
+	interceptor := a.StreamServerInterceptor(Policy{"/pb.Media/Get": Public, "/pb.Media/List": Optional,
+		"/pb.Media/HardDelete": Admin})
+	call := func(method, authorization string) error {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+		return interceptor(nil, testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method},
+			func(srv interface{}, stream grpc.ServerStream) error {
+				if authorization != "" {
+					identity, ok := IdentityFromContext(stream.Context())
+					assert.True(t, ok)
+					assert.Equal(t, "user-1", identity.Subject)
+				}
+				return nil
+			})
+	}
+
+	assert.Nil(t, call("/pb.Media/Get", ""))
+	assert.Nil(t, call("/pb.Media/List", ""))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("/pb.Media/List", "Bearer abc")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("/pb.Media/Create", "")))
+	assert.Nil(t, call("/pb.Media/Create", token()))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("/pb.Media/HardDelete", token())))
+	assert.Nil(t, call("/pb.Media/HardDelete", token("admin")))
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
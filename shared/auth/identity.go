@@ -0,0 +1,37 @@
+package auth
+
+import "context"
+
+// Identity authenticated caller, transports propagate it through the request context
+type Identity struct {
+	// Subject caller's unique identifier (JWT sub claim)
+	Subject  string
+	Username string
+	Groups   []string
+	// Admin caller belongs to the administrators group
+	Admin bool
+}
+
+type identityContextKey struct{}
+
+// InGroup returns true if the caller belongs to the given group
+func (i Identity) InGroup(group string) bool {
+	for _, g := range i.Groups {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithIdentity returns a copy of ctx holding the given identity
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the caller of the given context, false if the call was not authenticated
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(Identity)
+	return identity, ok
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrUnknownKey the token was signed by a key not found in the key set
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySet JWT signing public keys indexed by key id (kid)
+type KeySet interface {
+	Key(ctx context.Context, kid string) (*rsa.PublicKey, error)
+}
+
+// jwks JSON Web Key Set document, only RSA signing keys are used
+type jwks struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// parseJWKS returns the RSA signing keys of the given JWKS document
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	set := new(jwks)
+	if err := json.Unmarshal(data, set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus of key %s: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent of key %s: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("key set has no RSA signing keys")
+	}
+
+	return keys, nil
+}
+
+// JWKSKeySet remote key set (e.g. https://cognito-idp.<region>.amazonaws.com/<pool>/.well-known/jwks.json),
+// keys are cached and refreshed periodically or when an unknown key id is found to follow key rotation
+type JWKSKeySet struct {
+	url       string
+	client    *http.Client
+	refresh   time.Duration
+	keys      map[string]*rsa.PublicKey
+	fetchTime time.Time
+	mu        *sync.Mutex
+}
+
+// minRefresh minimum time between fetches triggered by unknown key ids
+const minRefresh = time.Minute
+
+func NewJWKSKeySet(url string, refresh time.Duration) *JWKSKeySet {
+	return &JWKSKeySet{
+		url:     url,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		refresh: refresh,
+		keys:    make(map[string]*rsa.PublicKey),
+		mu:      new(sync.Mutex),
+	}
+}
+
+func (s *JWKSKeySet) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[kid]
+	age := time.Since(s.fetchTime)
+	if age > s.refresh || (!ok && age > minRefresh) {
+		keys, err := s.fetch(ctx)
+		if err != nil {
+			// Cached keys are still valid while the provider is not available
+			if ok {
+				return key, nil
+			}
+			return nil, err
+		}
+
+		s.keys, s.fetchTime = keys, time.Now()
+		key, ok = s.keys[kid]
+	}
+
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+func (s *JWKSKeySet) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch key set, status %d", res.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseJWKS(data)
+}
+
+// FileKeySet local key set read from either a JWKS document or a PEM encoded RSA public key,
+// a PEM key verifies tokens of any key id. Useful for testing and local environments
+type FileKeySet struct {
+	keys map[string]*rsa.PublicKey
+	any  *rsa.PublicKey
+}
+
+func NewFileKeySet(path string) (*FileKeySet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if block, _ := pem.Decode(data); block != nil {
+		key, err := parsePEMKey(block)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FileKeySet{any: key}, nil
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileKeySet{keys: keys}, nil
+}
+
+func (s *FileKeySet) Key(_ context.Context, kid string) (*rsa.PublicKey, error) {
+	if s.any != nil {
+		return s.any, nil
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+func parsePEMKey(block *pem.Block) (*rsa.PublicKey, error) {
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("key file is not an RSA public key")
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	// Register hash functions used by RS256, RS384 and RS512
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+var (
+	// ErrUnauthenticated the caller did not send a valid token
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied the caller is not allowed to perform the operation
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// leeway tolerated clock skew between the token issuer and this service
+const leeway = time.Minute
+
+var algorithms = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+}
+
+// Verifier validates RSA signed JWTs (e.g. AWS Cognito ID and access tokens)
+type Verifier struct {
+	keys     KeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+// NewVerifier returns a JWT verifier, issuer and audience are not checked if empty
+func NewVerifier(keys KeySet, issuer, audience string) *Verifier {
+	return &Verifier{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		now:      time.Now,
+	}
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type claims struct {
+	Subject         string          `json:"sub"`
+	Issuer          string          `json:"iss"`
+	Audience        json.RawMessage `json:"aud"`
+	ClientID        string          `json:"client_id"`
+	ExpiresAt       *float64        `json:"exp"`
+	NotBefore       *float64        `json:"nbf"`
+	Username        string          `json:"username"`
+	CognitoUsername string          `json:"cognito:username"`
+	Groups          []string        `json:"groups"`
+	CognitoGroups   []string        `json:"cognito:groups"`
+}
+
+// Verify returns the identity of the given token if its signature and claims are valid
+func (v *Verifier) Verify(ctx context.Context, token string) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "malformed token")
+	}
+
+	h := new(header)
+	if err := decodeSegment(parts[0], h); err != nil {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "malformed token header")
+	}
+	// Symmetric and unsigned tokens are never accepted
+	hash, ok := algorithms[h.Alg]
+	if !ok {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "unsupported signing algorithm")
+	}
+
+	key, err := v.keys.Key(ctx, h.Kid)
+	if err != nil {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "unknown signing key")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "malformed token signature")
+	}
+	digest := hash.New()
+	_, _ = digest.Write([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(key, hash, digest.Sum(nil), signature); err != nil {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "invalid token signature")
+	}
+
+	c := new(claims)
+	if err = decodeSegment(parts[1], c); err != nil {
+		return nil, exception.NewErrorDescription(ErrUnauthenticated, "malformed token claims")
+	}
+	if err = v.validate(c); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject:  c.Subject,
+		Username: c.CognitoUsername,
+		Groups:   c.CognitoGroups,
+	}
+	if identity.Username == "" {
+		identity.Username = c.Username
+	}
+	if len(identity.Groups) == 0 {
+		identity.Groups = c.Groups
+	}
+
+	return identity, nil
+}
+
+func (v *Verifier) validate(c *claims) error {
+	now := v.now()
+	if c.Subject == "" {
+		return exception.NewErrorDescription(ErrUnauthenticated, "token has no subject")
+	}
+	if c.ExpiresAt == nil || now.After(unixTime(*c.ExpiresAt).Add(leeway)) {
+		return exception.NewErrorDescription(ErrUnauthenticated, "token expired")
+	}
+	if c.NotBefore != nil && now.Add(leeway).Before(unixTime(*c.NotBefore)) {
+		return exception.NewErrorDescription(ErrUnauthenticated, "token not valid yet")
+	}
+	if v.issuer != "" && c.Issuer != v.issuer {
+		return exception.NewErrorDescription(ErrUnauthenticated, "invalid token issuer")
+	}
+	// ID tokens hold the client in aud, access tokens in client_id
+	if v.audience != "" && c.ClientID != v.audience && !hasAudience(c.Audience, v.audience) {
+		return exception.NewErrorDescription(ErrUnauthenticated, "invalid token audience")
+	}
+
+	return nil
+}
+
+// hasAudience returns true if the aud claim (string or array) holds the given audience
+func hasAudience(raw json.RawMessage, audience string) bool {
+	if len(raw) == 0 {
+		return false
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single == audience
+	}
+
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		for _, a := range many {
+			if a == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(int64(seconds), 0)
+}
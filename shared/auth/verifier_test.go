@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// staticKeySet test key set holding a single key
+type staticKeySet struct {
+	kid string
+	key *rsa.PublicKey
+}
+
+func (s staticKeySet) Key(_ context.Context, kid string) (*rsa.PublicKey, error) {
+	if kid != s.kid {
+		return nil, ErrUnknownKey
+	}
+
+	return s.key, nil
+}
+
+func newTestKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	return key
+}
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, alg, kid string, c map[string]interface{}) string {
+	h, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	assert.Nil(t, err)
+	p, err := json.Marshal(c)
+	assert.Nil(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+	digest := crypto.SHA256.New()
+	_, _ = digest.Write([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest.Sum(nil))
+	assert.Nil(t, err)
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	key, otherKey := newTestKey(t), newTestKey(t)
+	v := NewVerifier(staticKeySet{kid: "kid-1", key: &key.PublicKey}, "https://issuer", "client-1")
+	now := time.Now()
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub":              "user-1",
+			"iss":              "https://issuer",
+			"client_id":        "client-1",
+			"exp":              now.Add(time.Hour).Unix(),
+			"cognito:username": "aruiz",
+			"cognito:groups":   []string{"admin"},
+		}
+	}
+
+	identity, err := v.Verify(context.Background(), signTestToken(t, key, "RS256", "kid-1", valid()))
+	assert.Nil(t, err)
+	assert.Equal(t, "user-1", identity.Subject)
+	assert.Equal(t, "aruiz", identity.Username)
+	assert.True(t, identity.InGroup("admin"))
+
+	// ID tokens hold the client in aud
+	c := valid()
+	delete(c, "client_id")
+	c["aud"] = "client-1"
+	_, err = v.Verify(context.Background(), signTestToken(t, key, "RS256", "kid-1", c))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"malformed", "abc.def"},
+		{"unsigned", signTestToken(t, key, "none", "kid-1", valid())},
+		{"unknown key", signTestToken(t, key, "RS256", "kid-2", valid())},
+		{"invalid signature", signTestToken(t, otherKey, "RS256", "kid-1", valid())},
+		{"expired", func() string {
+			c := valid()
+			c["exp"] = now.Add(-time.Hour).Unix()
+			return signTestToken(t, key, "RS256", "kid-1", c)
+		}()},
+		{"issuer", func() string {
+			c := valid()
+			c["iss"] = "https://attacker"
+			return signTestToken(t, key, "RS256", "kid-1", c)
+		}()},
+		{"audience", func() string {
+			c := valid()
+			c["client_id"] = "client-2"
+			return signTestToken(t, key, "RS256", "kid-1", c)
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.Verify(context.Background(), tt.token)
+			assert.True(t, errors.Is(err, ErrUnauthenticated))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+module github.com/maestre3d/alexandria/shared
+
+go 1.13
+
+require (
+	github.com/alexandria-oss/core v0.5.4-beta
+	github.com/go-kit/kit v0.10.0
+	github.com/go-redis/redis/v7 v7.2.0
+	github.com/gorilla/mux v1.7.3
+	github.com/prometheus/client_golang v1.3.0
+	github.com/spf13/viper v1.6.3
+	github.com/stretchr/testify v1.5.1
+	gocloud.dev v0.19.0
+	google.golang.org/grpc v1.27.1
+)
@@ -0,0 +1,194 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/spf13/viper"
+	"gocloud.dev/pubsub"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeadLetterSuffix suffix appended to the original topic to route poison messages
+const DeadLetterSuffix = "_DLQ"
+
+// Dead-letter message headers, original metadata is kept
+const (
+	DeadLetterTopic      = "dlq_topic"
+	DeadLetterError      = "dlq_error"
+	DeadLetterAttempts   = "dlq_attempts"
+	DeadLetterService    = "dlq_service"
+	DeadLetterFailedTime = "dlq_failed_time"
+)
+
+type deadLetterContextKey struct{}
+
+// deadLetterBinding listener information required to route poison messages, bound per message
+type deadLetterBinding struct {
+	service      string
+	topic        string
+	subscription *pubsub.Subscription
+	attempts     int
+}
+
+func init() {
+	viper.SetDefault("alexandria.eventbus.dlq.attempts", 3)
+	viper.SetDefault("alexandria.eventbus.dlq.backoff", "500ms")
+}
+
+// DeadLetter retries failing handlers and routes poison messages to its <TOPIC>_DLQ topic
+// after the configured attempts
+type DeadLetter struct {
+	service  string
+	attempts int
+	backoff  time.Duration
+	logger   log.Logger
+	open     func(ctx context.Context, topic string) (*pubsub.Topic, error)
+}
+
+// NewDeadLetter returns the dead-letter router of the given service (e.g. media)
+func NewDeadLetter(service string, logger log.Logger) *DeadLetter {
+	attempts := viper.GetInt("alexandria.eventbus.dlq.attempts")
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return &DeadLetter{
+		service:  service,
+		attempts: attempts,
+		backoff:  viper.GetDuration("alexandria.eventbus.dlq.backoff"),
+		logger:   logger,
+		open:     eventbus.NewKafkaProducer,
+	}
+}
+
+// Bind sets the subscription, consumer group and topic the handler is listening to, required to route poison messages
+func (d *DeadLetter) Bind(sub *pubsub.Subscription, service, topic string, h eventbus.HandlerFunc) eventbus.HandlerFunc {
+	service, topic = strings.ToUpper(service), strings.ToUpper(topic)
+	return func(r *eventbus.Request) {
+		r.Context = context.WithValue(r.Context, deadLetterContextKey{}, &deadLetterBinding{
+			service:      service,
+			topic:        topic,
+			subscription: sub,
+		})
+		h(r)
+	}
+}
+
+// BoundTopic returns the topic the running handler is listening to, empty if the handler is not bound
+func BoundTopic(ctx context.Context) string {
+	if b, ok := ctx.Value(deadLetterContextKey{}).(*deadLetterBinding); ok {
+		return b.topic
+	}
+
+	return ""
+}
+
+// Execute calls the given function until it succeeds, returns a non-internal error or attempts are exhausted,
+// calls are counted by the message bound to the given context
+func (d *DeadLetter) Execute(ctx context.Context, fn func() error) (err error) {
+	b, _ := ctx.Value(deadLetterContextKey{}).(*deadLetterBinding)
+	backoff := d.backoff
+	for i := 0; i < d.attempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+				backoff *= 2
+			}
+		}
+
+		if b != nil {
+			b.attempts++
+		}
+		err = fn()
+		if err == nil || httputil.ErrorToCode(err) != 500 {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Route publishes the given message into the dead-letter topic with the original metadata and error headers,
+// then acknowledges it. If the message could not be routed it is left unacknowledged to be redelivered
+func (d *DeadLetter) Route(r *eventbus.Request, errH error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	b, ok := r.Context.Value(deadLetterContextKey{}).(*deadLetterBinding)
+	if !ok || b.topic == "" {
+		err := errors.New("message topic is not bound")
+		d.reject(ctx, r, b, err)
+		return err
+	}
+
+	metadata, err := d.publish(ctx, r, b, errH)
+	if err != nil {
+		d.reject(ctx, r, b, err)
+		return err
+	}
+
+	r.Message.Ack()
+	_ = level.Warn(d.logger).Log("method", d.service+".transport.event.dead_letter", "msg", "message routed to "+b.topic+DeadLetterSuffix,
+		"event_id", metadata["event_id"], "root_id", metadata["root_id"], "attempts", metadata[DeadLetterAttempts])
+	return nil
+}
+
+func (d *DeadLetter) publish(ctx context.Context, r *eventbus.Request, b *deadLetterBinding, errH error) (map[string]string, error) {
+	p, err := d.open(ctx, b.topic+DeadLetterSuffix)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Shutdown(ctx)
+
+	// Replayed messages keep their previous attempts
+	attempts, _ := strconv.Atoi(r.Message.Metadata[DeadLetterAttempts])
+	attempts += b.attempts
+
+	metadata := make(map[string]string, len(r.Message.Metadata)+5)
+	for k, v := range r.Message.Metadata {
+		metadata[k] = v
+	}
+	metadata[DeadLetterTopic] = b.topic
+	metadata[DeadLetterError] = errH.Error()
+	metadata[DeadLetterAttempts] = strconv.Itoa(attempts)
+	metadata[DeadLetterService] = b.service
+	metadata[DeadLetterFailedTime] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	err = p.Send(ctx, &pubsub.Message{
+		Body:       r.Message.Body,
+		Metadata:   metadata,
+		BeforeSend: nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// reject leaves the given message unacknowledged. Brokers unable to redeliver a single message (e.g. Kafka) commit
+// offsets of later acknowledgements, so the subscription is stopped and the message is redelivered once the
+// service restarts
+func (d *DeadLetter) reject(ctx context.Context, r *eventbus.Request, b *deadLetterBinding, err error) {
+	_ = level.Error(d.logger).Log("method", d.service+".transport.event.dead_letter", "err", err.Error(),
+		"event_id", r.Message.Metadata["event_id"], "root_id", r.Message.Metadata["root_id"])
+	if r.Message.Nackable() {
+		r.Message.Nack()
+		return
+	} else if b == nil || b.subscription == nil {
+		return
+	}
+
+	_ = level.Error(d.logger).Log("method", d.service+".transport.event.dead_letter", "msg", "stopping subscription of "+b.topic)
+	if errS := b.subscription.Shutdown(ctx); errS != nil {
+		_ = level.Error(d.logger).Log("method", d.service+".transport.event.dead_letter", "err", errS.Error())
+	}
+}
@@ -0,0 +1,125 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/mempubsub"
+)
+
+// newTestDeadLetter returns a router publishing into the given in-memory topic
+func newTestDeadLetter(topic *pubsub.Topic) *DeadLetter {
+	d := NewDeadLetter("test", log.NewNopLogger())
+	d.backoff = time.Millisecond
+	d.open = func(ctx context.Context, _ string) (*pubsub.Topic, error) {
+		if topic == nil {
+			return nil, errors.New("broker not available")
+		}
+
+		return topic, nil
+	}
+	return d
+}
+
+// handle calls the given handler bound to the given subscription with its next message
+func handle(t *testing.T, d *DeadLetter, sub *pubsub.Subscription, h eventbus.HandlerFunc) *eventbus.Request {
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	d.Bind(sub, "test", "test_topic", h)(r)
+	return r
+}
+
+func TestDeadLetter_Execute(t *testing.T) {
+	d := newTestDeadLetter(nil)
+	sub, cleanup := newTestSubscription(t, 1)
+	defer cleanup()
+
+	calls := 0
+	r := handle(t, d, sub, func(r *eventbus.Request) {
+		err := d.Execute(r.Context, func() error {
+			calls++
+			if calls == 2 {
+				return exception.EntityNotFound
+			}
+			return errors.New("service unavailable")
+		})
+		assert.Equal(t, exception.EntityNotFound, err)
+		r.Message.Ack()
+	})
+
+	// Non-internal errors are never retried
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, "TEST_TOPIC", BoundTopic(r.Context))
+	assert.Equal(t, "", BoundTopic(context.Background()))
+}
+
+func TestDeadLetter_Route(t *testing.T) {
+	ctx := context.Background()
+	dlq := mempubsub.NewTopic()
+	defer dlq.Shutdown(ctx)
+	dlqSub := mempubsub.NewSubscription(dlq, time.Minute)
+	defer dlqSub.Shutdown(ctx)
+
+	d := newTestDeadLetter(dlq)
+	sub, cleanup := newTestSubscription(t, 1)
+	defer cleanup()
+
+	handle(t, d, sub, func(r *eventbus.Request) {
+		r.Message.Metadata[DeadLetterAttempts] = "3"
+		errH := d.Execute(r.Context, func() error {
+			return errors.New("service unavailable")
+		})
+		assert.Nil(t, d.Route(r, errH))
+	})
+
+	m := receive(t, dlqSub)
+	assert.NotNil(t, m)
+	m.Message.Ack()
+	assert.Equal(t, []byte("alexandria"), m.Message.Body)
+	assert.Equal(t, "event", m.Message.Metadata["event_id"])
+	assert.Equal(t, "TEST_TOPIC", m.Message.Metadata[DeadLetterTopic])
+	assert.Equal(t, "TEST", m.Message.Metadata[DeadLetterService])
+	assert.Equal(t, "service unavailable", m.Message.Metadata[DeadLetterError])
+	assert.NotEmpty(t, m.Message.Metadata[DeadLetterFailedTime])
+	// Replayed messages accumulate attempts
+	assert.Equal(t, "6", m.Message.Metadata[DeadLetterAttempts])
+
+	// Routed message was acknowledged
+	assert.Nil(t, receive(t, sub))
+}
+
+func TestDeadLetter_RouteFailed(t *testing.T) {
+	d := newTestDeadLetter(nil)
+	sub, cleanup := newTestSubscription(t, 1)
+	defer cleanup()
+
+	handle(t, d, sub, func(r *eventbus.Request) {
+		assert.NotNil(t, d.Route(r, errors.New("service unavailable")))
+	})
+
+	// Message was not acknowledged, it must be redelivered
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	r.Message.Ack()
+}
+
+func TestDeadLetter_RouteUnbound(t *testing.T) {
+	d := newTestDeadLetter(mempubsub.NewTopic())
+	sub, cleanup := newTestSubscription(t, 1)
+	defer cleanup()
+
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	assert.NotNil(t, d.Route(r, errors.New("service unavailable")))
+
+	r = receive(t, sub)
+	assert.NotNil(t, r)
+	r.Message.Ack()
+}
@@ -0,0 +1,104 @@
+package messaging
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func init() {
+	viper.SetDefault("alexandria.eventbus.idempotency.wait", "500ms")
+}
+
+// Guard turns event consumers idempotent, redelivered messages are acknowledged
+// without being applied again
+type Guard struct {
+	store      Store
+	service    string
+	duplicates metrics.Counter
+	logger     log.Logger
+	wait       time.Duration
+}
+
+// NewGuard returns the message guard of the given service (e.g. media)
+func NewGuard(store Store, service string, logger log.Logger) *Guard {
+	return &Guard{
+		store:   store,
+		service: service,
+		duplicates: kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace:   "alexandria",
+			Subsystem:   service + "_service",
+			Name:        "duplicate_message_count",
+			Help:        "number of duplicated messages received",
+			ConstLabels: nil,
+		}, []string{"handler"}),
+		logger: logger,
+		wait:   viper.GetDuration("alexandria.eventbus.idempotency.wait"),
+	}
+}
+
+// Acquire returns false if the given message must not be applied, duplicated messages are acknowledged.
+// Messages being applied by another handler are awaited until they are completed, released or their lock expires
+func (g *Guard) Acquire(r *eventbus.Request, ec *eventbus.EventContext, handler string) bool {
+	if ec.Event.ID == "" {
+		return true
+	}
+
+	for {
+		state, err := g.store.Acquire(r.Context, ec.Event.ID, ec.Transaction.ID)
+		if err != nil {
+			// Store not available, prefer at-least-once delivery
+			_ = level.Warn(g.logger).Log("method", g.service+".transport.event.acquire", "err", err.Error())
+			return true
+		}
+
+		switch state {
+		case MessageAcquired:
+			return true
+		case MessageProcessed:
+			g.duplicates.With("handler", handler).Add(1)
+			_ = level.Info(g.logger).Log("method", g.service+".transport.event.acquire", "msg", "duplicated message acknowledged",
+				"event_id", ec.Event.ID, "transaction_id", ec.Transaction.ID, "handler", handler)
+			r.Message.Ack()
+			return false
+		}
+
+		select {
+		case <-r.Context.Done():
+			// Leave message to be redelivered
+			if r.Message.Nackable() {
+				r.Message.Nack()
+			}
+			return false
+		case <-time.After(g.wait):
+		}
+	}
+}
+
+// Ack marks the given message as processed and acknowledges it, must be called only after the message was applied
+func (g *Guard) Ack(r *eventbus.Request, ec *eventbus.EventContext) {
+	if ec.Event.ID != "" {
+		if err := g.store.Complete(r.Context, ec.Event.ID, ec.Transaction.ID); err != nil {
+			_ = level.Warn(g.logger).Log("method", g.service+".transport.event.ack", "err", err.Error())
+		}
+	}
+
+	r.Message.Ack()
+}
+
+// Release allows the given message to be applied again, must be called if the message could not be applied
+func (g *Guard) Release(ctx context.Context, ec *eventbus.EventContext) {
+	if ec.Event.ID == "" {
+		return
+	}
+
+	if err := g.store.Release(ctx, ec.Event.ID, ec.Transaction.ID); err != nil {
+		_ = level.Warn(g.logger).Log("method", g.service+".transport.event.release", "err", err.Error())
+	}
+}
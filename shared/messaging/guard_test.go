@@ -0,0 +1,202 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/mempubsub"
+)
+
+// memStore in-memory processed-message store, locks never expire
+type memStore struct {
+	mtx      sync.Mutex
+	messages map[string]MessageState
+}
+
+func newMemStore() *memStore {
+	return &memStore{messages: map[string]MessageState{}}
+}
+
+func (s *memStore) Acquire(_ context.Context, eventID, transactionID string) (MessageState, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	key := processedMessageKey(eventID, transactionID)
+	if state, ok := s.messages[key]; ok {
+		return state, nil
+	}
+
+	s.messages[key] = MessageInProgress
+	return MessageAcquired, nil
+}
+
+func (s *memStore) Complete(_ context.Context, eventID, transactionID string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.messages[processedMessageKey(eventID, transactionID)] = MessageProcessed
+	return nil
+}
+
+func (s *memStore) Release(_ context.Context, eventID, transactionID string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	delete(s.messages, processedMessageKey(eventID, transactionID))
+	return nil
+}
+
+// newTestSubscription returns an in-memory subscription holding the given copies of the same message,
+// a copy stands for a message published twice or redelivered while in progress. Unacknowledged messages are
+// redelivered after a short deadline
+func newTestSubscription(t *testing.T, copies int) (*pubsub.Subscription, func()) {
+	ctx := context.Background()
+	topic := mempubsub.NewTopic()
+	sub := mempubsub.NewSubscription(topic, 50*time.Millisecond)
+	cleanup := func() {
+		_ = sub.Shutdown(ctx)
+		_ = topic.Shutdown(ctx)
+	}
+
+	for i := 0; i < copies; i++ {
+		err := topic.Send(ctx, &pubsub.Message{
+			Body: []byte("alexandria"),
+			Metadata: map[string]string{
+				"event_id":       "event",
+				"transaction_id": "transaction",
+			},
+		})
+		assert.Nil(t, err)
+	}
+	return sub, cleanup
+}
+
+// receive returns the next delivered message, nil if no message was delivered in time
+func receive(t *testing.T, sub *pubsub.Subscription) *eventbus.Request {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	m, err := sub.Receive(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return &eventbus.Request{Context: context.Background(), Message: m}
+}
+
+// testGuard metrics are registered once per process
+var testGuard = NewGuard(nil, "test", log.NewNopLogger())
+
+func newTestGuard(store Store) *Guard {
+	g := *testGuard
+	g.store = store
+	g.wait = 10 * time.Millisecond
+	return &g
+}
+
+func newTestEventContext(r *eventbus.Request) *eventbus.EventContext {
+	return &eventbus.EventContext{
+		Transaction: &eventbus.Transaction{ID: r.Message.Metadata["transaction_id"]},
+		Event:       &eventbus.Event{ID: r.Message.Metadata["event_id"]},
+	}
+}
+
+func TestGuard_Duplicate(t *testing.T) {
+	g := newTestGuard(newMemStore())
+	// Producers might publish the same event twice
+	sub, cleanup := newTestSubscription(t, 2)
+	defer cleanup()
+
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	ec := newTestEventContext(r)
+	assert.True(t, g.Acquire(r, ec, "on_test"))
+	g.Ack(r, ec)
+
+	dup := receive(t, sub)
+	assert.NotNil(t, dup)
+	assert.False(t, g.Acquire(dup, ec, "on_test"))
+	assert.Nil(t, receive(t, sub))
+}
+
+func TestGuard_Failure(t *testing.T) {
+	g := newTestGuard(newMemStore())
+	sub, cleanup := newTestSubscription(t, 1)
+	defer cleanup()
+
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	ec := newTestEventContext(r)
+	assert.True(t, g.Acquire(r, ec, "on_test"))
+
+	// Handler failed, message must be applied again once redelivered
+	g.Release(r.Context, ec)
+	redelivered := receive(t, sub)
+	assert.NotNil(t, redelivered)
+	assert.True(t, g.Acquire(redelivered, ec, "on_test"))
+	g.Ack(redelivered, ec)
+	assert.Nil(t, receive(t, sub))
+}
+
+func TestGuard_Redelivery(t *testing.T) {
+	g := newTestGuard(newMemStore())
+	sub, cleanup := newTestSubscription(t, 2)
+	defer cleanup()
+
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	ec := newTestEventContext(r)
+	assert.True(t, g.Acquire(r, ec, "on_test"))
+
+	// Message is redelivered while still being applied, redelivered copy waits for the first one
+	redelivered := receive(t, sub)
+	assert.NotNil(t, redelivered)
+	acquired := make(chan bool)
+	go func() {
+		acquired <- g.Acquire(redelivered, ec, "on_test")
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("message in progress must not be acquired")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	g.Ack(r, ec)
+	assert.False(t, <-acquired)
+	assert.Nil(t, receive(t, sub))
+}
+
+func TestGuard_RedeliveryCanceled(t *testing.T) {
+	store := newMemStore()
+	g := newTestGuard(store)
+	sub, cleanup := newTestSubscription(t, 2)
+	defer cleanup()
+
+	r := receive(t, sub)
+	assert.NotNil(t, r)
+	ec := newTestEventContext(r)
+	assert.True(t, g.Acquire(r, ec, "on_test"))
+
+	redelivered := receive(t, sub)
+	assert.NotNil(t, redelivered)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	redelivered.Context = ctx
+	assert.False(t, g.Acquire(redelivered, ec, "on_test"))
+
+	// First copy is still in progress, nothing was marked as processed
+	state, _ := store.Acquire(context.Background(), ec.Event.ID, ec.Transaction.ID)
+	assert.Equal(t, MessageInProgress, state)
+}
+
+func TestProcessedMessageKey(t *testing.T) {
+	assert.Equal(t, "processed_message:event", processedMessageKey("event", ""))
+	assert.Equal(t, "processed_message:transaction:event", processedMessageKey("event", "transaction"))
+}
@@ -0,0 +1,104 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v7"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func init() {
+	viper.SetDefault("alexandria.eventbus.idempotency.ttl", "72h")
+	viper.SetDefault("alexandria.eventbus.idempotency.lock_ttl", "1m")
+}
+
+// MessageState processing state of a broker message
+type MessageState int
+
+const (
+	// MessageAcquired the message was not processed, caller must apply it
+	MessageAcquired MessageState = iota
+	// MessageInProgress the message is being applied by another handler
+	MessageInProgress
+	// MessageProcessed the message was already applied
+	MessageProcessed
+)
+
+// inProgressMessage value of messages being applied, any other value means processed
+const inProgressMessage = "in_progress"
+
+// Store keeps track of processed broker messages, used by event consumers to apply
+// a message (event_id/transaction_id pair) exactly once
+type Store interface {
+	// Acquire marks the given message as in progress for a short time if it was neither processed nor in progress
+	Acquire(ctx context.Context, eventID, transactionID string) (MessageState, error)
+	// Complete marks the given message as processed, must be called once the message was applied
+	Complete(ctx context.Context, eventID, transactionID string) error
+	// Release removes any mark, the message will be applied again if redelivered
+	Release(ctx context.Context, eventID, transactionID string) error
+}
+
+// RedisStore processed-message store, in-progress keys expire after the lock TTL and processed keys
+// after the configured TTL
+type RedisStore struct {
+	client  *redis.Client
+	ttl     time.Duration
+	lockTTL time.Duration
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{
+		client:  client,
+		ttl:     viper.GetDuration("alexandria.eventbus.idempotency.ttl"),
+		lockTTL: viper.GetDuration("alexandria.eventbus.idempotency.lock_ttl"),
+	}
+}
+
+func (s *RedisStore) Acquire(ctx context.Context, eventID, transactionID string) (MessageState, error) {
+	if s.client == nil {
+		return MessageAcquired, fmt.Errorf("redis client is not available")
+	}
+
+	key := processedMessageKey(eventID, transactionID)
+	ok, err := s.client.WithContext(ctx).SetNX(key, inProgressMessage, s.lockTTL).Result()
+	if err != nil {
+		return MessageAcquired, err
+	} else if ok {
+		return MessageAcquired, nil
+	}
+
+	value, err := s.client.WithContext(ctx).Get(key).Result()
+	if err == redis.Nil || value == inProgressMessage {
+		// Key might have expired or been released in between, caller must try again
+		return MessageInProgress, nil
+	} else if err != nil {
+		return MessageAcquired, err
+	}
+
+	return MessageProcessed, nil
+}
+
+func (s *RedisStore) Complete(ctx context.Context, eventID, transactionID string) error {
+	if s.client == nil {
+		return fmt.Errorf("redis client is not available")
+	}
+
+	return s.client.WithContext(ctx).Set(processedMessageKey(eventID, transactionID), time.Now().Unix(), s.ttl).Err()
+}
+
+func (s *RedisStore) Release(ctx context.Context, eventID, transactionID string) error {
+	if s.client == nil {
+		return fmt.Errorf("redis client is not available")
+	}
+
+	return s.client.WithContext(ctx).Del(processedMessageKey(eventID, transactionID)).Err()
+}
+
+func processedMessageKey(eventID, transactionID string) string {
+	if transactionID == "" {
+		return fmt.Sprintf("processed_message:%s", eventID)
+	}
+
+	return fmt.Sprintf("processed_message:%s:%s", transactionID, eventID)
+}
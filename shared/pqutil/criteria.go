@@ -0,0 +1,265 @@
+package pqutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Operator SQL comparison operator
+type Operator string
+
+// Direction SQL ordering direction
+type Direction string
+
+const (
+	Equal          Operator = "="
+	NotEqual       Operator = "<>"
+	Greater        Operator = ">"
+	GreaterOrEqual Operator = ">="
+	Less           Operator = "<"
+	LessOrEqual    Operator = "<="
+	Like           Operator = "LIKE"
+)
+
+const (
+	Ascending  Direction = "ASC"
+	Descending Direction = "DESC"
+)
+
+// likeEscaper escapes LIKE wildcards from user-supplied values
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ParseDirection returns the given direction if valid, def otherwise
+func ParseDirection(direction string, def Direction) Direction {
+	switch Direction(strings.ToUpper(direction)) {
+	case Ascending:
+		return Ascending
+	case Descending:
+		return Descending
+	default:
+		return def
+	}
+}
+
+// Expression SQL boolean expression, values are always bound as placeholders
+//
+// Field and table names are taken as they are, they must never come from user input
+type Expression interface {
+	// build returns the SQL text of the expression and the given args with the expression values appended
+	build(args []interface{}) (string, []interface{})
+}
+
+type comparison struct {
+	field string
+	op    Operator
+	value interface{}
+	fold  bool
+}
+
+func (c comparison) build(args []interface{}) (string, []interface{}) {
+	args = append(args, c.value)
+	if c.fold {
+		return fmt.Sprintf("LOWER(%s) %s LOWER(%s)", c.field, c.op, placeholder(len(args))), args
+	}
+
+	return fmt.Sprintf("%s %s %s", c.field, c.op, placeholder(len(args))), args
+}
+
+type contains struct {
+	value  string
+	fields []string
+}
+
+func (c contains) build(args []interface{}) (string, []interface{}) {
+	args = append(args, "%"+likeEscaper.Replace(c.value)+"%")
+	p := placeholder(len(args))
+
+	statements := make([]string, 0, len(c.fields))
+	for _, field := range c.fields {
+		statements = append(statements, fmt.Sprintf("LOWER(%s) LIKE LOWER(%s)", field, p))
+	}
+
+	return "(" + strings.Join(statements, " OR ") + ")", args
+}
+
+type seek struct {
+	fields []string
+	op     Operator
+	values []interface{}
+}
+
+func (s seek) build(args []interface{}) (string, []interface{}) {
+	placeholders := make([]string, 0, len(s.values))
+	for _, v := range s.values {
+		args = append(args, v)
+		placeholders = append(placeholders, placeholder(len(args)))
+	}
+
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(s.fields, ", "), s.op, strings.Join(placeholders, ", ")), args
+}
+
+type subquery struct {
+	field     string
+	statement string
+	value     interface{}
+}
+
+func (s subquery) build(args []interface{}) (string, []interface{}) {
+	args = append(args, s.value)
+	return fmt.Sprintf("%s IN (%s %s)", s.field, s.statement, placeholder(len(args))), args
+}
+
+type raw string
+
+func (r raw) build(args []interface{}) (string, []interface{}) {
+	return string(r), args
+}
+
+type group struct {
+	conjunction string
+	expressions []Expression
+}
+
+func (g group) build(args []interface{}) (string, []interface{}) {
+	statements := make([]string, 0, len(g.expressions))
+	for _, e := range g.expressions {
+		var statement string
+		statement, args = e.build(args)
+		statements = append(statements, statement)
+	}
+
+	if len(statements) == 1 {
+		return statements[0], args
+	}
+
+	return "(" + strings.Join(statements, " "+g.conjunction+" ") + ")", args
+}
+
+// Compare returns an expression comparing the given field against a value
+func Compare(field string, op Operator, value interface{}) Expression {
+	return comparison{field: field, op: op, value: value}
+}
+
+// FoldCompare returns a case-insensitive expression comparing the given field against a value
+func FoldCompare(field string, op Operator, value string) Expression {
+	return comparison{field: field, op: op, value: value, fold: true}
+}
+
+// Contains returns a case-insensitive expression matching any of the given fields containing the value
+func Contains(value string, fields ...string) Expression {
+	return contains{value: value, fields: fields}
+}
+
+// Seek returns a row comparison expression between the given fields and values, used by keyset pagination
+// (e.g. (update_time, external_id) < ($1, $2))
+func Seek(fields []string, op Operator, values ...interface{}) Expression {
+	return seek{fields: fields, op: op, values: values}
+}
+
+// In returns an expression matching the given field against a single column subquery, the subquery statement must
+// end with the comparison the value is bound to (e.g. SELECT author_id FROM alexa1.owners WHERE owner_id =)
+func In(field, statement string, value interface{}) Expression {
+	return subquery{field: field, statement: statement, value: value}
+}
+
+// Raw returns a static SQL expression, it must never contain user input
+func Raw(statement string) Expression {
+	return raw(statement)
+}
+
+// And returns a group where every expression must match
+func And(expressions ...Expression) Expression {
+	return group{conjunction: "AND", expressions: expressions}
+}
+
+// Or returns a group where any expression must match
+func Or(expressions ...Expression) Expression {
+	return group{conjunction: "OR", expressions: expressions}
+}
+
+// Criteria PostgreSQL query builder, produces a placeholder statement with its args slice
+type Criteria struct {
+	statement   string
+	args        []interface{}
+	expressions []Expression
+	orders      []string
+	limit       int
+}
+
+// NewCriteria returns a criteria for the given base statement (e.g. SELECT * FROM table),
+// args are bound to the base statement placeholders ($1...$n), expressions placeholders start after them
+func NewCriteria(statement string, args ...interface{}) *Criteria {
+	return &Criteria{
+		statement:   statement,
+		args:        args,
+		expressions: make([]Expression, 0),
+		orders:      make([]string, 0),
+	}
+}
+
+// Where appends the given expressions, every criteria expression must match
+func (c *Criteria) Where(expressions ...Expression) *Criteria {
+	c.expressions = append(c.expressions, expressions...)
+	return c
+}
+
+// OrderBy appends an ordering key
+func (c *Criteria) OrderBy(field string, direction Direction) *Criteria {
+	c.orders = append(c.orders, field+" "+string(ParseDirection(string(direction), Ascending)))
+	return c
+}
+
+// Limit sets the maximum rows to fetch, zero means no limit
+func (c *Criteria) Limit(limit int) *Criteria {
+	c.limit = limit
+	return c
+}
+
+// Build returns the SQL statement and its args
+func (c *Criteria) Build() (string, []interface{}) {
+	statement := c.statement
+	args := make([]interface{}, 0, len(c.args))
+	args = append(args, c.args...)
+
+	if len(c.expressions) > 0 {
+		statements := make([]string, 0, len(c.expressions))
+		for _, e := range c.expressions {
+			var s string
+			s, args = e.build(args)
+			statements = append(statements, s)
+		}
+
+		statement += " WHERE " + strings.Join(statements, " AND ")
+	}
+
+	if len(c.orders) > 0 {
+		statement += " ORDER BY " + strings.Join(c.orders, ", ")
+	}
+
+	if c.limit > 0 {
+		statement += fmt.Sprintf(" FETCH FIRST %d ROWS ONLY", c.limit)
+	}
+
+	return statement, args
+}
+
+func placeholder(i int) string {
+	return "$" + strconv.Itoa(i)
+}
+
+// PrefixQuery returns a tsquery text matching every word of the given query by prefix (e.g. micro:* & serv:*),
+// tsquery operators from the query are dropped. Returns empty if the query has no words
+func PrefixQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		terms = append(terms, strings.ToLower(w)+":*")
+	}
+
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,64 @@
+package pqutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriteria_Build(t *testing.T) {
+	tests := []struct {
+		name      string
+		criteria  *Criteria
+		statement string
+		args      []interface{}
+	}{
+		{
+			name:      "empty",
+			criteria:  NewCriteria(`SELECT * FROM alexa1.media`),
+			statement: `SELECT * FROM alexa1.media`,
+			args:      []interface{}{},
+		},
+		{
+			name: "operators",
+			criteria: NewCriteria(`SELECT * FROM alexa1.media`).Where(Compare("total_views", Greater, 10),
+				Compare("total_views", Less, 20), Compare("media_type", NotEqual, "MEDIA_BOOK"),
+				FoldCompare("display_name", Equal, "Dune")),
+			statement: `SELECT * FROM alexa1.media WHERE total_views > $1 AND total_views < $2 AND media_type <> $3` +
+				` AND LOWER(display_name) = LOWER($4)`,
+			args: []interface{}{10, 20, "MEDIA_BOOK", "Dune"},
+		},
+		{
+			name: "groups",
+			criteria: NewCriteria(`SELECT * FROM alexa1.media`).Where(
+				Or(Compare("author_id", Equal, "1"), And(Compare("publisher_id", Equal, "2"), Compare("language_code", Equal, "es"))),
+				Or(Compare("active", Equal, true))),
+			statement: `SELECT * FROM alexa1.media WHERE (author_id = $1 OR (publisher_id = $2 AND language_code = $3))` +
+				` AND active = $4`,
+			args: []interface{}{"1", "2", "es", true},
+		},
+		{
+			name: "subquery",
+			criteria: NewCriteria(`SELECT * FROM alexa1.media`).Where(Compare("active", Equal, true),
+				In("external_id", `SELECT media_id FROM alexa1.media_authors WHERE author_id =`, "1")),
+			statement: `SELECT * FROM alexa1.media WHERE active = $1 AND external_id IN (SELECT media_id FROM alexa1.media_authors` +
+				` WHERE author_id = $2)`,
+			args: []interface{}{true, "1"},
+		},
+		{
+			name: "ordering",
+			criteria: NewCriteria(`SELECT * FROM alexa1.media`).OrderBy("total_views", Descending).
+				OrderBy("id", "").Limit(5),
+			statement: `SELECT * FROM alexa1.media ORDER BY total_views DESC, id ASC FETCH FIRST 5 ROWS ONLY`,
+			args:      []interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, args := tt.criteria.Build()
+			assert.Equal(t, tt.statement, statement)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
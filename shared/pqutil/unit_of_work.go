@@ -0,0 +1,93 @@
+package pqutil
+
+import (
+	"context"
+	"database/sql"
+)
+
+type txContextKey struct{}
+
+// transaction running transaction, committed functions are called once it gets committed
+type transaction struct {
+	*sql.Tx
+	committed []func()
+}
+
+// Executor common operations between a pool connection and a running transaction
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// OpenExecutor returns the running transaction from the given context if any,
+// otherwise a new connection from the pool is retrieved
+func OpenExecutor(ctx context.Context, db *sql.DB) (Executor, func(), error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*transaction); ok {
+		return tx, func() {}, nil
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return conn, func() {
+		_ = conn.Close()
+	}, nil
+}
+
+// InTransaction returns true if the given context holds a running transaction
+func InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txContextKey{}).(*transaction)
+	return ok
+}
+
+// AfterCommit calls fn once the running transaction gets committed, fn is called right away if there is
+// no running transaction and never if the transaction is rolled back
+func AfterCommit(ctx context.Context, fn func()) {
+	if tx, ok := ctx.Value(txContextKey{}).(*transaction); ok {
+		tx.committed = append(tx.committed, fn)
+		return
+	}
+
+	fn()
+}
+
+// UnitOfWork executes functions inside a single Postgres transaction, repositories join it through
+// OpenExecutor
+type UnitOfWork struct {
+	db *sql.DB
+}
+
+func NewUnitOfWork(db *sql.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested units of work join the running transaction
+	if InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	sqlTx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	tx := &transaction{Tx: sqlTx}
+	err = fn(context.WithValue(ctx, txContextKey{}, tx))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	for _, committed := range tx.committed {
+		committed()
+	}
+
+	return nil
+}
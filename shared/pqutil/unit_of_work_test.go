@@ -0,0 +1,22 @@
+package pqutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAfterCommit(t *testing.T) {
+	called := 0
+	AfterCommit(context.Background(), func() { called++ })
+	assert.Equal(t, 1, called)
+
+	// Functions are deferred until the running transaction gets committed
+	tx := new(transaction)
+	ctx := context.WithValue(context.Background(), txContextKey{}, tx)
+	assert.True(t, InTransaction(ctx))
+	AfterCommit(ctx, func() { called++ })
+	assert.Equal(t, 1, called)
+	assert.Len(t, tx.committed, 1)
+}
@@ -0,0 +1,50 @@
+// +build wireinject
+
+package dependency
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/google/wire"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/maestre3d/alexandria/trending-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/trending-service/internal/interactor"
+	"github.com/maestre3d/alexandria/shared/messaging"
+)
+
+var Ctx = context.Background()
+
+var dataSet = wire.NewSet(
+	provideContext,
+	config.NewKernel,
+	persistence.NewPostgresPool,
+	logger.NewZapLogger,
+	wire.Bind(new(domain.InteractionRepository), new(*infrastructure.InteractionPQRepository)),
+	infrastructure.NewInteractionPQRepository,
+)
+
+func provideContext() context.Context {
+	return Ctx
+}
+
+func InjectTrendingUseCase() (*interactor.Trending, func(), error) {
+	wire.Build(
+		dataSet,
+		interactor.NewTrending,
+	)
+	return &interactor.Trending{}, nil, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	wire.Build(
+		provideContext,
+		config.NewKernel,
+		persistence.NewRedisPool,
+		wire.Bind(new(messaging.Store), new(*messaging.RedisStore)),
+		messaging.NewRedisStore,
+	)
+
+	return &messaging.RedisStore{}, nil, nil
+}
@@ -0,0 +1,66 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate wire
+//+build !wireinject
+
+package dependency
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/persistence"
+	"github.com/google/wire"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/maestre3d/alexandria/trending-service/internal/infrastructure"
+	"github.com/maestre3d/alexandria/trending-service/internal/interactor"
+)
+
+// Injectors from wire.go:
+
+func InjectTrendingUseCase() (*interactor.Trending, func(), error) {
+	logLogger := logger.NewZapLogger()
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup, err := persistence.NewPostgresPool(context, kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	interactionPQRepository := infrastructure.NewInteractionPQRepository(db, logLogger)
+	trending := interactor.NewTrending(logLogger, interactionPQRepository)
+	return trending, func() {
+		cleanup()
+	}, nil
+}
+
+func InjectMessageStore() (messaging.Store, func(), error) {
+	context := provideContext()
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, cleanup, err := persistence.NewRedisPool(kernel)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisStore := messaging.NewRedisStore(client)
+	return redisStore, func() {
+		cleanup()
+	}, nil
+}
+
+// wire.go:
+
+var Ctx = context.Background()
+
+var dataSet = wire.NewSet(
+	provideContext, config.NewKernel, persistence.NewPostgresPool, logger.NewZapLogger, wire.Bind(new(domain.InteractionRepository), new(*infrastructure.InteractionPQRepository)), infrastructure.NewInteractionPQRepository,
+)
+
+func provideContext() context.Context {
+	return Ctx
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+// Interaction social interaction (SI) of a user with an aggregate (e.g. a view), received as event body
+type Interaction struct {
+	ID            string    `json:"id"`
+	AggregateType string    `json:"-"`
+	UserID        string    `json:"user_id,omitempty"`
+	Country       string    `json:"country,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// IsValid returns an error if the interaction cannot be counted, missing timestamps are set to now
+func (i *Interaction) IsValid() error {
+	if i.ID == "" {
+		return exception.NewErrorDescription(exception.RequiredField, fmt.Sprintf(exception.RequiredFieldString, "id"))
+	}
+
+	aggregateType, err := ParseAggregateType(i.AggregateType)
+	if err != nil {
+		return err
+	}
+	i.AggregateType = aggregateType
+	i.Country = strings.ToUpper(i.Country)
+
+	// Interactions from the future are counted as present ones
+	if now := time.Now().UTC(); i.Timestamp.IsZero() || i.Timestamp.After(now) {
+		i.Timestamp = now
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package domain
+
+// Consumed domain events
+const (
+	MediaViewed  = "MEDIA_VIEWED"
+	AuthorViewed = "AUTHOR_VIEWED"
+)
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+)
+
+// Supported trending aggregates
+const (
+	Media  = "media"
+	Author = "author"
+)
+
+// Windows supported trending windows, the current window is compared against the one right before it
+var Windows = map[string]time.Duration{
+	"1h":  time.Hour,
+	"6h":  6 * time.Hour,
+	"12h": 12 * time.Hour,
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+}
+
+// Trend trending score of an aggregate within a window
+type Trend struct {
+	AggregateID          string  `json:"aggregate_id"`
+	AggregateType        string  `json:"aggregate_type"`
+	Interactions         int64   `json:"interactions"`
+	PreviousInteractions int64   `json:"previous_interactions"`
+	FluctuationRatio     float64 `json:"fluctuation_ratio"`
+	ValuableRatio        float64 `json:"valuable_ratio"`
+	Score                float64 `json:"score"`
+}
+
+// Trending ranked trends of an aggregate type within a window
+type Trending struct {
+	AggregateType string    `json:"aggregate_type"`
+	Country       string    `json:"country,omitempty"`
+	Window        string    `json:"window"`
+	ComputeTime   time.Time `json:"compute_time"`
+	Trends        []*Trend  `json:"trends"`
+}
+
+// NewTrends returns the trends of the given interaction totals sorted by trending score, aggregates without
+// interactions in the current window are not trending
+func NewTrends(aggregateType string, current, previous map[string]int64) []*Trend {
+	// z = maximum SI score in the whole data set
+	var max int64
+	for _, total := range current {
+		if total > max {
+			max = total
+		}
+	}
+
+	trends := make([]*Trend, 0, len(current))
+	for id, total := range current {
+		if total <= 0 {
+			continue
+		}
+
+		trends = append(trends, &Trend{
+			AggregateID:          id,
+			AggregateType:        aggregateType,
+			Interactions:         total,
+			PreviousInteractions: previous[id],
+			FluctuationRatio:     FluctuationRatio(previous[id], total),
+			ValuableRatio:        ValuableRatio(total, max),
+			Score:                TrendingScore(previous[id], total, max),
+		})
+	}
+
+	sort.Slice(trends, func(i, j int) bool {
+		if trends[i].Score != trends[j].Score {
+			return trends[i].Score > trends[j].Score
+		} else if trends[i].Interactions != trends[j].Interactions {
+			return trends[i].Interactions > trends[j].Interactions
+		}
+
+		return trends[i].AggregateID < trends[j].AggregateID
+	})
+
+	return trends
+}
+
+// ParseAggregateType returns a supported aggregate type
+func ParseAggregateType(aggregateType string) (string, error) {
+	switch aggregateType = strings.ToLower(aggregateType); aggregateType {
+	case Media, Author:
+		return aggregateType, nil
+	default:
+		return "", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "type", "[media, author]"))
+	}
+}
+
+// ParseWindow returns the duration of a supported window
+func ParseWindow(window string) (time.Duration, error) {
+	if d, ok := Windows[strings.ToLower(window)]; ok {
+		return d, nil
+	}
+
+	return 0, exception.NewErrorDescription(exception.InvalidFieldFormat,
+		fmt.Sprintf(exception.InvalidFieldFormatString, "window", "[1h, 6h, 12h, 24h, 7d]"))
+}
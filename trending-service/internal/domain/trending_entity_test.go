@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrends(t *testing.T) {
+	current := map[string]int64{"x": 10, "y": 125980, "z": 150000, "w": 0}
+	previous := map[string]int64{"x": 1, "y": 123980, "z": 150000, "w": 50}
+
+	trends := NewTrends(Media, current, previous)
+	assert.Len(t, trends, 3)
+
+	// z is the maximum SI score of the data set, x beats y thanks to its fluctuation ratio
+	assert.Equal(t, "z", trends[0].AggregateID)
+	assert.Equal(t, 1.0, trends[0].Score)
+	assert.Equal(t, "x", trends[1].AggregateID)
+	assert.InDelta(t, 0.900066667, trends[1].Score, 1e-9)
+	assert.Equal(t, "y", trends[2].AggregateID)
+	assert.InDelta(t, 0.855742202, trends[2].Score, 1e-9)
+	assert.Equal(t, int64(123980), trends[2].PreviousInteractions)
+	assert.Equal(t, Media, trends[2].AggregateType)
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// InteractionRepository time-bucketed social interaction counts
+type InteractionRepository interface {
+	// Increment adds the given interaction to its time bucket
+	Increment(ctx context.Context, interaction Interaction) error
+	// FetchTotals returns the interaction totals per aggregate within [from, to), an empty country counts every country
+	FetchTotals(ctx context.Context, aggregateType, country string, from, to time.Time) (map[string]int64, error)
+	// Bucket returns the size of the time buckets, smallest time unit of the totals
+	Bucket() time.Duration
+}
@@ -0,0 +1,26 @@
+package domain
+
+// FluctuationRatio returns the Social Fluctuation Ratio (ω = Δy / y), increment or decrement ratio of the social
+// interactions between the previous and current window of an aggregate
+func FluctuationRatio(previous, current int64) float64 {
+	if current == 0 {
+		return 0
+	}
+
+	return float64(current-previous) / float64(current)
+}
+
+// ValuableRatio returns the Valuable Ratio (υ = y / z), removes the high-relativity of the fluctuation ratio using
+// the maximum social interaction score of the whole data set
+func ValuableRatio(current, max int64) float64 {
+	if max == 0 {
+		return 0
+	}
+
+	return float64(current) / float64(max)
+}
+
+// TrendingScore returns the Trending Score (Φ = ω + υ) of an aggregate
+func TrendingScore(previous, current, max int64) float64 {
+	return FluctuationRatio(previous, current) + ValuableRatio(current, max)
+}
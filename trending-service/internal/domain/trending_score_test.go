@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrendingScore(t *testing.T) {
+	// Worked examples from docs/Trending_Algorithm.MD
+	tests := []struct {
+		name        string
+		previous    int64
+		current     int64
+		max         int64
+		fluctuation float64
+		valuable    float64
+		score       float64
+	}{
+		{"aggregate x", 1, 10, 150000, 0.9, 0.000066667, 0.900066667},
+		{"aggregate y", 123980, 125980, 150000, 0.015875536, 0.839866667, 0.855742202},
+		{"decrement", 10, 5, 10, -1, 0.5, -0.5},
+		{"no interactions", 10, 0, 10, 0, 0, 0},
+		{"empty data set", 0, 0, 0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.fluctuation, FluctuationRatio(tt.previous, tt.current), 1e-9)
+			assert.InDelta(t, tt.valuable, ValuableRatio(tt.current, tt.max), 1e-9)
+			assert.InDelta(t, tt.score, TrendingScore(tt.previous, tt.current, tt.max), 1e-9)
+		})
+	}
+}
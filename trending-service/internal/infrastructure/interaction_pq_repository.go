@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault("alexandria.trending.bucket", "1m")
+}
+
+// InteractionPQRepository DBMS time-bucketed interaction repository, concurrent increments are applied atomically
+type InteractionPQRepository struct {
+	db     *sql.DB
+	logger log.Logger
+	bucket time.Duration
+}
+
+// NewInteractionPQRepository Create an interaction repository using the configured bucket size
+func NewInteractionPQRepository(db *sql.DB, logger log.Logger) *InteractionPQRepository {
+	bucket := viper.GetDuration("alexandria.trending.bucket")
+	if bucket <= 0 {
+		bucket = time.Minute
+	}
+
+	return &InteractionPQRepository{
+		db:     db,
+		logger: logger,
+		bucket: bucket,
+	}
+}
+
+func (r *InteractionPQRepository) Increment(ctx context.Context, interaction domain.Interaction) error {
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "trending.infrastructure.postgres.increment", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `INSERT INTO alexa1.interaction(aggregate_type, aggregate_id, country, bucket_time, total) VALUES ($1, $2, $3, $4, 1)
+					ON CONFLICT (aggregate_type, aggregate_id, country, bucket_time) DO UPDATE SET total = alexa1.interaction.total + 1`
+
+	_, err := r.db.ExecContext(ctx, statement, interaction.AggregateType, interaction.ID, interaction.Country,
+		interaction.Timestamp.UTC().Truncate(r.bucket))
+	return err
+}
+
+func (r *InteractionPQRepository) FetchTotals(ctx context.Context, aggregateType, country string, from, to time.Time) (map[string]int64, error) {
+	// Use Go CDK OpenCensus database metrics
+	_ = r.logger.Log("method", "trending.infrastructure.postgres.fetch_totals", "db_connection", r.db.Stats().OpenConnections)
+
+	statement := `SELECT aggregate_id, SUM(total) FROM alexa1.interaction WHERE aggregate_type = $1 AND bucket_time >= $2
+					AND bucket_time < $3 AND ($4 = '' OR country = $4) GROUP BY aggregate_id`
+
+	rows, err := r.db.QueryContext(ctx, statement, aggregateType, from.UTC(), to.UTC(), country)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	totals := make(map[string]int64)
+	for rows.Next() {
+		var id string
+		var total int64
+		if err = rows.Scan(&id, &total); err != nil {
+			return nil, err
+		}
+
+		totals[id] = total
+	}
+
+	return totals, rows.Err()
+}
+
+func (r *InteractionPQRepository) Bucket() time.Duration {
+	return r.bucket
+}
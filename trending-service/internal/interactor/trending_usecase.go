@@ -0,0 +1,95 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexandria-oss/core/exception"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+)
+
+type Trending struct {
+	logger     log.Logger
+	repository domain.InteractionRepository
+}
+
+func NewTrending(logger log.Logger, repository domain.InteractionRepository) *Trending {
+	return &Trending{
+		logger:     logger,
+		repository: repository,
+	}
+}
+
+func (u *Trending) List(ctx context.Context, aggregateType, country, window, limit string) (*domain.Trending, error) {
+	aggregateType, err := domain.ParseAggregateType(aggregateType)
+	if err != nil {
+		return nil, err
+	}
+
+	if window == "" {
+		window = "1h"
+	}
+	duration, err := domain.ParseWindow(window)
+	if err != nil {
+		return nil, err
+	}
+
+	size := 10
+	if limit != "" {
+		size, err = strconv.Atoi(limit)
+		if err != nil || size < 1 || size > 100 {
+			return nil, exception.NewErrorDescription(exception.InvalidFieldRange,
+				fmt.Sprintf(exception.InvalidFieldRangeString, "limit", "1", "100"))
+		}
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Only complete buckets are scored, current window is compared against the one right before it
+	computeTime := time.Now().UTC().Truncate(u.repository.Bucket())
+	country = strings.ToUpper(country)
+	current, err := u.repository.FetchTotals(ctxR, aggregateType, country, computeTime.Add(-duration), computeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := u.repository.FetchTotals(ctxR, aggregateType, country, computeTime.Add(-2*duration),
+		computeTime.Add(-duration))
+	if err != nil {
+		return nil, err
+	}
+
+	trends := domain.NewTrends(aggregateType, current, previous)
+	if len(trends) > size {
+		trends = trends[:size]
+	}
+
+	return &domain.Trending{
+		AggregateType: aggregateType,
+		Country:       country,
+		Window:        strings.ToLower(window),
+		ComputeTime:   computeTime,
+		Trends:        trends,
+	}, nil
+}
+
+func (u *Trending) Track(ctx context.Context, interaction *domain.Interaction) error {
+	if err := interaction.IsValid(); err != nil {
+		return err
+	}
+
+	ctxR, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := u.repository.Increment(ctxR, *interaction); err != nil {
+		_ = u.logger.Log("method", "trending.interactor.track", "err", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate wire
+//+build !wireinject
+
+package dep
+
+import (
+	"context"
+	zipkin2 "contrib.go.opencensus.io/exporter/zipkin"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/logger"
+	"github.com/alexandria-oss/core/tracer"
+	"github.com/alexandria-oss/core/transport"
+	"github.com/alexandria-oss/core/transport/proxy"
+	"github.com/go-kit/kit/log"
+	"github.com/google/wire"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/maestre3d/alexandria/trending-service/internal/dependency"
+	"github.com/maestre3d/alexandria/trending-service/pkg/transport/bind"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	"github.com/openzipkin/zipkin-go"
+	"github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/reporter"
+	"github.com/openzipkin/zipkin-go/reporter/http"
+	"go.opencensus.io/trace"
+)
+
+// Injectors from wire.go:
+
+func InjectTransportService() (*transport.Transport, func(), error) {
+	context := provideContext()
+	logLogger := logger.NewZapLogger()
+	trendingInteractor, cleanup, err := provideTrendingInteractor(context, logLogger)
+	if err != nil {
+		return nil, nil, err
+	}
+	kernel, err := config.NewKernel(context)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	reporter := provideZipkinReporter(kernel)
+	endpoint := provideZipkinEndpoint(kernel)
+	zipkinTracer, cleanup2 := provideZipkinTracer(reporter, endpoint)
+	opentracingTracer := tracer.WrapZipkinOpenTracing(kernel, zipkinTracer)
+	trendingRPCServer := bind.NewTrendingRPC(trendingInteractor, logLogger, opentracingTracer, zipkinTracer)
+	healthRPCServer := bind.NewHealthRPC()
+	v := provideRPCServers(trendingRPCServer, healthRPCServer)
+	server, cleanup3 := proxy.NewRPC(v)
+	trendingHandler := bind.NewTrendingHTTP(trendingInteractor, logLogger, opentracingTracer, zipkinTracer)
+	v2 := provideHTTPHandlers(trendingHandler)
+	http, cleanup4 := proxy.NewHTTP(kernel, v2...)
+	store, cleanup5, err := provideMessageStore(context)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	trendingEventConsumer := bind.NewTrendingEventConsumer(trendingInteractor, logLogger, kernel, store)
+	v3 := provideEventConsumers(trendingEventConsumer)
+	event, cleanup6, err := proxy.NewEvent(context, kernel, v3...)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	transportTransport := transport.NewTransport(server, http, event, kernel)
+	return transportTransport, func() {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wire.go:
+
+var Ctx = context.Background()
+
+var interactorSet = wire.NewSet(
+	provideContext, logger.NewZapLogger, provideTrendingInteractor,
+)
+
+var zipkinSet = wire.NewSet(
+	provideZipkinReporter,
+	provideZipkinEndpoint,
+	provideZipkinTracer,
+)
+
+var httpProxySet = wire.NewSet(
+	interactorSet, config.NewKernel, zipkinSet, tracer.WrapZipkinOpenTracing, bind.NewTrendingHTTP, provideHTTPHandlers, proxy.NewHTTP,
+)
+
+var rpcProxySet = wire.NewSet(bind.NewTrendingRPC, bind.NewHealthRPC, provideRPCServers, proxy.NewRPC)
+
+var eventProxySet = wire.NewSet(
+	provideMessageStore, bind.NewTrendingEventConsumer, provideEventConsumers, proxy.NewEvent,
+)
+
+func provideContext() context.Context {
+	return Ctx
+}
+
+func provideTrendingInteractor(ctx context.Context, logger2 log.Logger) (usecase.TrendingInteractor, func(), error) {
+	dependency.Ctx = ctx
+
+	trendingInteractor, cleanup, err := dependency.InjectTrendingUseCase()
+	trendingService := trending.WrapTrendingInstrumentation(trendingInteractor, logger2)
+
+	return trendingService, cleanup, err
+}
+
+// Bind/Map used http handlers
+func provideHTTPHandlers(trendingHandler *bind.TrendingHandler) []proxy.Handler {
+	handlers := make([]proxy.Handler, 0)
+	handlers = append(handlers, trendingHandler)
+	return handlers
+}
+
+// Bind/Map used rpc servers
+func provideRPCServers(trendingServer *bind.TrendingRPCServer, healthServer *bind.HealthRPCServer) []proxy.RPCServer {
+	servers := make([]proxy.RPCServer, 0)
+	servers = append(servers, trendingServer, healthServer)
+	return servers
+}
+
+func provideMessageStore(ctx context.Context) (messaging.Store, func(), error) {
+	dependency.Ctx = ctx
+
+	return dependency.InjectMessageStore()
+}
+
+// Bind/Map used event consumers
+func provideEventConsumers(trendingConsumer *bind.TrendingEventConsumer) []proxy.Consumer {
+	consumers := make([]proxy.Consumer, 0)
+	consumers = append(consumers, trendingConsumer)
+	return consumers
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinReporter(cfg *config.Kernel) reporter.Reporter {
+	if cfg.Tracing.ZipkinHost != "" {
+		zipkinReporter := http.NewReporter(cfg.Tracing.ZipkinHost)
+
+		return zipkinReporter
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinEndpoint(cfg *config.Kernel) *model.Endpoint {
+	if cfg.Tracing.ZipkinEndpoint != "" {
+		zipkinEndpoint, err := zipkin.NewEndpoint(cfg.Service, cfg.Tracing.ZipkinEndpoint)
+		if err != nil {
+			return nil
+		}
+
+		return zipkinEndpoint
+	}
+
+	return nil
+}
+
+// NewZipkin returns a zipkin tracing consumer
+func provideZipkinTracer(r reporter.Reporter, ep *model.Endpoint) (*zipkin.Tracer, func()) {
+	if r != nil && ep != nil {
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+		trace.RegisterExporter(zipkin2.NewExporter(r, ep))
+
+		zipkinTrace, err := zipkin.NewTracer(r, zipkin.WithLocalEndpoint(ep))
+		if err != nil {
+			return nil, nil
+		}
+		cleanup := func() {
+			_ = r.Close()
+		}
+
+		return zipkinTrace, cleanup
+	}
+
+	return nil, nil
+}
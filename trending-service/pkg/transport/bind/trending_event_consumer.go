@@ -0,0 +1,171 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alexandria-oss/core/config"
+	"github.com/alexandria-oss/core/eventbus"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	"github.com/maestre3d/alexandria/shared/messaging"
+	"github.com/sony/gobreaker"
+	"go.opencensus.io/trace"
+	"gocloud.dev/pubsub"
+	"time"
+)
+
+type TrendingEventConsumer struct {
+	svc    usecase.TrendingInteractor
+	logger log.Logger
+	cfg    *config.Kernel
+	guard  *messaging.Guard
+	dlq    *messaging.DeadLetter
+}
+
+func NewTrendingEventConsumer(svc usecase.TrendingInteractor, logger log.Logger, cfg *config.Kernel,
+	store messaging.Store) *TrendingEventConsumer {
+	return &TrendingEventConsumer{
+		svc:    svc,
+		logger: logger,
+		cfg:    cfg,
+		guard:  messaging.NewGuard(store, "trending", logger),
+		dlq:    messaging.NewDeadLetter("trending", logger),
+	}
+}
+
+func (c TrendingEventConsumer) defaultCircuitBreaker(action string) *gobreaker.CircuitBreaker {
+	st := gobreaker.Settings{
+		Name:        "trending_consumer_kafka_" + action,
+		MaxRequests: 5,
+		Interval:    10 * time.Second,
+		Timeout:     15 * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= 3 && failureRatio >= 0.6
+		},
+		OnStateChange: nil,
+	}
+
+	return gobreaker.NewCircuitBreaker(st)
+}
+
+func extractContext(r *eventbus.Request) *eventbus.EventContext {
+	return &eventbus.EventContext{
+		Transaction: &eventbus.Transaction{
+			ID:        r.Message.Metadata["transaction_id"],
+			RootID:    r.Message.Metadata["root_id"],
+			SpanID:    r.Message.Metadata["span_id"],
+			TraceID:   r.Message.Metadata["trace_id"],
+			Operation: r.Message.Metadata["operation"],
+			Snapshot:  r.Message.Metadata["snapshot"],
+		},
+		Event: &eventbus.Event{
+			Content:        r.Message.Body,
+			TracingContext: r.Message.Metadata["tracing_context"],
+			ID:             r.Message.Metadata["event_id"],
+			ServiceName:    r.Message.Metadata["service"],
+			EventType:      r.Message.Metadata["event_type"],
+			Priority:       r.Message.Metadata["priority"],
+			Provider:       r.Message.Metadata["provider"],
+			DispatchTime:   r.Message.Metadata["dispatch_time"],
+		},
+	}
+}
+
+func (c *TrendingEventConsumer) SetBinders(s *eventbus.Server, ctx context.Context, service string) error {
+	mediaBind, err := c.bindViewed(ctx, service, domain.MediaViewed, domain.Media)
+	if err != nil {
+		return err
+	}
+
+	authorBind, err := c.bindViewed(ctx, service, domain.AuthorViewed, domain.Author)
+	if err != nil {
+		return err
+	}
+
+	s.AddConsumer(mediaBind)
+	s.AddConsumer(authorBind)
+
+	return nil
+}
+
+// Consumers / Binders
+func (c *TrendingEventConsumer) bindViewed(ctx context.Context, service, topic, aggregateType string) (*eventbus.Consumer, error) {
+	sub, err := c.defaultCircuitBreaker(aggregateType + "_viewed").Execute(func() (interface{}, error) {
+		sub, err := eventbus.NewKafkaConsumer(ctx, service, topic)
+		if err != nil {
+			return nil, err
+		}
+
+		return sub, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventbus.Consumer{
+		MaxHandler: 10,
+		Consumer:   sub.(*pubsub.Subscription),
+		Handler: c.dlq.Bind(sub.(*pubsub.Subscription), service, topic, func(r *eventbus.Request) {
+			c.onViewed(r, aggregateType)
+		}),
+	}, nil
+}
+
+// Hooks / Handlers
+func (c *TrendingEventConsumer) onViewed(r *eventbus.Request, aggregateType string) {
+	// Domain event (side-effects) does not use transactions
+	ec := extractContext(r)
+	if !c.guard.Acquire(r, ec, "on_"+aggregateType+"_viewed") {
+		return
+	}
+
+	var traceCtx trace.SpanContext
+	err := json.Unmarshal([]byte(ec.Event.TracingContext), &traceCtx)
+	if err != nil {
+		rootSpan := trace.FromContext(r.Context)
+		defer rootSpan.End()
+		traceCtx = rootSpan.SpanContext()
+	}
+
+	ctxT, span := trace.StartSpanWithRemoteParent(r.Context, "trending: "+aggregateType+"_viewed", traceCtx)
+	defer span.End()
+
+	span.SetStatus(trace.Status{
+		Code:    trace.StatusCodeOK,
+		Message: "event received",
+	})
+	span.AddAttributes(trace.StringAttribute("event.name", messaging.BoundTopic(r.Context)))
+
+	interaction := new(domain.Interaction)
+	if err = json.Unmarshal(ec.Event.Content, interaction); err != nil {
+		// Malformed bodies will never be counted, avoid redelivery
+		_ = level.Error(c.logger).Log("err", exception.NewErrorDescription(exception.InvalidFieldFormat,
+			fmt.Sprintf(exception.InvalidFieldFormatString, "body", "interaction JSON")))
+		c.guard.Ack(r, ec)
+		return
+	}
+	interaction.AggregateType = aggregateType
+
+	ctxU := context.WithValue(ctxT, eventbus.EventContextKey("event"), ec)
+	err = c.dlq.Execute(ctxU, func() error {
+		return c.svc.Track(ctxU, interaction)
+	})
+	if err != nil {
+		_ = level.Error(c.logger).Log("err", err)
+		// If internal error after every attempt, route message to dead-letter topic
+		if code := httputil.ErrorToCode(err); code == 500 {
+			c.guard.Release(r.Context, ec)
+			// Routed messages are not marked as processed so they can be replayed, unrouted ones are never acknowledged
+			_ = c.dlq.Route(r, err)
+			return
+		}
+	}
+
+	c.guard.Ack(r, ec)
+}
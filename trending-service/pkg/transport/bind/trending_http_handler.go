@@ -0,0 +1,109 @@
+package bind
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/httputil"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/action"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+)
+
+type TrendingHandler struct {
+	service      usecase.TrendingInteractor
+	logger       log.Logger
+	duration     *kitprometheus.Summary
+	tracer       stdopentracing.Tracer
+	zipkinTracer *stdzipkin.Tracer
+	options      []httptransport.ServerOption
+}
+
+func NewTrendingHTTP(svc usecase.TrendingInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *TrendingHandler {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "trending_service",
+		Name:        "request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(httputil.ResponseErrJSON),
+		kitoc.HTTPServerTrace(),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.HTTPServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("trending_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	return &TrendingHandler{svc, logger, duration, tracer, zipkinTracer, options}
+}
+
+// SetRoutes implement Handler interface for HTTP Proxy
+func (h *TrendingHandler) SetRoutes(public, _, _ *mux.Router) {
+	// Public routing
+	r := public.PathPrefix("/trending").Subrouter()
+	r.Methods(http.MethodOptions)
+	r.Path("").Methods(http.MethodGet).Handler(h.List())
+	r.Path("/").Methods(http.MethodGet).Handler(h.List())
+	r.Use(mux.CORSMethodMiddleware(r))
+}
+
+func (h *TrendingHandler) List() *httptransport.Server {
+	return httptransport.NewServer(
+		action.MakeListTrendingEndpoint(h.service, h.logger, h.duration, h.tracer, h.zipkinTracer),
+		decodeListRequest,
+		encodeListResponse,
+		append(h.options, httptransport.ServerBefore(opentracing.HTTPToContext(h.tracer, "List", h.logger)))...,
+	)
+}
+
+/* Decoders */
+
+func decodeListRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return action.ListRequest{
+		AggregateType: r.URL.Query().Get("type"),
+		Country:       r.URL.Query().Get("country"),
+		Window:        r.URL.Query().Get("window"),
+		Limit:         r.URL.Query().Get("limit"),
+	}, nil
+}
+
+/* Encoders */
+
+func encodeListResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	r, ok := response.(action.ListResponse)
+	if ok {
+		if r.Err != nil {
+			httputil.ResponseErrJSON(ctx, r.Err, w)
+			return nil
+		} else if r.Trending == nil || len(r.Trending.Trends) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return json.NewEncoder(w).Encode(httputil.GenericResponse{
+				Message: exception.EntitiesNotFound.Error(),
+				Code:    http.StatusNotFound,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(r.Trending)
+}
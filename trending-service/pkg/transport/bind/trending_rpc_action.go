@@ -0,0 +1,125 @@
+package bind
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/exception"
+	"github.com/alexandria-oss/core/grpcutil"
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/maestre3d/alexandria/trending-service/pb"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/action"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type TrendingRPCServer struct {
+	srv pb.TrendingServer
+}
+
+// Compile-time RPC implementation
+type trendingRPCImp struct {
+	list grpctransport.Handler
+}
+
+func NewTrendingRPC(svc usecase.TrendingInteractor, logger log.Logger, tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) *TrendingRPCServer {
+	duration := kitprometheus.NewSummaryFrom(prometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "rpc_trending_service",
+		Name:        "request_duration_seconds",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, []string{"method", "success"})
+
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer, zipkin.Logger(logger), zipkin.Name("trending_service"),
+			zipkin.AllowPropagation(true)))
+	}
+
+	srv := trendingRPCImp{
+		list: grpctransport.NewServer(
+			action.MakeListTrendingEndpoint(svc, logger, duration, tracer, zipkinTracer),
+			decodeRPCListRequest,
+			encodeRPCListResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(tracer, "List", logger)))...,
+		),
+	}
+
+	return &TrendingRPCServer{srv}
+}
+
+func (a TrendingRPCServer) SetRoutes(srv *grpc.Server) {
+	pb.RegisterTrendingServer(srv, a.srv)
+}
+
+/* RPC Action Binding/Implementations */
+
+func (a trendingRPCImp) List(ctx context.Context, req *pb.TrendingRequest) (*pb.TrendingListResponse, error) {
+	_, rep, err := a.list.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcutil.ResponseErr(err)
+	}
+	return rep.(*pb.TrendingListResponse), nil
+}
+
+/* Decoders */
+
+func decodeRPCListRequest(_ context.Context, rpcReq interface{}) (interface{}, error) {
+	req := rpcReq.(*pb.TrendingRequest)
+	return action.ListRequest{
+		AggregateType: req.AggregateType,
+		Country:       req.Country,
+		Window:        req.Window,
+		Limit:         req.Limit,
+	}, nil
+}
+
+/* Encoders */
+
+func encodeRPCListResponse(_ context.Context, response interface{}) (interface{}, error) {
+	res := response.(action.ListResponse)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	if res.Trending == nil || len(res.Trending.Trends) == 0 {
+		return nil, status.Error(codes.NotFound, exception.EntitiesNotFound.Error())
+	}
+
+	trendsRPC := make([]*pb.TrendMessage, 0)
+	for _, trend := range res.Trending.Trends {
+		trendsRPC = append(trendsRPC, &pb.TrendMessage{
+			AggregateID:          trend.AggregateID,
+			AggregateType:        trend.AggregateType,
+			Country:              res.Trending.Country,
+			Interactions:         trend.Interactions,
+			PreviousInteractions: trend.PreviousInteractions,
+			FluctuationRatio:     trend.FluctuationRatio,
+			ValuableRatio:        trend.ValuableRatio,
+			Score:                trend.Score,
+		})
+	}
+
+	return &pb.TrendingListResponse{
+		Trends:      trendsRPC,
+		Window:      res.Trending.Window,
+		ComputeTime: res.Trending.ComputeTime.Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package action
+
+import (
+	"context"
+	"github.com/alexandria-oss/core/middleware"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+)
+
+type ListRequest struct {
+	AggregateType string `json:"type"`
+	Country       string `json:"country"`
+	Window        string `json:"window"`
+	Limit         string `json:"limit"`
+}
+
+type ListResponse struct {
+	Trending *domain.Trending `json:"trending"`
+	Err      error            `json:"-"`
+}
+
+func MakeListTrendingEndpoint(svc usecase.TrendingInteractor, logger log.Logger, duration metrics.Histogram,
+	tracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) endpoint.Endpoint {
+	ep := func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ListRequest)
+		trending, err := svc.List(ctx, req.AggregateType, req.Country, req.Window, req.Limit)
+		if err != nil {
+			return ListResponse{
+				Trending: nil,
+				Err:      err,
+			}, err
+		}
+
+		return ListResponse{
+			Trending: trending,
+			Err:      nil,
+		}, nil
+	}
+
+	// Required resiliency and instrumentation
+	action := "list"
+	ep = middleware.WrapResiliency(ep, "trending", action)
+	return middleware.WrapInstrumentation(ep, "trending", action, &middleware.WrapInstrumentParams{
+		Logger:       logger,
+		Duration:     duration,
+		Tracer:       tracer,
+		ZipkinTracer: zipkinTracer,
+	})
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ListResponse{}
+)
+
+func (r ListResponse) Failed() error { return r.Err }
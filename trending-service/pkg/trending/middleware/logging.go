@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	"time"
+)
+
+type LoggingTrendingMiddleware struct {
+	Logger log.Logger
+	Next   usecase.TrendingInteractor
+}
+
+func (mw LoggingTrendingMiddleware) List(ctx context.Context, aggregateType, country, window, limit string) (output *domain.Trending, err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "trending.list",
+			"input", fmt.Sprintf("type: %s, country: %s, window: %s, limit: %s", aggregateType, country, window, limit),
+			"output", fmt.Sprintf("%+v", output),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	output, err = mw.Next.List(ctx, aggregateType, country, window, limit)
+	return
+}
+
+func (mw LoggingTrendingMiddleware) Track(ctx context.Context, interaction *domain.Interaction) (err error) {
+	defer func(begin time.Time) {
+		_ = mw.Logger.Log(
+			"method", "trending.track",
+			"input", fmt.Sprintf("%+v", interaction),
+			"output", "",
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	err = mw.Next.Track(ctx, interaction)
+	return
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/metrics"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	"time"
+)
+
+type MetricTrendingMiddleware struct {
+	RequestCount   metrics.Counter
+	RequestLatency metrics.Histogram
+	Next           usecase.TrendingInteractor
+}
+
+func (mw MetricTrendingMiddleware) List(ctx context.Context, aggregateType, country, window, limit string) (output *domain.Trending, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "trending.list", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	output, err = mw.Next.List(ctx, aggregateType, country, window, limit)
+	return
+}
+
+func (mw MetricTrendingMiddleware) Track(ctx context.Context, interaction *domain.Interaction) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "trending.track", "error", fmt.Sprint(err != nil)}
+		mw.RequestCount.With(lvs...).Add(1)
+		mw.RequestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err = mw.Next.Track(ctx, interaction)
+	return
+}
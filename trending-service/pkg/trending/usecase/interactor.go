@@ -0,0 +1,11 @@
+package usecase
+
+import (
+	"context"
+	"github.com/maestre3d/alexandria/trending-service/internal/domain"
+)
+
+type TrendingInteractor interface {
+	List(ctx context.Context, aggregateType, country, window, limit string) (*domain.Trending, error)
+	Track(ctx context.Context, interaction *domain.Interaction) error
+}
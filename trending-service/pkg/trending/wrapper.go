@@ -0,0 +1,42 @@
+package trending
+
+import (
+	"github.com/go-kit/kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/middleware"
+	"github.com/maestre3d/alexandria/trending-service/pkg/trending/usecase"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// WrapTrendingInstrumentation Inject middleware (metrics and logging) to bounded context's edge
+// using chain of responsibility/middleware pattern and HOC-like pattern wrapping style
+func WrapTrendingInstrumentation(trendingUseCase usecase.TrendingInteractor, logger log.Logger) usecase.TrendingInteractor {
+	// Inject Prometheus metrics
+	// Inject logger
+	fieldKeys := []string{"method", "error"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "trending_service",
+		Name:        "request_count",
+		Help:        "number of request received",
+		ConstLabels: nil,
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace:   "alexandria",
+		Subsystem:   "trending_service",
+		Name:        "request_latency",
+		Help:        "total duration of requests in microseconds",
+		ConstLabels: nil,
+		Objectives:  nil,
+		MaxAge:      0,
+		AgeBuckets:  0,
+		BufCap:      0,
+	}, fieldKeys)
+
+	var svc usecase.TrendingInteractor
+	svc = trendingUseCase
+	svc = middleware.LoggingTrendingMiddleware{Logger: logger, Next: svc}
+	svc = middleware.MetricTrendingMiddleware{RequestCount: requestCount, RequestLatency: requestLatency, Next: svc}
+
+	return svc
+}
@@ -0,0 +1,27 @@
+/******************************
+**	File:   main.sql
+**	Name:	Database migrations scripts
+**	Desc:	Main database migrations scripts for trending microservice
+**	Auth:	Alonso R
+**	Lic:	MIT
+**	Date:	2026-10-18
+*******************************/
+
+CREATE DATABASE 'alexandria/trending';
+SET search_path TO 'alexandria/trending';
+
+CREATE SCHEMA IF NOT EXISTS alexa1;
+
+-- Social interactions (SI) per aggregate, counted in time buckets
+-- country holds the ISO 3166-1 alpha-2 code of the user, empty if unknown
+CREATE TABLE IF NOT EXISTS alexa1.interaction(
+    aggregate_type  varchar(32) NOT NULL,
+    aggregate_id    varchar(128) NOT NULL,
+    country         varchar(8) NOT NULL DEFAULT '',
+    bucket_time     timestamp NOT NULL,
+    total           bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (aggregate_type, aggregate_id, country, bucket_time)
+);
+
+-- Trending windows are read by type and time range
+CREATE INDEX IF NOT EXISTS interaction_window_idx ON alexa1.interaction(aggregate_type, bucket_time, country);